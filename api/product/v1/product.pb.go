@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.20.3
+// source: api/product/v1/product.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// sku信息
+type SkuInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	SpuId         int64                  `protobuf:"varint,2,opt,name=spuId,proto3" json:"spuId,omitempty"`
+	StoreId       int64                  `protobuf:"varint,3,opt,name=storeId,proto3" json:"storeId,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Pic           string                 `protobuf:"bytes,5,opt,name=pic,proto3" json:"pic,omitempty"`
+	Price         int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"` // 单位:分
+	Attrs         string                 `protobuf:"bytes,7,opt,name=attrs,proto3" json:"attrs,omitempty"`  // 规格属性,比如 "颜色:黑色;尺码:XL"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuInfo) Reset() {
+	*x = SkuInfo{}
+	mi := &file_api_product_v1_product_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuInfo) ProtoMessage() {}
+
+func (x *SkuInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuInfo.ProtoReflect.Descriptor instead.
+func (*SkuInfo) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SkuInfo) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SkuInfo) GetSpuId() int64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *SkuInfo) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *SkuInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SkuInfo) GetPic() string {
+	if x != nil {
+		return x.Pic
+	}
+	return ""
+}
+
+func (x *SkuInfo) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SkuInfo) GetAttrs() string {
+	if x != nil {
+		return x.Attrs
+	}
+	return ""
+}
+
+// 查询sku详情的请求
+type GetSkuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkuRequest) Reset() {
+	*x = GetSkuRequest{}
+	mi := &file_api_product_v1_product_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkuRequest) ProtoMessage() {}
+
+func (x *GetSkuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkuRequest.ProtoReflect.Descriptor instead.
+func (*GetSkuRequest) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSkuRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+// 查询sku详情的响应
+type GetSkuReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           *SkuInfo               `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkuReply) Reset() {
+	*x = GetSkuReply{}
+	mi := &file_api_product_v1_product_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkuReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkuReply) ProtoMessage() {}
+
+func (x *GetSkuReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_v1_product_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkuReply.ProtoReflect.Descriptor instead.
+func (*GetSkuReply) Descriptor() ([]byte, []int) {
+	return file_api_product_v1_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSkuReply) GetSku() *SkuInfo {
+	if x != nil {
+		return x.Sku
+	}
+	return nil
+}
+
+var File_api_product_v1_product_proto protoreflect.FileDescriptor
+
+const file_api_product_v1_product_proto_rawDesc = "" +
+	"\n" +
+	"\x1capi/product/v1/product.proto\x12\x0eapi.product.v1\"\xa3\x01\n" +
+	"\aSkuInfo\x12\x14\n" +
+	"\x05skuId\x18\x01 \x01(\x03R\x05skuId\x12\x14\n" +
+	"\x05spuId\x18\x02 \x01(\x03R\x05spuId\x12\x18\n" +
+	"\astoreId\x18\x03 \x01(\x03R\astoreId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x10\n" +
+	"\x03pic\x18\x05 \x01(\tR\x03pic\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x03R\x05price\x12\x14\n" +
+	"\x05attrs\x18\a \x01(\tR\x05attrs\"%\n" +
+	"\rGetSkuRequest\x12\x14\n" +
+	"\x05skuId\x18\x01 \x01(\x03R\x05skuId\"8\n" +
+	"\vGetSkuReply\x12)\n" +
+	"\x03sku\x18\x01 \x01(\v2\x17.api.product.v1.SkuInfoR\x03sku2O\n" +
+	"\aProduct\x12D\n" +
+	"\x06GetSku\x12\x1d.api.product.v1.GetSkuRequest\x1a\x1b.api.product.v1.GetSkuReplyB4\n" +
+	"\x0eapi.product.v1P\x01Z review-service/api/product/v1;v1b\x06proto3"
+
+var (
+	file_api_product_v1_product_proto_rawDescOnce sync.Once
+	file_api_product_v1_product_proto_rawDescData []byte
+)
+
+func file_api_product_v1_product_proto_rawDescGZIP() []byte {
+	file_api_product_v1_product_proto_rawDescOnce.Do(func() {
+		file_api_product_v1_product_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_product_v1_product_proto_rawDesc), len(file_api_product_v1_product_proto_rawDesc)))
+	})
+	return file_api_product_v1_product_proto_rawDescData
+}
+
+var file_api_product_v1_product_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_product_v1_product_proto_goTypes = []any{
+	(*SkuInfo)(nil),       // 0: api.product.v1.SkuInfo
+	(*GetSkuRequest)(nil), // 1: api.product.v1.GetSkuRequest
+	(*GetSkuReply)(nil),   // 2: api.product.v1.GetSkuReply
+}
+var file_api_product_v1_product_proto_depIdxs = []int32{
+	0, // 0: api.product.v1.GetSkuReply.sku:type_name -> api.product.v1.SkuInfo
+	1, // 1: api.product.v1.Product.GetSku:input_type -> api.product.v1.GetSkuRequest
+	2, // 2: api.product.v1.Product.GetSku:output_type -> api.product.v1.GetSkuReply
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_product_v1_product_proto_init() }
+func file_api_product_v1_product_proto_init() {
+	if File_api_product_v1_product_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_product_v1_product_proto_rawDesc), len(file_api_product_v1_product_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_product_v1_product_proto_goTypes,
+		DependencyIndexes: file_api_product_v1_product_proto_depIdxs,
+		MessageInfos:      file_api_product_v1_product_proto_msgTypes,
+	}.Build()
+	File_api_product_v1_product_proto = out.File
+	file_api_product_v1_product_proto_goTypes = nil
+	file_api_product_v1_product_proto_depIdxs = nil
+}
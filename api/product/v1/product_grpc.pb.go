@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.20.3
+// source: api/product/v1/product.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Product_GetSku_FullMethodName = "/api.product.v1.Product/GetSku"
+)
+
+// ProductClient is the client API for Product service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 商品服务(只保留评价服务用到的接口)
+type ProductClient interface {
+	// 查询sku详情
+	GetSku(ctx context.Context, in *GetSkuRequest, opts ...grpc.CallOption) (*GetSkuReply, error)
+}
+
+type productClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProductClient(cc grpc.ClientConnInterface) ProductClient {
+	return &productClient{cc}
+}
+
+func (c *productClient) GetSku(ctx context.Context, in *GetSkuRequest, opts ...grpc.CallOption) (*GetSkuReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSkuReply)
+	err := c.cc.Invoke(ctx, Product_GetSku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductServer is the server API for Product service.
+// All implementations must embed UnimplementedProductServer
+// for forward compatibility.
+//
+// 商品服务(只保留评价服务用到的接口)
+type ProductServer interface {
+	// 查询sku详情
+	GetSku(context.Context, *GetSkuRequest) (*GetSkuReply, error)
+	mustEmbedUnimplementedProductServer()
+}
+
+// UnimplementedProductServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductServer struct{}
+
+func (UnimplementedProductServer) GetSku(context.Context, *GetSkuRequest) (*GetSkuReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSku not implemented")
+}
+func (UnimplementedProductServer) mustEmbedUnimplementedProductServer() {}
+func (UnimplementedProductServer) testEmbeddedByValue()                 {}
+
+// UnsafeProductServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductServer will
+// result in compilation errors.
+type UnsafeProductServer interface {
+	mustEmbedUnimplementedProductServer()
+}
+
+func RegisterProductServer(s grpc.ServiceRegistrar, srv ProductServer) {
+	// If the following call pancis, it indicates UnimplementedProductServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Product_ServiceDesc, srv)
+}
+
+func _Product_GetSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetSku(ctx, req.(*GetSkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Product_ServiceDesc is the grpc.ServiceDesc for Product service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Product_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.product.v1.Product",
+	HandlerType: (*ProductServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSku",
+			Handler:    _Product_GetSku_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/product/v1/product.proto",
+}
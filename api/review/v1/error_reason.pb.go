@@ -0,0 +1,237 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.20.3
+// source: api/review/v1/error_reason.proto
+
+package v1
+
+import (
+	_ "github.com/go-kratos/kratos/v2/errors"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 评价服务的错误原因
+// 每个错误原因对应一个HTTP状态码,gRPC状态码由kratos根据HTTP状态码转换
+type ErrorReason int32
+
+const (
+	ErrorReason_ERROR_REASON_UNSPECIFIED ErrorReason = 0
+	// 参数不合法
+	ErrorReason_INVALID_PARAM ErrorReason = 1
+	// 数据库操作失败
+	ErrorReason_DB_FAILED ErrorReason = 2
+	// ES查询失败
+	ErrorReason_SEARCH_FAILED ErrorReason = 3
+	// 订单已评价
+	ErrorReason_REVIEW_ALREADY_EXISTS ErrorReason = 4
+	// 评价不存在
+	ErrorReason_REVIEW_NOT_FOUND ErrorReason = 5
+	// 不能操作其他用户的评价
+	ErrorReason_FORBIDDEN_USER ErrorReason = 6
+	// 商家不能操作其他商家的评价(水平越权)
+	ErrorReason_FORBIDDEN_STORE ErrorReason = 7
+	// 评价已回复
+	ErrorReason_ALREADY_REPLIED ErrorReason = 8
+	// 申诉不存在
+	ErrorReason_APPEAL_NOT_FOUND ErrorReason = 9
+	// 申诉已审核
+	ErrorReason_APPEAL_ALREADY_AUDITED ErrorReason = 10
+	// 当前状态不允许该操作
+	ErrorReason_STATUS_TRANSITION_NOT_ALLOWED ErrorReason = 11
+	// 超过评价可修改期限
+	ErrorReason_UPDATE_WINDOW_EXPIRED ErrorReason = 12
+	// 并发修改冲突,需要重新查询后重试
+	ErrorReason_NEED_RETRY ErrorReason = 13
+	// 订单不存在
+	ErrorReason_ORDER_NOT_FOUND ErrorReason = 14
+	// 订单未完成,不能评价
+	ErrorReason_ORDER_NOT_COMPLETED ErrorReason = 15
+	// 调用下游服务失败
+	ErrorReason_DEPENDENCY_FAILED ErrorReason = 16
+	// 未登录或者登录凭证无效
+	ErrorReason_UNAUTHORIZED ErrorReason = 17
+	// 调用方角色没有权限
+	ErrorReason_PERMISSION_DENIED ErrorReason = 18
+	// 超过图片视频的上传配额
+	ErrorReason_UPLOAD_QUOTA_EXCEEDED ErrorReason = 19
+	// 引用的图片视频在对象存储中不存在或者不合法
+	ErrorReason_MEDIA_NOT_FOUND ErrorReason = 20
+	// 评价已追评
+	ErrorReason_APPEND_ALREADY_EXISTS ErrorReason = 21
+	// 超过评价可追评期限
+	ErrorReason_APPEND_WINDOW_EXPIRED ErrorReason = 22
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "ERROR_REASON_UNSPECIFIED",
+		1:  "INVALID_PARAM",
+		2:  "DB_FAILED",
+		3:  "SEARCH_FAILED",
+		4:  "REVIEW_ALREADY_EXISTS",
+		5:  "REVIEW_NOT_FOUND",
+		6:  "FORBIDDEN_USER",
+		7:  "FORBIDDEN_STORE",
+		8:  "ALREADY_REPLIED",
+		9:  "APPEAL_NOT_FOUND",
+		10: "APPEAL_ALREADY_AUDITED",
+		11: "STATUS_TRANSITION_NOT_ALLOWED",
+		12: "UPDATE_WINDOW_EXPIRED",
+		13: "NEED_RETRY",
+		14: "ORDER_NOT_FOUND",
+		15: "ORDER_NOT_COMPLETED",
+		16: "DEPENDENCY_FAILED",
+		17: "UNAUTHORIZED",
+		18: "PERMISSION_DENIED",
+		19: "UPLOAD_QUOTA_EXCEEDED",
+		20: "MEDIA_NOT_FOUND",
+		21: "APPEND_ALREADY_EXISTS",
+		22: "APPEND_WINDOW_EXPIRED",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED":      0,
+		"INVALID_PARAM":                 1,
+		"DB_FAILED":                     2,
+		"SEARCH_FAILED":                 3,
+		"REVIEW_ALREADY_EXISTS":         4,
+		"REVIEW_NOT_FOUND":              5,
+		"FORBIDDEN_USER":                6,
+		"FORBIDDEN_STORE":               7,
+		"ALREADY_REPLIED":               8,
+		"APPEAL_NOT_FOUND":              9,
+		"APPEAL_ALREADY_AUDITED":        10,
+		"STATUS_TRANSITION_NOT_ALLOWED": 11,
+		"UPDATE_WINDOW_EXPIRED":         12,
+		"NEED_RETRY":                    13,
+		"ORDER_NOT_FOUND":               14,
+		"ORDER_NOT_COMPLETED":           15,
+		"DEPENDENCY_FAILED":             16,
+		"UNAUTHORIZED":                  17,
+		"PERMISSION_DENIED":             18,
+		"UPLOAD_QUOTA_EXCEEDED":         19,
+		"MEDIA_NOT_FOUND":               20,
+		"APPEND_ALREADY_EXISTS":         21,
+		"APPEND_WINDOW_EXPIRED":         22,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_review_v1_error_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_api_review_v1_error_reason_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_api_review_v1_error_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_api_review_v1_error_reason_proto protoreflect.FileDescriptor
+
+const file_api_review_v1_error_reason_proto_rawDesc = "" +
+	"\n" +
+	" api/review/v1/error_reason.proto\x12\rapi.review.v1\x1a\x13errors/errors.proto*\xa1\x05\n" +
+	"\vErrorReason\x12\x1c\n" +
+	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\rINVALID_PARAM\x10\x01\x1a\x04\xa8E\x90\x03\x12\r\n" +
+	"\tDB_FAILED\x10\x02\x12\x11\n" +
+	"\rSEARCH_FAILED\x10\x03\x12\x1f\n" +
+	"\x15REVIEW_ALREADY_EXISTS\x10\x04\x1a\x04\xa8E\x99\x03\x12\x1a\n" +
+	"\x10REVIEW_NOT_FOUND\x10\x05\x1a\x04\xa8E\x94\x03\x12\x18\n" +
+	"\x0eFORBIDDEN_USER\x10\x06\x1a\x04\xa8E\x93\x03\x12\x19\n" +
+	"\x0fFORBIDDEN_STORE\x10\a\x1a\x04\xa8E\x93\x03\x12\x19\n" +
+	"\x0fALREADY_REPLIED\x10\b\x1a\x04\xa8E\x99\x03\x12\x1a\n" +
+	"\x10APPEAL_NOT_FOUND\x10\t\x1a\x04\xa8E\x94\x03\x12 \n" +
+	"\x16APPEAL_ALREADY_AUDITED\x10\n" +
+	"\x1a\x04\xa8E\x99\x03\x12'\n" +
+	"\x1dSTATUS_TRANSITION_NOT_ALLOWED\x10\v\x1a\x04\xa8E\x99\x03\x12\x1f\n" +
+	"\x15UPDATE_WINDOW_EXPIRED\x10\f\x1a\x04\xa8E\x93\x03\x12\x14\n" +
+	"\n" +
+	"NEED_RETRY\x10\r\x1a\x04\xa8E\x99\x03\x12\x19\n" +
+	"\x0fORDER_NOT_FOUND\x10\x0e\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
+	"\x13ORDER_NOT_COMPLETED\x10\x0f\x1a\x04\xa8E\x90\x03\x12\x1b\n" +
+	"\x11DEPENDENCY_FAILED\x10\x10\x1a\x04\xa8E\xf7\x03\x12\x16\n" +
+	"\fUNAUTHORIZED\x10\x11\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
+	"\x11PERMISSION_DENIED\x10\x12\x1a\x04\xa8E\x93\x03\x12\x1f\n" +
+	"\x15UPLOAD_QUOTA_EXCEEDED\x10\x13\x1a\x04\xa8E\xad\x03\x12\x19\n" +
+	"\x0fMEDIA_NOT_FOUND\x10\x14\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
+	"\x15APPEND_ALREADY_EXISTS\x10\x15\x1a\x04\xa8E\x99\x03\x12\x1f\n" +
+	"\x15APPEND_WINDOW_EXPIRED\x10\x16\x1a\x04\xa8E\x93\x03\x1a\x04\xa0E\xf4\x03B2\n" +
+	"\rapi.review.v1P\x01Z\x1freview-service/api/review/v1;v1b\x06proto3"
+
+var (
+	file_api_review_v1_error_reason_proto_rawDescOnce sync.Once
+	file_api_review_v1_error_reason_proto_rawDescData []byte
+)
+
+func file_api_review_v1_error_reason_proto_rawDescGZIP() []byte {
+	file_api_review_v1_error_reason_proto_rawDescOnce.Do(func() {
+		file_api_review_v1_error_reason_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_review_v1_error_reason_proto_rawDesc), len(file_api_review_v1_error_reason_proto_rawDesc)))
+	})
+	return file_api_review_v1_error_reason_proto_rawDescData
+}
+
+var file_api_review_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_review_v1_error_reason_proto_goTypes = []any{
+	(ErrorReason)(0), // 0: api.review.v1.ErrorReason
+}
+var file_api_review_v1_error_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_review_v1_error_reason_proto_init() }
+func file_api_review_v1_error_reason_proto_init() {
+	if File_api_review_v1_error_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_review_v1_error_reason_proto_rawDesc), len(file_api_review_v1_error_reason_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_review_v1_error_reason_proto_goTypes,
+		DependencyIndexes: file_api_review_v1_error_reason_proto_depIdxs,
+		EnumInfos:         file_api_review_v1_error_reason_proto_enumTypes,
+	}.Build()
+	File_api_review_v1_error_reason_proto = out.File
+	file_api_review_v1_error_reason_proto_goTypes = nil
+	file_api_review_v1_error_reason_proto_depIdxs = nil
+}
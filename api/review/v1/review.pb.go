@@ -28,6 +28,7 @@ type ListReviewByStoreIdRequest struct {
 	StoreId       int64                  `protobuf:"varint,1,opt,name=storeId,proto3" json:"storeId,omitempty"`
 	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"` // 带有所有这些标签的评价
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -83,19 +84,43 @@ func (x *ListReviewByStoreIdRequest) GetSize() int32 {
 	return 0
 }
 
+func (x *ListReviewByStoreIdRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type ReviewInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
-	OrderId       int64                  `protobuf:"varint,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
-	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
-	ServiceScore  int32                  `protobuf:"varint,5,opt,name=serviceScore,proto3" json:"serviceScore,omitempty"`
-	ExpressScore  int32                  `protobuf:"varint,6,opt,name=expressScore,proto3" json:"expressScore,omitempty"`
-	Content       string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
-	PicInfo       string                 `protobuf:"bytes,8,opt,name=picInfo,proto3" json:"picInfo,omitempty"`
-	VideoInfo     string                 `protobuf:"bytes,9,opt,name=videoInfo,proto3" json:"videoInfo,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId       int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrderId        int64                  `protobuf:"varint,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Score          int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	ServiceScore   int32                  `protobuf:"varint,5,opt,name=serviceScore,proto3" json:"serviceScore,omitempty"`
+	ExpressScore   int32                  `protobuf:"varint,6,opt,name=expressScore,proto3" json:"expressScore,omitempty"`
+	Content        string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	Media          []*MediaItem           `protobuf:"bytes,26,rep,name=media,proto3" json:"media,omitempty"` // 图片和视频
+	StoreId        int64                  `protobuf:"varint,10,opt,name=storeId,proto3" json:"storeId,omitempty"`
+	SkuId          int64                  `protobuf:"varint,11,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	SpuId          int64                  `protobuf:"varint,12,opt,name=spuId,proto3" json:"spuId,omitempty"`
+	Status         int32                  `protobuf:"varint,13,opt,name=status,proto3" json:"status,omitempty"`
+	Anonymous      bool                   `protobuf:"varint,14,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	HasMedia       bool                   `protobuf:"varint,15,opt,name=hasMedia,proto3" json:"hasMedia,omitempty"`
+	HasReply       bool                   `protobuf:"varint,16,opt,name=hasReply,proto3" json:"hasReply,omitempty"`
+	IsDefault      bool                   `protobuf:"varint,17,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	OpReason       string                 `protobuf:"bytes,18,opt,name=opReason,proto3" json:"opReason,omitempty"`
+	OpRemarks      string                 `protobuf:"bytes,19,opt,name=opRemarks,proto3" json:"opRemarks,omitempty"`
+	OpUser         string                 `protobuf:"bytes,20,opt,name=opUser,proto3" json:"opUser,omitempty"`
+	GoodsSnapshoot string                 `protobuf:"bytes,21,opt,name=goodsSnapshoot,proto3" json:"goodsSnapshoot,omitempty"`
+	Version        int32                  `protobuf:"varint,22,opt,name=version,proto3" json:"version,omitempty"`
+	CreateAt       string                 `protobuf:"bytes,23,opt,name=createAt,proto3" json:"createAt,omitempty"`
+	UpdateAt       string                 `protobuf:"bytes,24,opt,name=updateAt,proto3" json:"updateAt,omitempty"`
+	UserAlias      string                 `protobuf:"bytes,25,opt,name=userAlias,proto3" json:"userAlias,omitempty"` // 匿名评价对调用方隐藏用户时展示的名字,此时userId为0
+	Tags           []string               `protobuf:"bytes,27,rep,name=tags,proto3" json:"tags,omitempty"`           // 标签,包括用户选择的和根据内容自动打的
+	Append         *ReviewAppendInfo      `protobuf:"bytes,28,opt,name=append,proto3" json:"append,omitempty"`       // 追评,没有追评或者追评对调用方不可见时为空
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ReviewInfo) Reset() {
@@ -177,41 +202,167 @@ func (x *ReviewInfo) GetContent() string {
 	return ""
 }
 
-func (x *ReviewInfo) GetPicInfo() string {
+func (x *ReviewInfo) GetMedia() []*MediaItem {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *ReviewInfo) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetSpuId() int64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *ReviewInfo) GetHasMedia() bool {
+	if x != nil {
+		return x.HasMedia
+	}
+	return false
+}
+
+func (x *ReviewInfo) GetHasReply() bool {
+	if x != nil {
+		return x.HasReply
+	}
+	return false
+}
+
+func (x *ReviewInfo) GetIsDefault() bool {
 	if x != nil {
-		return x.PicInfo
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *ReviewInfo) GetOpReason() string {
+	if x != nil {
+		return x.OpReason
 	}
 	return ""
 }
 
-func (x *ReviewInfo) GetVideoInfo() string {
+func (x *ReviewInfo) GetOpRemarks() string {
 	if x != nil {
-		return x.VideoInfo
+		return x.OpRemarks
 	}
 	return ""
 }
 
-type ListReviewByStoreIdReply struct {
+func (x *ReviewInfo) GetOpUser() string {
+	if x != nil {
+		return x.OpUser
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetGoodsSnapshoot() string {
+	if x != nil {
+		return x.GoodsSnapshoot
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetUpdateAt() string {
+	if x != nil {
+		return x.UpdateAt
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetUserAlias() string {
+	if x != nil {
+		return x.UserAlias
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ReviewInfo) GetAppend() *ReviewAppendInfo {
+	if x != nil {
+		return x.Append
+	}
+	return nil
+}
+
+// 追评信息,审核通过前只有追评的用户和运营可见
+type ReviewAppendInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*ReviewInfo          `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	AppendId      int64                  `protobuf:"varint,1,opt,name=appendId,proto3" json:"appendId,omitempty"`
+	ReviewId      int64                  `protobuf:"varint,2,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Media         []*MediaItem           `protobuf:"bytes,4,rep,name=media,proto3" json:"media,omitempty"` // 图片和视频
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	OpReason      string                 `protobuf:"bytes,6,opt,name=opReason,proto3" json:"opReason,omitempty"`
+	CreateAt      string                 `protobuf:"bytes,7,opt,name=createAt,proto3" json:"createAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReviewByStoreIdReply) Reset() {
-	*x = ListReviewByStoreIdReply{}
+func (x *ReviewAppendInfo) Reset() {
+	*x = ReviewAppendInfo{}
 	mi := &file_api_review_v1_review_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReviewByStoreIdReply) String() string {
+func (x *ReviewAppendInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReviewByStoreIdReply) ProtoMessage() {}
+func (*ReviewAppendInfo) ProtoMessage() {}
 
-func (x *ListReviewByStoreIdReply) ProtoReflect() protoreflect.Message {
+func (x *ReviewAppendInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_api_review_v1_review_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -223,49 +374,2093 @@ func (x *ListReviewByStoreIdReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReviewByStoreIdReply.ProtoReflect.Descriptor instead.
-func (*ListReviewByStoreIdReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReviewAppendInfo.ProtoReflect.Descriptor instead.
+func (*ReviewAppendInfo) Descriptor() ([]byte, []int) {
 	return file_api_review_v1_review_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ListReviewByStoreIdReply) GetList() []*ReviewInfo {
+func (x *ReviewAppendInfo) GetAppendId() int64 {
 	if x != nil {
-		return x.List
+		return x.AppendId
+	}
+	return 0
+}
+
+func (x *ReviewAppendInfo) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReviewAppendInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReviewAppendInfo) GetMedia() []*MediaItem {
+	if x != nil {
+		return x.Media
 	}
 	return nil
 }
 
-// 创建评价的参数
-type CreateReviewRequest struct {
+func (x *ReviewAppendInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ReviewAppendInfo) GetOpReason() string {
+	if x != nil {
+		return x.OpReason
+	}
+	return ""
+}
+
+func (x *ReviewAppendInfo) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
+}
+
+// 评价、回复、申诉中的图片或视频
+type MediaItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	OrderId       int64                  `protobuf:"varint,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
-	Score         int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
-	ServiceScore  int32                  `protobuf:"varint,4,opt,name=serviceScore,proto3" json:"serviceScore,omitempty"`
-	ExpressScore  int32                  `protobuf:"varint,5,opt,name=expressScore,proto3" json:"expressScore,omitempty"`
-	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
-	PicInfo       string                 `protobuf:"bytes,7,opt,name=picInfo,proto3" json:"picInfo,omitempty"`
-	VideoInfo     string                 `protobuf:"bytes,8,opt,name=videoInfo,proto3" json:"videoInfo,omitempty"`
-	Anonymous     bool                   `protobuf:"varint,9,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Width         int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Duration      int32                  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"` // 视频时长,单位秒
+	Cover         string                 `protobuf:"bytes,6,opt,name=cover,proto3" json:"cover,omitempty"`        // 视频封面
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaItem) Reset() {
+	*x = MediaItem{}
+	mi := &file_api_review_v1_review_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaItem) ProtoMessage() {}
+
+func (x *MediaItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaItem.ProtoReflect.Descriptor instead.
+func (*MediaItem) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MediaItem) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MediaItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MediaItem) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *MediaItem) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MediaItem) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *MediaItem) GetCover() string {
+	if x != nil {
+		return x.Cover
+	}
+	return ""
+}
+
+// 商家回复信息
+type ReviewReplyInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReplyId       int64                  `protobuf:"varint,1,opt,name=replyId,proto3" json:"replyId,omitempty"`
+	ReviewId      int64                  `protobuf:"varint,2,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	StoreId       int64                  `protobuf:"varint,3,opt,name=storeId,proto3" json:"storeId,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Media         []*MediaItem           `protobuf:"bytes,8,rep,name=media,proto3" json:"media,omitempty"` // 图片和视频
+	CreateAt      string                 `protobuf:"bytes,7,opt,name=createAt,proto3" json:"createAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateReviewRequest) Reset() {
-	*x = CreateReviewRequest{}
-	mi := &file_api_review_v1_review_proto_msgTypes[3]
+func (x *ReviewReplyInfo) Reset() {
+	*x = ReviewReplyInfo{}
+	mi := &file_api_review_v1_review_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewReplyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewReplyInfo) ProtoMessage() {}
+
+func (x *ReviewReplyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewReplyInfo.ProtoReflect.Descriptor instead.
+func (*ReviewReplyInfo) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReviewReplyInfo) GetReplyId() int64 {
+	if x != nil {
+		return x.ReplyId
+	}
+	return 0
+}
+
+func (x *ReviewReplyInfo) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReviewReplyInfo) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *ReviewReplyInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReviewReplyInfo) GetMedia() []*MediaItem {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *ReviewReplyInfo) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
+}
+
+// 商家申诉信息
+type ReviewAppealInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppealId      int64                  `protobuf:"varint,1,opt,name=appealId,proto3" json:"appealId,omitempty"`
+	ReviewId      int64                  `protobuf:"varint,2,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	StoreId       int64                  `protobuf:"varint,3,opt,name=storeId,proto3" json:"storeId,omitempty"`
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	Media         []*MediaItem           `protobuf:"bytes,12,rep,name=media,proto3" json:"media,omitempty"` // 图片和视频
+	OpRemarks     string                 `protobuf:"bytes,9,opt,name=opRemarks,proto3" json:"opRemarks,omitempty"`
+	OpUser        string                 `protobuf:"bytes,10,opt,name=opUser,proto3" json:"opUser,omitempty"`
+	CreateAt      string                 `protobuf:"bytes,11,opt,name=createAt,proto3" json:"createAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAppealInfo) Reset() {
+	*x = ReviewAppealInfo{}
+	mi := &file_api_review_v1_review_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAppealInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAppealInfo) ProtoMessage() {}
+
+func (x *ReviewAppealInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAppealInfo.ProtoReflect.Descriptor instead.
+func (*ReviewAppealInfo) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReviewAppealInfo) GetAppealId() int64 {
+	if x != nil {
+		return x.AppealId
+	}
+	return 0
+}
+
+func (x *ReviewAppealInfo) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReviewAppealInfo) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *ReviewAppealInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ReviewAppealInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReviewAppealInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReviewAppealInfo) GetMedia() []*MediaItem {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *ReviewAppealInfo) GetOpRemarks() string {
+	if x != nil {
+		return x.OpRemarks
+	}
+	return ""
+}
+
+func (x *ReviewAppealInfo) GetOpUser() string {
+	if x != nil {
+		return x.OpUser
+	}
+	return ""
+}
+
+func (x *ReviewAppealInfo) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
+}
+
+type ListReviewByStoreIdReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*ReviewInfo          `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewByStoreIdReply) Reset() {
+	*x = ListReviewByStoreIdReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewByStoreIdReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewByStoreIdReply) ProtoMessage() {}
+
+func (x *ListReviewByStoreIdReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewByStoreIdReply.ProtoReflect.Descriptor instead.
+func (*ListReviewByStoreIdReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListReviewByStoreIdReply) GetList() []*ReviewInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 创建评价的参数
+type CreateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"` // 已废弃,以登录的用户身份为准
+	OrderId       int64                  `protobuf:"varint,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Score         int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	ServiceScore  int32                  `protobuf:"varint,4,opt,name=serviceScore,proto3" json:"serviceScore,omitempty"`
+	ExpressScore  int32                  `protobuf:"varint,5,opt,name=expressScore,proto3" json:"expressScore,omitempty"`
+	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	Media         []*MediaItem           `protobuf:"bytes,10,rep,name=media,proto3" json:"media,omitempty"` // 图片和视频
+	Anonymous     bool                   `protobuf:"varint,9,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	Tags          []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"` // 用户选择的标签,必须是标签词典中的
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewRequest) Reset() {
+	*x = CreateReviewRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewRequest) ProtoMessage() {}
+
+func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetServiceScore() int32 {
+	if x != nil {
+		return x.ServiceScore
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetExpressScore() int32 {
+	if x != nil {
+		return x.ExpressScore
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetMedia() []*MediaItem {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *CreateReviewRequest) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *CreateReviewRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// 创建评价的回复
+type CreateReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewReply) Reset() {
+	*x = CreateReviewReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewReply) ProtoMessage() {}
+
+func (x *CreateReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewReply.ProtoReflect.Descriptor instead.
+func (*CreateReviewReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateReviewReply) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+type TestConnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestConnRequest) Reset() {
+	*x = TestConnRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestConnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestConnRequest) ProtoMessage() {}
+
+func (x *TestConnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestConnRequest.ProtoReflect.Descriptor instead.
+func (*TestConnRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{9}
+}
+
+// 回复评价的请求
+type ReplyReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	StoreId       int64                  `protobuf:"varint,2,opt,name=storeId,proto3" json:"storeId,omitempty"` // 已废弃,以登录的商家身份为准
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Media         []*MediaItem           `protobuf:"bytes,6,rep,name=media,proto3" json:"media,omitempty"` // 图片和视频
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyReviewRequest) Reset() {
+	*x = ReplyReviewRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyReviewRequest) ProtoMessage() {}
+
+func (x *ReplyReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReplyReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReplyReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReplyReviewRequest) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *ReplyReviewRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReplyReviewRequest) GetMedia() []*MediaItem {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// 回复评价的返回值
+type ReplyReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReplyId       int64                  `protobuf:"varint,1,opt,name=replyId,proto3" json:"replyId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyReviewReply) Reset() {
+	*x = ReplyReviewReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyReviewReply) ProtoMessage() {}
+
+func (x *ReplyReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyReviewReply.ProtoReflect.Descriptor instead.
+func (*ReplyReviewReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReplyReviewReply) GetReplyId() int64 {
+	if x != nil {
+		return x.ReplyId
+	}
+	return 0
+}
+
+type TestConnReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pong          string                 `protobuf:"bytes,1,opt,name=pong,proto3" json:"pong,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestConnReply) Reset() {
+	*x = TestConnReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestConnReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestConnReply) ProtoMessage() {}
+
+func (x *TestConnReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestConnReply.ProtoReflect.Descriptor instead.
+func (*TestConnReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TestConnReply) GetPong() string {
+	if x != nil {
+		return x.Pong
+	}
+	return ""
+}
+
+type AppealReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	StoreId       int64                  `protobuf:"varint,2,opt,name=storeId,proto3" json:"storeId,omitempty"` // 已废弃,以登录的商家身份为准
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Media         []*MediaItem           `protobuf:"bytes,8,rep,name=media,proto3" json:"media,omitempty"`   // 图片和视频
+	OpUser        string                 `protobuf:"bytes,6,opt,name=opUser,proto3" json:"opUser,omitempty"` // 已废弃,审核人在运营审核申诉时记录
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealReviewRequest) Reset() {
+	*x = AppealReviewRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealReviewRequest) ProtoMessage() {}
+
+func (x *AppealReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealReviewRequest.ProtoReflect.Descriptor instead.
+func (*AppealReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AppealReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *AppealReviewRequest) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *AppealReviewRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AppealReviewRequest) GetMedia() []*MediaItem {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *AppealReviewRequest) GetOpUser() string {
+	if x != nil {
+		return x.OpUser
+	}
+	return ""
+}
+
+func (x *AppealReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AppealReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppealId      int64                  `protobuf:"varint,1,opt,name=appealId,proto3" json:"appealId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealReviewReply) Reset() {
+	*x = AppealReviewReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealReviewReply) ProtoMessage() {}
+
+func (x *AppealReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealReviewReply.ProtoReflect.Descriptor instead.
+func (*AppealReviewReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AppealReviewReply) GetAppealId() int64 {
+	if x != nil {
+		return x.AppealId
+	}
+	return 0
+}
+
+// 运营审核评价的请求
+type AuditReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`    // 20审核通过;30审核不通过
+	OpUser        string                 `protobuf:"bytes,3,opt,name=opUser,proto3" json:"opUser,omitempty"`     // 已废弃,以登录的运营身份为准
+	OpReason      string                 `protobuf:"bytes,4,opt,name=opReason,proto3" json:"opReason,omitempty"` // 审核不通过时必填
+	OpRemarks     *string                `protobuf:"bytes,5,opt,name=opRemarks,proto3,oneof" json:"opRemarks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditReviewRequest) Reset() {
+	*x = AuditReviewRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditReviewRequest) ProtoMessage() {}
+
+func (x *AuditReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditReviewRequest.ProtoReflect.Descriptor instead.
+func (*AuditReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AuditReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *AuditReviewRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AuditReviewRequest) GetOpUser() string {
+	if x != nil {
+		return x.OpUser
+	}
+	return ""
+}
+
+func (x *AuditReviewRequest) GetOpReason() string {
+	if x != nil {
+		return x.OpReason
+	}
+	return ""
+}
+
+func (x *AuditReviewRequest) GetOpRemarks() string {
+	if x != nil && x.OpRemarks != nil {
+		return *x.OpRemarks
+	}
+	return ""
+}
+
+// 运营审核评价的返回值
+type AuditReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditReviewReply) Reset() {
+	*x = AuditReviewReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditReviewReply) ProtoMessage() {}
+
+func (x *AuditReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditReviewReply.ProtoReflect.Descriptor instead.
+func (*AuditReviewReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AuditReviewReply) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *AuditReviewReply) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type AuditAppealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppealId      int64                  `protobuf:"varint,1,opt,name=appealId,proto3" json:"appealId,omitempty"`
+	ReviewId      int64                  `protobuf:"varint,2,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	OpUser        string                 `protobuf:"bytes,4,opt,name=opUser,proto3" json:"opUser,omitempty"` // 已废弃,以登录的运营身份为准
+	OpReason      string                 `protobuf:"bytes,5,opt,name=opReason,proto3" json:"opReason,omitempty"`
+	OpRemarks     *string                `protobuf:"bytes,6,opt,name=opRemarks,proto3,oneof" json:"opRemarks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditAppealRequest) Reset() {
+	*x = AuditAppealRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditAppealRequest) ProtoMessage() {}
+
+func (x *AuditAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditAppealRequest.ProtoReflect.Descriptor instead.
+func (*AuditAppealRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AuditAppealRequest) GetAppealId() int64 {
+	if x != nil {
+		return x.AppealId
+	}
+	return 0
+}
+
+func (x *AuditAppealRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *AuditAppealRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AuditAppealRequest) GetOpUser() string {
+	if x != nil {
+		return x.OpUser
+	}
+	return ""
+}
+
+func (x *AuditAppealRequest) GetOpReason() string {
+	if x != nil {
+		return x.OpReason
+	}
+	return ""
+}
+
+func (x *AuditAppealRequest) GetOpRemarks() string {
+	if x != nil && x.OpRemarks != nil {
+		return *x.OpRemarks
+	}
+	return ""
+}
+
+type AuditAppealReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditAppealReply) Reset() {
+	*x = AuditAppealReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditAppealReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditAppealReply) ProtoMessage() {}
+
+func (x *AuditAppealReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditAppealReply.ProtoReflect.Descriptor instead.
+func (*AuditAppealReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{18}
+}
+
+// 修改评价的请求
+type UpdateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`   // 已废弃,以登录的用户身份为准
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // 查询评价时拿到的版本号
+	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	ServiceScore  int32                  `protobuf:"varint,5,opt,name=serviceScore,proto3" json:"serviceScore,omitempty"`
+	ExpressScore  int32                  `protobuf:"varint,6,opt,name=expressScore,proto3" json:"expressScore,omitempty"`
+	Content       string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	Media         []*MediaItem           `protobuf:"bytes,10,rep,name=media,proto3" json:"media,omitempty"` // 图片和视频
+	Tags          []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`   // 用户选择的标签,和创建评价一样会根据修改后的内容重新自动打标签
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReviewRequest) Reset() {
+	*x = UpdateReviewRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewRequest) ProtoMessage() {}
+
+func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetServiceScore() int32 {
+	if x != nil {
+		return x.ServiceScore
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetExpressScore() int32 {
+	if x != nil {
+		return x.ExpressScore
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateReviewRequest) GetMedia() []*MediaItem {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *UpdateReviewRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// 修改评价的返回值
+type UpdateReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 修改后的版本号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReviewReply) Reset() {
+	*x = UpdateReviewReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewReply) ProtoMessage() {}
+
+func (x *UpdateReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewReply.ProtoReflect.Descriptor instead.
+func (*UpdateReviewReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateReviewReply) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *UpdateReviewReply) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// 删除评价的请求
+type DeleteReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"` // 已废弃,用户只能删除自己的评价,运营可以删除任意评价
+	OpUser        string                 `protobuf:"bytes,3,opt,name=opUser,proto3" json:"opUser,omitempty"`  // 已废弃
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewRequest) Reset() {
+	*x = DeleteReviewRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewRequest) ProtoMessage() {}
+
+func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *DeleteReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteReviewRequest) GetOpUser() string {
+	if x != nil {
+		return x.OpUser
+	}
+	return ""
+}
+
+// 删除评价的返回值
+type DeleteReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewReply) Reset() {
+	*x = DeleteReviewReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewReply) ProtoMessage() {}
+
+func (x *DeleteReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewReply.ProtoReflect.Descriptor instead.
+func (*DeleteReviewReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{22}
+}
+
+// 查询评价详情的请求
+type GetReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewRequest) Reset() {
+	*x = GetReviewRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewRequest) ProtoMessage() {}
+
+func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+// 查询评价详情的返回值
+type GetReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *ReviewInfo            `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	Reply         *ReviewReplyInfo       `protobuf:"bytes,2,opt,name=reply,proto3" json:"reply,omitempty"`   // 商家回复,没有回复时为空
+	Appeal        *ReviewAppealInfo      `protobuf:"bytes,3,opt,name=appeal,proto3" json:"appeal,omitempty"` // 商家申诉,没有申诉时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewReply) Reset() {
+	*x = GetReviewReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewReply) ProtoMessage() {}
+
+func (x *GetReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewReply.ProtoReflect.Descriptor instead.
+func (*GetReviewReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetReviewReply) GetReview() *ReviewInfo {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *GetReviewReply) GetReply() *ReviewReplyInfo {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *GetReviewReply) GetAppeal() *ReviewAppealInfo {
+	if x != nil {
+		return x.Appeal
+	}
+	return nil
+}
+
+// 评价列表的请求,筛选条件不传表示不限制
+type ListReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	SpuId         int64                  `protobuf:"varint,2,opt,name=spuId,proto3" json:"spuId,omitempty"`
+	SkuId         int64                  `protobuf:"varint,3,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	StoreId       int64                  `protobuf:"varint,4,opt,name=storeId,proto3" json:"storeId,omitempty"`
+	Status        *int32                 `protobuf:"varint,5,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	MinScore      int32                  `protobuf:"varint,6,opt,name=minScore,proto3" json:"minScore,omitempty"`
+	MaxScore      int32                  `protobuf:"varint,7,opt,name=maxScore,proto3" json:"maxScore,omitempty"`
+	HasMedia      *bool                  `protobuf:"varint,8,opt,name=hasMedia,proto3,oneof" json:"hasMedia,omitempty"`
+	HasReply      *bool                  `protobuf:"varint,9,opt,name=hasReply,proto3,oneof" json:"hasReply,omitempty"`
+	StartTime     string                 `protobuf:"bytes,10,opt,name=startTime,proto3" json:"startTime,omitempty"` // 创建时间范围,格式: 2006-01-02 15:04:05
+	EndTime       string                 `protobuf:"bytes,11,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	SortBy        string                 `protobuf:"bytes,12,opt,name=sortBy,proto3" json:"sortBy,omitempty"`       // 排序字段,默认按时间
+	Asc           bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`            // 默认倒序
+	PageToken     string                 `protobuf:"bytes,14,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken,查第一页时不传
+	Size          int32                  `protobuf:"varint,15,opt,name=size,proto3" json:"size,omitempty"`
+	Tags          []string               `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"` // 带有所有这些标签的评价
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewRequest) Reset() {
+	*x = ListReviewRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewRequest) ProtoMessage() {}
+
+func (x *ListReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListReviewRequest) GetSpuId() int64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *ListReviewRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ListReviewRequest) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *ListReviewRequest) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *ListReviewRequest) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *ListReviewRequest) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *ListReviewRequest) GetHasMedia() bool {
+	if x != nil && x.HasMedia != nil {
+		return *x.HasMedia
+	}
+	return false
+}
+
+func (x *ListReviewRequest) GetHasReply() bool {
+	if x != nil && x.HasReply != nil {
+		return *x.HasReply
+	}
+	return false
+}
+
+func (x *ListReviewRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ListReviewRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ListReviewRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListReviewRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+func (x *ListReviewRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListReviewRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListReviewRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// 评价列表的返回值
+type ListReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*ReviewInfo          `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 为空表示没有下一页了
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewReply) Reset() {
+	*x = ListReviewReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewReply) ProtoMessage() {}
+
+func (x *ListReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewReply.ProtoReflect.Descriptor instead.
+func (*ListReviewReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListReviewReply) GetList() []*ReviewInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListReviewReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListReviewReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// 搜索评价的请求
+type SearchReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	StoreId       int64                  `protobuf:"varint,2,opt,name=storeId,proto3" json:"storeId,omitempty"`
+	SpuId         int64                  `protobuf:"varint,3,opt,name=spuId,proto3" json:"spuId,omitempty"`
+	MinScore      int32                  `protobuf:"varint,4,opt,name=minScore,proto3" json:"minScore,omitempty"`
+	MaxScore      int32                  `protobuf:"varint,5,opt,name=maxScore,proto3" json:"maxScore,omitempty"`
+	Status        *int32                 `protobuf:"varint,6,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	PageToken     string                 `protobuf:"bytes,7,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken,查第一页时不传
+	Size          int32                  `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"` // 带有所有这些标签的评价
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchReviewsRequest) Reset() {
+	*x = SearchReviewsRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReviewsRequest) ProtoMessage() {}
+
+func (x *SearchReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReviewsRequest.ProtoReflect.Descriptor instead.
+func (*SearchReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchReviewsRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchReviewsRequest) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *SearchReviewsRequest) GetSpuId() int64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *SearchReviewsRequest) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *SearchReviewsRequest) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *SearchReviewsRequest) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *SearchReviewsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchReviewsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SearchReviewsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// 搜索命中的评价,高亮片段中命中的关键词用<em></em>包裹
+type SearchReviewHit struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Review             *ReviewInfo            `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	ContentHighlight   []string               `protobuf:"bytes,2,rep,name=contentHighlight,proto3" json:"contentHighlight,omitempty"`
+	TagsHighlight      []string               `protobuf:"bytes,3,rep,name=tagsHighlight,proto3" json:"tagsHighlight,omitempty"`
+	OpRemarksHighlight []string               `protobuf:"bytes,4,rep,name=opRemarksHighlight,proto3" json:"opRemarksHighlight,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SearchReviewHit) Reset() {
+	*x = SearchReviewHit{}
+	mi := &file_api_review_v1_review_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchReviewHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReviewHit) ProtoMessage() {}
+
+func (x *SearchReviewHit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReviewHit.ProtoReflect.Descriptor instead.
+func (*SearchReviewHit) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchReviewHit) GetReview() *ReviewInfo {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *SearchReviewHit) GetContentHighlight() []string {
+	if x != nil {
+		return x.ContentHighlight
+	}
+	return nil
+}
+
+func (x *SearchReviewHit) GetTagsHighlight() []string {
+	if x != nil {
+		return x.TagsHighlight
+	}
+	return nil
+}
+
+func (x *SearchReviewHit) GetOpRemarksHighlight() []string {
+	if x != nil {
+		return x.OpRemarksHighlight
+	}
+	return nil
+}
+
+// 搜索评价的响应,按相关度排序
+type SearchReviewsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*SearchReviewHit     `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 为空表示没有下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchReviewsReply) Reset() {
+	*x = SearchReviewsReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchReviewsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReviewsReply) ProtoMessage() {}
+
+func (x *SearchReviewsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReviewsReply.ProtoReflect.Descriptor instead.
+func (*SearchReviewsReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SearchReviewsReply) GetList() []*SearchReviewHit {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *SearchReviewsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchReviewsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// 店铺评分汇总的请求,只统计审核通过且未删除的评价
+type GetStoreRatingSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreId       int64                  `protobuf:"varint,1,opt,name=storeId,proto3" json:"storeId,omitempty"`
+	StartTime     string                 `protobuf:"bytes,2,opt,name=startTime,proto3" json:"startTime,omitempty"` // 创建时间范围,格式: 2006-01-02 15:04:05,不传表示不限制
+	EndTime       string                 `protobuf:"bytes,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStoreRatingSummaryRequest) Reset() {
+	*x = GetStoreRatingSummaryRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStoreRatingSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoreRatingSummaryRequest) ProtoMessage() {}
+
+func (x *GetStoreRatingSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoreRatingSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetStoreRatingSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetStoreRatingSummaryRequest) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *GetStoreRatingSummaryRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GetStoreRatingSummaryRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+// 某个星级的评价数
+type ScoreCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score         int32                  `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreCount) Reset() {
+	*x = ScoreCount{}
+	mi := &file_api_review_v1_review_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreCount) ProtoMessage() {}
+
+func (x *ScoreCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreCount.ProtoReflect.Descriptor instead.
+func (*ScoreCount) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ScoreCount) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ScoreCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 店铺评分汇总的返回值
+type GetStoreRatingSummaryReply struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StoreId           int64                  `protobuf:"varint,1,opt,name=storeId,proto3" json:"storeId,omitempty"`
+	Total             int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`        // 评价总数
+	AvgScore          float64                `protobuf:"fixed64,3,opt,name=avgScore,proto3" json:"avgScore,omitempty"` // 平均分,保留两位小数
+	AvgServiceScore   float64                `protobuf:"fixed64,4,opt,name=avgServiceScore,proto3" json:"avgServiceScore,omitempty"`
+	AvgExpressScore   float64                `protobuf:"fixed64,5,opt,name=avgExpressScore,proto3" json:"avgExpressScore,omitempty"`
+	ScoreDistribution []*ScoreCount          `protobuf:"bytes,6,rep,name=scoreDistribution,proto3" json:"scoreDistribution,omitempty"` // 1-5星的评价数,按星级从高到低,没有评价的星级count为0
+	MediaCount        int64                  `protobuf:"varint,7,opt,name=mediaCount,proto3" json:"mediaCount,omitempty"`              // 带图/视频的评价数
+	ReplyCount        int64                  `protobuf:"varint,8,opt,name=replyCount,proto3" json:"replyCount,omitempty"`              // 商家已回复的评价数
+	ReplyRate         float64                `protobuf:"fixed64,9,opt,name=replyRate,proto3" json:"replyRate,omitempty"`               // 回复率,replyCount/total,保留四位小数
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetStoreRatingSummaryReply) Reset() {
+	*x = GetStoreRatingSummaryReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateReviewRequest) String() string {
+func (x *GetStoreRatingSummaryReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReviewRequest) ProtoMessage() {}
+func (*GetStoreRatingSummaryReply) ProtoMessage() {}
 
-func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[3]
+func (x *GetStoreRatingSummaryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -276,97 +2471,103 @@ func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
-func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetStoreRatingSummaryReply.ProtoReflect.Descriptor instead.
+func (*GetStoreRatingSummaryReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CreateReviewRequest) GetUserId() int64 {
+func (x *GetStoreRatingSummaryReply) GetStoreId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.StoreId
 	}
 	return 0
 }
 
-func (x *CreateReviewRequest) GetOrderId() int64 {
+func (x *GetStoreRatingSummaryReply) GetTotal() int64 {
 	if x != nil {
-		return x.OrderId
+		return x.Total
 	}
 	return 0
 }
 
-func (x *CreateReviewRequest) GetScore() int32 {
+func (x *GetStoreRatingSummaryReply) GetAvgScore() float64 {
 	if x != nil {
-		return x.Score
+		return x.AvgScore
 	}
 	return 0
 }
 
-func (x *CreateReviewRequest) GetServiceScore() int32 {
+func (x *GetStoreRatingSummaryReply) GetAvgServiceScore() float64 {
 	if x != nil {
-		return x.ServiceScore
+		return x.AvgServiceScore
 	}
 	return 0
 }
 
-func (x *CreateReviewRequest) GetExpressScore() int32 {
+func (x *GetStoreRatingSummaryReply) GetAvgExpressScore() float64 {
 	if x != nil {
-		return x.ExpressScore
+		return x.AvgExpressScore
 	}
 	return 0
 }
 
-func (x *CreateReviewRequest) GetContent() string {
+func (x *GetStoreRatingSummaryReply) GetScoreDistribution() []*ScoreCount {
 	if x != nil {
-		return x.Content
+		return x.ScoreDistribution
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateReviewRequest) GetPicInfo() string {
+func (x *GetStoreRatingSummaryReply) GetMediaCount() int64 {
 	if x != nil {
-		return x.PicInfo
+		return x.MediaCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateReviewRequest) GetVideoInfo() string {
+func (x *GetStoreRatingSummaryReply) GetReplyCount() int64 {
 	if x != nil {
-		return x.VideoInfo
+		return x.ReplyCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateReviewRequest) GetAnonymous() bool {
+func (x *GetStoreRatingSummaryReply) GetReplyRate() float64 {
 	if x != nil {
-		return x.Anonymous
+		return x.ReplyRate
 	}
-	return false
+	return 0
 }
 
-// 创建评价的回复
-type CreateReviewReply struct {
+// 商品评价列表的请求,只返回审核通过的评价
+// tab: all 全部, media 有图/视频, positive 好评(4-5星), neutral 中评(3星), negative 差评(1-2星),默认all
+type ListReviewBySpuRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	SpuId         int64                  `protobuf:"varint,1,opt,name=spuId,proto3" json:"spuId,omitempty"`
+	SkuId         int64                  `protobuf:"varint,2,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	Tab           string                 `protobuf:"bytes,3,opt,name=tab,proto3" json:"tab,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"` // 上一页返回的nextPageToken,查第一页时不传
+	Size          int32                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"` // 带有所有这些标签的评价,tab的评价数也只统计这些评价
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateReviewReply) Reset() {
-	*x = CreateReviewReply{}
-	mi := &file_api_review_v1_review_proto_msgTypes[4]
+func (x *ListReviewBySpuRequest) Reset() {
+	*x = ListReviewBySpuRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateReviewReply) String() string {
+func (x *ListReviewBySpuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReviewReply) ProtoMessage() {}
+func (*ListReviewBySpuRequest) ProtoMessage() {}
 
-func (x *CreateReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[4]
+func (x *ListReviewBySpuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,39 +2578,77 @@ func (x *CreateReviewReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReviewReply.ProtoReflect.Descriptor instead.
-func (*CreateReviewReply) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListReviewBySpuRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewBySpuRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CreateReviewReply) GetReviewId() int64 {
+func (x *ListReviewBySpuRequest) GetSpuId() int64 {
 	if x != nil {
-		return x.ReviewId
+		return x.SpuId
 	}
 	return 0
 }
 
-type TestConnRequest struct {
+func (x *ListReviewBySpuRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ListReviewBySpuRequest) GetTab() string {
+	if x != nil {
+		return x.Tab
+	}
+	return ""
+}
+
+func (x *ListReviewBySpuRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListReviewBySpuRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListReviewBySpuRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// 某个tab下的评价数
+type ReviewTabCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tab           string                 `protobuf:"bytes,1,opt,name=tab,proto3" json:"tab,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TestConnRequest) Reset() {
-	*x = TestConnRequest{}
-	mi := &file_api_review_v1_review_proto_msgTypes[5]
+func (x *ReviewTabCount) Reset() {
+	*x = ReviewTabCount{}
+	mi := &file_api_review_v1_review_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestConnRequest) String() string {
+func (x *ReviewTabCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestConnRequest) ProtoMessage() {}
+func (*ReviewTabCount) ProtoMessage() {}
 
-func (x *TestConnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[5]
+func (x *ReviewTabCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,38 +2659,50 @@ func (x *TestConnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestConnRequest.ProtoReflect.Descriptor instead.
-func (*TestConnRequest) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ReviewTabCount.ProtoReflect.Descriptor instead.
+func (*ReviewTabCount) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{34}
 }
 
-// 回复评价的请求
-type ReplyReviewRequest struct {
+func (x *ReviewTabCount) GetTab() string {
+	if x != nil {
+		return x.Tab
+	}
+	return ""
+}
+
+func (x *ReviewTabCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 商品评价列表的返回值,按创建时间倒序
+type ListReviewBySpuReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
-	StoreId       int64                  `protobuf:"varint,2,opt,name=storeId,proto3" json:"storeId,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	PicInfo       string                 `protobuf:"bytes,4,opt,name=picInfo,proto3" json:"picInfo,omitempty"`
-	VideoInfo     string                 `protobuf:"bytes,5,opt,name=videoInfo,proto3" json:"videoInfo,omitempty"`
+	List          []*ReviewInfo          `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Tabs          []*ReviewTabCount      `protobuf:"bytes,2,rep,name=tabs,proto3" json:"tabs,omitempty"`                   // 所有tab的评价数,顺序固定: all,media,positive,neutral,negative
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 为空表示没有下一页
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReplyReviewRequest) Reset() {
-	*x = ReplyReviewRequest{}
-	mi := &file_api_review_v1_review_proto_msgTypes[6]
+func (x *ListReviewBySpuReply) Reset() {
+	*x = ListReviewBySpuReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReplyReviewRequest) String() string {
+func (x *ListReviewBySpuReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplyReviewRequest) ProtoMessage() {}
+func (*ListReviewBySpuReply) ProtoMessage() {}
 
-func (x *ReplyReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[6]
+func (x *ListReviewBySpuReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,69 +2713,57 @@ func (x *ReplyReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplyReviewRequest.ProtoReflect.Descriptor instead.
-func (*ReplyReviewRequest) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *ReplyReviewRequest) GetReviewId() int64 {
-	if x != nil {
-		return x.ReviewId
-	}
-	return 0
-}
-
-func (x *ReplyReviewRequest) GetStoreId() int64 {
-	if x != nil {
-		return x.StoreId
-	}
-	return 0
+// Deprecated: Use ListReviewBySpuReply.ProtoReflect.Descriptor instead.
+func (*ListReviewBySpuReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ReplyReviewRequest) GetContent() string {
+func (x *ListReviewBySpuReply) GetList() []*ReviewInfo {
 	if x != nil {
-		return x.Content
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-func (x *ReplyReviewRequest) GetPicInfo() string {
+func (x *ListReviewBySpuReply) GetTabs() []*ReviewTabCount {
 	if x != nil {
-		return x.PicInfo
+		return x.Tabs
 	}
-	return ""
+	return nil
 }
 
-func (x *ReplyReviewRequest) GetVideoInfo() string {
+func (x *ListReviewBySpuReply) GetNextPageToken() string {
 	if x != nil {
-		return x.VideoInfo
+		return x.NextPageToken
 	}
 	return ""
 }
 
-// 回复评价的返回值
-type ReplyReviewReply struct {
+// 用户评价列表的请求
+type ListReviewByUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReplyId       int64                  `protobuf:"varint,1,opt,name=replyId,proto3" json:"replyId,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"` // 用户查询时以登录的用户身份为准,运营查询时必填
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReplyReviewReply) Reset() {
-	*x = ReplyReviewReply{}
-	mi := &file_api_review_v1_review_proto_msgTypes[7]
+func (x *ListReviewByUserRequest) Reset() {
+	*x = ListReviewByUserRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReplyReviewReply) String() string {
+func (x *ListReviewByUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplyReviewReply) ProtoMessage() {}
+func (*ListReviewByUserRequest) ProtoMessage() {}
 
-func (x *ReplyReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[7]
+func (x *ListReviewByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,40 +2774,56 @@ func (x *ReplyReviewReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplyReviewReply.ProtoReflect.Descriptor instead.
-func (*ReplyReviewReply) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListReviewByUserRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewByUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ReplyReviewReply) GetReplyId() int64 {
+func (x *ListReviewByUserRequest) GetUserId() int64 {
 	if x != nil {
-		return x.ReplyId
+		return x.UserId
 	}
 	return 0
 }
 
-type TestConnReply struct {
+func (x *ListReviewByUserRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReviewByUserRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 用户的一条评价,审核不通过时review.opReason是驳回原因
+type UserReviewItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pong          string                 `protobuf:"bytes,1,opt,name=pong,proto3" json:"pong,omitempty"`
+	Review        *ReviewInfo            `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	Reply         *ReviewReplyInfo       `protobuf:"bytes,2,opt,name=reply,proto3" json:"reply,omitempty"` // 商家回复,没有回复时为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TestConnReply) Reset() {
-	*x = TestConnReply{}
-	mi := &file_api_review_v1_review_proto_msgTypes[8]
+func (x *UserReviewItem) Reset() {
+	*x = UserReviewItem{}
+	mi := &file_api_review_v1_review_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestConnReply) String() string {
+func (x *UserReviewItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestConnReply) ProtoMessage() {}
+func (*UserReviewItem) ProtoMessage() {}
 
-func (x *TestConnReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[8]
+func (x *UserReviewItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,46 +2834,49 @@ func (x *TestConnReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestConnReply.ProtoReflect.Descriptor instead.
-func (*TestConnReply) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use UserReviewItem.ProtoReflect.Descriptor instead.
+func (*UserReviewItem) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *TestConnReply) GetPong() string {
+func (x *UserReviewItem) GetReview() *ReviewInfo {
 	if x != nil {
-		return x.Pong
+		return x.Review
 	}
-	return ""
+	return nil
 }
 
-type AppealReviewRequest struct {
+func (x *UserReviewItem) GetReply() *ReviewReplyInfo {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+// 用户评价列表的返回值,按创建时间倒序
+type ListReviewByUserReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
-	StoreId       int64                  `protobuf:"varint,2,opt,name=storeId,proto3" json:"storeId,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	PicInfo       string                 `protobuf:"bytes,4,opt,name=picInfo,proto3" json:"picInfo,omitempty"`
-	VideoInfo     string                 `protobuf:"bytes,5,opt,name=videoInfo,proto3" json:"videoInfo,omitempty"`
-	OpUser        string                 `protobuf:"bytes,6,opt,name=opUser,proto3" json:"opUser,omitempty"`
-	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	List          []*UserReviewItem      `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AppealReviewRequest) Reset() {
-	*x = AppealReviewRequest{}
-	mi := &file_api_review_v1_review_proto_msgTypes[9]
+func (x *ListReviewByUserReply) Reset() {
+	*x = ListReviewByUserReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AppealReviewRequest) String() string {
+func (x *ListReviewByUserReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppealReviewRequest) ProtoMessage() {}
+func (*ListReviewByUserReply) ProtoMessage() {}
 
-func (x *AppealReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[9]
+func (x *ListReviewByUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,82 +2887,108 @@ func (x *AppealReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppealReviewRequest.ProtoReflect.Descriptor instead.
-func (*AppealReviewRequest) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListReviewByUserReply.ProtoReflect.Descriptor instead.
+func (*ListReviewByUserReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *AppealReviewRequest) GetReviewId() int64 {
+func (x *ListReviewByUserReply) GetList() []*UserReviewItem {
 	if x != nil {
-		return x.ReviewId
+		return x.List
 	}
-	return 0
+	return nil
 }
 
-func (x *AppealReviewRequest) GetStoreId() int64 {
+func (x *ListReviewByUserReply) GetTotal() int64 {
 	if x != nil {
-		return x.StoreId
+		return x.Total
 	}
 	return 0
 }
 
-func (x *AppealReviewRequest) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+// 要上传的一个文件
+type UploadFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"` // 比如image/jpeg
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`              // 文件大小,单位字节
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFile) Reset() {
+	*x = UploadFile{}
+	mi := &file_api_review_v1_review_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AppealReviewRequest) GetPicInfo() string {
+func (*UploadFile) ProtoMessage() {}
+
+func (x *UploadFile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[39]
 	if x != nil {
-		return x.PicInfo
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFile.ProtoReflect.Descriptor instead.
+func (*UploadFile) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AppealReviewRequest) GetVideoInfo() string {
+func (x *UploadFile) GetType() string {
 	if x != nil {
-		return x.VideoInfo
+		return x.Type
 	}
 	return ""
 }
 
-func (x *AppealReviewRequest) GetOpUser() string {
+func (x *UploadFile) GetContentType() string {
 	if x != nil {
-		return x.OpUser
+		return x.ContentType
 	}
 	return ""
 }
 
-func (x *AppealReviewRequest) GetReason() string {
+func (x *UploadFile) GetSize() int64 {
 	if x != nil {
-		return x.Reason
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-type AppealReviewReply struct {
+type GetUploadTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppealId      int64                  `protobuf:"varint,1,opt,name=appealId,proto3" json:"appealId,omitempty"`
+	Files         []*UploadFile          `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AppealReviewReply) Reset() {
-	*x = AppealReviewReply{}
-	mi := &file_api_review_v1_review_proto_msgTypes[10]
+func (x *GetUploadTokenRequest) Reset() {
+	*x = GetUploadTokenRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AppealReviewReply) String() string {
+func (x *GetUploadTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppealReviewReply) ProtoMessage() {}
+func (*GetUploadTokenRequest) ProtoMessage() {}
 
-func (x *AppealReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[10]
+func (x *GetUploadTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -715,45 +2999,47 @@ func (x *AppealReviewReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppealReviewReply.ProtoReflect.Descriptor instead.
-func (*AppealReviewReply) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetUploadTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetUploadTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AppealReviewReply) GetAppealId() int64 {
+func (x *GetUploadTokenRequest) GetFiles() []*UploadFile {
 	if x != nil {
-		return x.AppealId
+		return x.Files
 	}
-	return 0
+	return nil
 }
 
-type AuditAppealRequest struct {
+// 一个文件的上传地址,和请求中的files一一对应
+// 上传时必须用method请求uploadUrl,带上headers,并且文件大小和请求中的size一致
+type UploadToken struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppealId      int64                  `protobuf:"varint,1,opt,name=appealId,proto3" json:"appealId,omitempty"`
-	ReviewId      int64                  `protobuf:"varint,2,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
-	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
-	OpUser        string                 `protobuf:"bytes,4,opt,name=opUser,proto3" json:"opUser,omitempty"`
-	OpReason      string                 `protobuf:"bytes,5,opt,name=opReason,proto3" json:"opReason,omitempty"`
-	OpRemarks     *string                `protobuf:"bytes,6,opt,name=opRemarks,proto3,oneof" json:"opRemarks,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                                                                   // 对象存储中的key
+	UploadUrl     string                 `protobuf:"bytes,2,opt,name=uploadUrl,proto3" json:"uploadUrl,omitempty"`                                                                       // 预签名的上传地址
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`                                                                             // 固定是PUT
+	Headers       map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 上传时必须带的请求头
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`                                                                                   // 上传完成后的访问地址,创建评价时填到MediaItem.url
+	ExpireAt      string                 `protobuf:"bytes,6,opt,name=expireAt,proto3" json:"expireAt,omitempty"`                                                                         // 上传地址的过期时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditAppealRequest) Reset() {
-	*x = AuditAppealRequest{}
-	mi := &file_api_review_v1_review_proto_msgTypes[11]
+func (x *UploadToken) Reset() {
+	*x = UploadToken{}
+	mi := &file_api_review_v1_review_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditAppealRequest) String() string {
+func (x *UploadToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditAppealRequest) ProtoMessage() {}
+func (*UploadToken) ProtoMessage() {}
 
-func (x *AuditAppealRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[11]
+func (x *UploadToken) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,74 +3050,75 @@ func (x *AuditAppealRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditAppealRequest.ProtoReflect.Descriptor instead.
-func (*AuditAppealRequest) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UploadToken.ProtoReflect.Descriptor instead.
+func (*UploadToken) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *AuditAppealRequest) GetAppealId() int64 {
+func (x *UploadToken) GetKey() string {
 	if x != nil {
-		return x.AppealId
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *AuditAppealRequest) GetReviewId() int64 {
+func (x *UploadToken) GetUploadUrl() string {
 	if x != nil {
-		return x.ReviewId
+		return x.UploadUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *AuditAppealRequest) GetStatus() int32 {
+func (x *UploadToken) GetMethod() string {
 	if x != nil {
-		return x.Status
+		return x.Method
 	}
-	return 0
+	return ""
 }
 
-func (x *AuditAppealRequest) GetOpUser() string {
+func (x *UploadToken) GetHeaders() map[string]string {
 	if x != nil {
-		return x.OpUser
+		return x.Headers
 	}
-	return ""
+	return nil
 }
 
-func (x *AuditAppealRequest) GetOpReason() string {
+func (x *UploadToken) GetUrl() string {
 	if x != nil {
-		return x.OpReason
+		return x.Url
 	}
 	return ""
 }
 
-func (x *AuditAppealRequest) GetOpRemarks() string {
-	if x != nil && x.OpRemarks != nil {
-		return *x.OpRemarks
+func (x *UploadToken) GetExpireAt() string {
+	if x != nil {
+		return x.ExpireAt
 	}
 	return ""
 }
 
-type AuditAppealReply struct {
+type GetUploadTokenReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*UploadToken         `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditAppealReply) Reset() {
-	*x = AuditAppealReply{}
-	mi := &file_api_review_v1_review_proto_msgTypes[12]
+func (x *GetUploadTokenReply) Reset() {
+	*x = GetUploadTokenReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditAppealReply) String() string {
+func (x *GetUploadTokenReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditAppealReply) ProtoMessage() {}
+func (*GetUploadTokenReply) ProtoMessage() {}
 
-func (x *AuditAppealReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[12]
+func (x *GetUploadTokenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -842,32 +3129,43 @@ func (x *AuditAppealReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditAppealReply.ProtoReflect.Descriptor instead.
-func (*AuditAppealReply) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetUploadTokenReply.ProtoReflect.Descriptor instead.
+func (*GetUploadTokenReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{42}
 }
 
-type UpdateReviewRequest struct {
+func (x *GetUploadTokenReply) GetTokens() []*UploadToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// storeId和spuId至少传一个,都传时统计店铺下这个商品的评价
+type GetTagCloudRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreId       int64                  `protobuf:"varint,1,opt,name=storeId,proto3" json:"storeId,omitempty"`
+	SpuId         int64                  `protobuf:"varint,2,opt,name=spuId,proto3" json:"spuId,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"` // 返回前几个标签,默认20
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateReviewRequest) Reset() {
-	*x = UpdateReviewRequest{}
-	mi := &file_api_review_v1_review_proto_msgTypes[13]
+func (x *GetTagCloudRequest) Reset() {
+	*x = GetTagCloudRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReviewRequest) String() string {
+func (x *GetTagCloudRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReviewRequest) ProtoMessage() {}
+func (*GetTagCloudRequest) ProtoMessage() {}
 
-func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[13]
+func (x *GetTagCloudRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,32 +3176,56 @@ func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReviewRequest.ProtoReflect.Descriptor instead.
-func (*UpdateReviewRequest) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetTagCloudRequest.ProtoReflect.Descriptor instead.
+func (*GetTagCloudRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{43}
 }
 
-type UpdateReviewReply struct {
+func (x *GetTagCloudRequest) GetStoreId() int64 {
+	if x != nil {
+		return x.StoreId
+	}
+	return 0
+}
+
+func (x *GetTagCloudRequest) GetSpuId() int64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *GetTagCloudRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 标签和带这个标签的评价数
+type TagCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateReviewReply) Reset() {
-	*x = UpdateReviewReply{}
-	mi := &file_api_review_v1_review_proto_msgTypes[14]
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_api_review_v1_review_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReviewReply) String() string {
+func (x *TagCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReviewReply) ProtoMessage() {}
+func (*TagCount) ProtoMessage() {}
 
-func (x *UpdateReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[14]
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,32 +3236,47 @@ func (x *UpdateReviewReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReviewReply.ProtoReflect.Descriptor instead.
-func (*UpdateReviewReply) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{44}
 }
 
-type DeleteReviewRequest struct {
+func (x *TagCount) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetTagCloudReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagCount            `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteReviewRequest) Reset() {
-	*x = DeleteReviewRequest{}
-	mi := &file_api_review_v1_review_proto_msgTypes[15]
+func (x *GetTagCloudReply) Reset() {
+	*x = GetTagCloudReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteReviewRequest) String() string {
+func (x *GetTagCloudReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReviewRequest) ProtoMessage() {}
+func (*GetTagCloudReply) ProtoMessage() {}
 
-func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[15]
+func (x *GetTagCloudReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,32 +3287,43 @@ func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
-func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetTagCloudReply.ProtoReflect.Descriptor instead.
+func (*GetTagCloudReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{45}
 }
 
-type DeleteReviewReply struct {
+func (x *GetTagCloudReply) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// 追评的请求
+type AppendReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Media         []*MediaItem           `protobuf:"bytes,3,rep,name=media,proto3" json:"media,omitempty"` // 图片和视频
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteReviewReply) Reset() {
-	*x = DeleteReviewReply{}
-	mi := &file_api_review_v1_review_proto_msgTypes[16]
+func (x *AppendReviewRequest) Reset() {
+	*x = AppendReviewRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteReviewReply) String() string {
+func (x *AppendReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReviewReply) ProtoMessage() {}
+func (*AppendReviewRequest) ProtoMessage() {}
 
-func (x *DeleteReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[16]
+func (x *AppendReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,32 +3334,55 @@ func (x *DeleteReviewReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReviewReply.ProtoReflect.Descriptor instead.
-func (*DeleteReviewReply) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use AppendReviewRequest.ProtoReflect.Descriptor instead.
+func (*AppendReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{46}
 }
 
-type GetReviewRequest struct {
+func (x *AppendReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *AppendReviewRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AppendReviewRequest) GetMedia() []*MediaItem {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// 追评的返回值
+type AppendReviewReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppendId      int64                  `protobuf:"varint,1,opt,name=appendId,proto3" json:"appendId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReviewRequest) Reset() {
-	*x = GetReviewRequest{}
-	mi := &file_api_review_v1_review_proto_msgTypes[17]
+func (x *AppendReviewReply) Reset() {
+	*x = AppendReviewReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReviewRequest) String() string {
+func (x *AppendReviewReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReviewRequest) ProtoMessage() {}
+func (*AppendReviewReply) ProtoMessage() {}
 
-func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[17]
+func (x *AppendReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,32 +3393,44 @@ func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
-func (*GetReviewRequest) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use AppendReviewReply.ProtoReflect.Descriptor instead.
+func (*AppendReviewReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{47}
 }
 
-type GetReviewReply struct {
+func (x *AppendReviewReply) GetAppendId() int64 {
+	if x != nil {
+		return x.AppendId
+	}
+	return 0
+}
+
+// 运营审核追评的请求
+type AuditAppendReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`    // 20审核通过;30审核不通过
+	OpReason      string                 `protobuf:"bytes,3,opt,name=opReason,proto3" json:"opReason,omitempty"` // 审核不通过时必填
+	OpRemarks     *string                `protobuf:"bytes,4,opt,name=opRemarks,proto3,oneof" json:"opRemarks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReviewReply) Reset() {
-	*x = GetReviewReply{}
-	mi := &file_api_review_v1_review_proto_msgTypes[18]
+func (x *AuditAppendReviewRequest) Reset() {
+	*x = AuditAppendReviewRequest{}
+	mi := &file_api_review_v1_review_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReviewReply) String() string {
+func (x *AuditAppendReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReviewReply) ProtoMessage() {}
+func (*AuditAppendReviewRequest) ProtoMessage() {}
 
-func (x *GetReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[18]
+func (x *AuditAppendReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,68 +3441,63 @@ func (x *GetReviewReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReviewReply.ProtoReflect.Descriptor instead.
-func (*GetReviewReply) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{18}
-}
-
-type ListReviewRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use AuditAppendReviewRequest.ProtoReflect.Descriptor instead.
+func (*AuditAppendReviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ListReviewRequest) Reset() {
-	*x = ListReviewRequest{}
-	mi := &file_api_review_v1_review_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AuditAppendReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
 }
 
-func (x *ListReviewRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuditAppendReviewRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
 }
 
-func (*ListReviewRequest) ProtoMessage() {}
-
-func (x *ListReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[19]
+func (x *AuditAppendReviewRequest) GetOpReason() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OpReason
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListReviewRequest.ProtoReflect.Descriptor instead.
-func (*ListReviewRequest) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{19}
+func (x *AuditAppendReviewRequest) GetOpRemarks() string {
+	if x != nil && x.OpRemarks != nil {
+		return *x.OpRemarks
+	}
+	return ""
 }
 
-type ListReviewReply struct {
+// 运营审核追评的返回值
+type AuditAppendReviewReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppendId      int64                  `protobuf:"varint,1,opt,name=appendId,proto3" json:"appendId,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReviewReply) Reset() {
-	*x = ListReviewReply{}
-	mi := &file_api_review_v1_review_proto_msgTypes[20]
+func (x *AuditAppendReviewReply) Reset() {
+	*x = AuditAppendReviewReply{}
+	mi := &file_api_review_v1_review_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReviewReply) String() string {
+func (x *AuditAppendReviewReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReviewReply) ProtoMessage() {}
+func (*AuditAppendReviewReply) ProtoMessage() {}
 
-func (x *ListReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_review_v1_review_proto_msgTypes[20]
+func (x *AuditAppendReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_review_v1_review_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,20 +3508,35 @@ func (x *ListReviewReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReviewReply.ProtoReflect.Descriptor instead.
-func (*ListReviewReply) Descriptor() ([]byte, []int) {
-	return file_api_review_v1_review_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use AuditAppendReviewReply.ProtoReflect.Descriptor instead.
+func (*AuditAppendReviewReply) Descriptor() ([]byte, []int) {
+	return file_api_review_v1_review_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AuditAppendReviewReply) GetAppendId() int64 {
+	if x != nil {
+		return x.AppendId
+	}
+	return 0
+}
+
+func (x *AuditAppendReviewReply) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
 }
 
 var File_api_review_v1_review_proto protoreflect.FileDescriptor
 
 const file_api_review_v1_review_proto_rawDesc = "" +
 	"\n" +
-	"\x1aapi/review/v1/review.proto\x12\rapi.review.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"y\n" +
+	"\x1aapi/review/v1/review.proto\x12\rapi.review.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\x97\x01\n" +
 	"\x1aListReviewByStoreIdRequest\x12!\n" +
 	"\astoreId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\astoreId\x12\x1b\n" +
 	"\x04page\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x04page\x12\x1b\n" +
-	"\x04size\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x04size\"\x8a\x02\n" +
+	"\x04size\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x04size\x12\x1c\n" +
+	"\x04tags\x18\x04 \x03(\tB\b\xfaB\x05\x92\x01\x02\x10\x05R\x04tags\"\xab\x06\n" +
 	"\n" +
 	"ReviewInfo\x12\x1a\n" +
 	"\breviewId\x18\x01 \x01(\x03R\breviewId\x12\x16\n" +
@@ -1152,13 +3545,66 @@ const file_api_review_v1_review_proto_rawDesc = "" +
 	"\x05score\x18\x04 \x01(\x05R\x05score\x12\"\n" +
 	"\fserviceScore\x18\x05 \x01(\x05R\fserviceScore\x12\"\n" +
 	"\fexpressScore\x18\x06 \x01(\x05R\fexpressScore\x12\x18\n" +
-	"\acontent\x18\a \x01(\tR\acontent\x12\x18\n" +
-	"\apicInfo\x18\b \x01(\tR\apicInfo\x12\x1c\n" +
-	"\tvideoInfo\x18\t \x01(\tR\tvideoInfo\"I\n" +
+	"\acontent\x18\a \x01(\tR\acontent\x12.\n" +
+	"\x05media\x18\x1a \x03(\v2\x18.api.review.v1.MediaItemR\x05media\x12\x18\n" +
+	"\astoreId\x18\n" +
+	" \x01(\x03R\astoreId\x12\x14\n" +
+	"\x05skuId\x18\v \x01(\x03R\x05skuId\x12\x14\n" +
+	"\x05spuId\x18\f \x01(\x03R\x05spuId\x12\x16\n" +
+	"\x06status\x18\r \x01(\x05R\x06status\x12\x1c\n" +
+	"\tanonymous\x18\x0e \x01(\bR\tanonymous\x12\x1a\n" +
+	"\bhasMedia\x18\x0f \x01(\bR\bhasMedia\x12\x1a\n" +
+	"\bhasReply\x18\x10 \x01(\bR\bhasReply\x12\x1c\n" +
+	"\tisDefault\x18\x11 \x01(\bR\tisDefault\x12\x1a\n" +
+	"\bopReason\x18\x12 \x01(\tR\bopReason\x12\x1c\n" +
+	"\topRemarks\x18\x13 \x01(\tR\topRemarks\x12\x16\n" +
+	"\x06opUser\x18\x14 \x01(\tR\x06opUser\x12&\n" +
+	"\x0egoodsSnapshoot\x18\x15 \x01(\tR\x0egoodsSnapshoot\x12\x18\n" +
+	"\aversion\x18\x16 \x01(\x05R\aversion\x12\x1a\n" +
+	"\bcreateAt\x18\x17 \x01(\tR\bcreateAt\x12\x1a\n" +
+	"\bupdateAt\x18\x18 \x01(\tR\bupdateAt\x12\x1c\n" +
+	"\tuserAlias\x18\x19 \x01(\tR\tuserAlias\x12\x12\n" +
+	"\x04tags\x18\x1b \x03(\tR\x04tags\x127\n" +
+	"\x06append\x18\x1c \x01(\v2\x1f.api.review.v1.ReviewAppendInfoR\x06appendJ\x04\b\b\x10\tJ\x04\b\t\x10\n" +
+	"R\apicInfoR\tvideoInfo\"\xe4\x01\n" +
+	"\x10ReviewAppendInfo\x12\x1a\n" +
+	"\bappendId\x18\x01 \x01(\x03R\bappendId\x12\x1a\n" +
+	"\breviewId\x18\x02 \x01(\x03R\breviewId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12.\n" +
+	"\x05media\x18\x04 \x03(\v2\x18.api.review.v1.MediaItemR\x05media\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\x12\x1a\n" +
+	"\bopReason\x18\x06 \x01(\tR\bopReason\x12\x1a\n" +
+	"\bcreateAt\x18\a \x01(\tR\bcreateAt\"\xde\x01\n" +
+	"\tMediaItem\x12\x1d\n" +
+	"\x03url\x18\x01 \x01(\tB\v\xfaB\br\x06\x18\x80\x04\x88\x01\x01R\x03url\x12'\n" +
+	"\x04type\x18\x02 \x01(\tB\x13\xfaB\x10r\x0eR\x05imageR\x05videoR\x04type\x12\x1d\n" +
+	"\x05width\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05width\x12\x1f\n" +
+	"\x06height\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06height\x12#\n" +
+	"\bduration\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bduration\x12$\n" +
+	"\x05cover\x18\x06 \x01(\tB\x0e\xfaB\vr\t\x18\x80\x04\xd0\x01\x01\x88\x01\x01R\x05cover\"\xe7\x01\n" +
+	"\x0fReviewReplyInfo\x12\x18\n" +
+	"\areplyId\x18\x01 \x01(\x03R\areplyId\x12\x1a\n" +
+	"\breviewId\x18\x02 \x01(\x03R\breviewId\x12\x18\n" +
+	"\astoreId\x18\x03 \x01(\x03R\astoreId\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12.\n" +
+	"\x05media\x18\b \x03(\v2\x18.api.review.v1.MediaItemR\x05media\x12\x1a\n" +
+	"\bcreateAt\x18\a \x01(\tR\bcreateAtJ\x04\b\x05\x10\x06J\x04\b\x06\x10\aR\apicInfoR\tvideoInfo\"\xd0\x02\n" +
+	"\x10ReviewAppealInfo\x12\x1a\n" +
+	"\bappealId\x18\x01 \x01(\x03R\bappealId\x12\x1a\n" +
+	"\breviewId\x18\x02 \x01(\x03R\breviewId\x12\x18\n" +
+	"\astoreId\x18\x03 \x01(\x03R\astoreId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\tR\acontent\x12.\n" +
+	"\x05media\x18\f \x03(\v2\x18.api.review.v1.MediaItemR\x05media\x12\x1c\n" +
+	"\topRemarks\x18\t \x01(\tR\topRemarks\x12\x16\n" +
+	"\x06opUser\x18\n" +
+	" \x01(\tR\x06opUser\x12\x1a\n" +
+	"\bcreateAt\x18\v \x01(\tR\bcreateAtJ\x04\b\a\x10\bJ\x04\b\b\x10\tR\apicInfoR\tvideoInfo\"I\n" +
 	"\x18ListReviewByStoreIdReply\x12-\n" +
-	"\x04list\x18\x01 \x03(\v2\x19.api.review.v1.ReviewInfoR\x04list\"\xe6\x02\n" +
-	"\x13CreateReviewRequest\x12\x1f\n" +
-	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12!\n" +
+	"\x04list\x18\x01 \x03(\v2\x19.api.review.v1.ReviewInfoR\x04list\"\xa5\x03\n" +
+	"\x13CreateReviewRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12!\n" +
 	"\aorderId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\x12%\n" +
 	"\x05score\x18\x03 \x01(\x05B\x0f\xfaB\f\x1a\n" +
 	"0\x010\x020\x030\x040\x05R\x05score\x123\n" +
@@ -1167,65 +3613,258 @@ const file_api_review_v1_review_proto_rawDesc = "" +
 	"\fexpressScore\x18\x05 \x01(\x05B\x0f\xfaB\f\x1a\n" +
 	"0\x010\x020\x030\x040\x05R\fexpressScore\x12$\n" +
 	"\acontent\x18\x06 \x01(\tB\n" +
-	"\xfaB\ar\x05\x10\b\x18\xff\x01R\acontent\x12\x18\n" +
-	"\apicInfo\x18\a \x01(\tR\apicInfo\x12\x1c\n" +
-	"\tvideoInfo\x18\b \x01(\tR\tvideoInfo\x12\x1c\n" +
-	"\tanonymous\x18\t \x01(\bR\tanonymous\"/\n" +
+	"\xfaB\ar\x05\x10\b\x18\xff\x01R\acontent\x128\n" +
+	"\x05media\x18\n" +
+	" \x03(\v2\x18.api.review.v1.MediaItemB\b\xfaB\x05\x92\x01\x02\x10\n" +
+	"R\x05media\x12\x1c\n" +
+	"\tanonymous\x18\t \x01(\bR\tanonymous\x12$\n" +
+	"\x04tags\x18\v \x03(\tB\x10\xfaB\r\x92\x01\n" +
+	"\x10\n" +
+	"\"\x06r\x04\x10\x01\x18\x14R\x04tagsJ\x04\b\a\x10\bJ\x04\b\b\x10\tR\apicInfoR\tvideoInfo\"/\n" +
 	"\x11CreateReviewReply\x12\x1a\n" +
 	"\breviewId\x18\x01 \x01(\x03R\breviewId\"\x11\n" +
-	"\x0fTestConnRequest\"\xba\x01\n" +
+	"\x0fTestConnRequest\"\xd3\x01\n" +
 	"\x12ReplyReviewRequest\x12#\n" +
-	"\breviewId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\breviewId\x12!\n" +
-	"\astoreId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\astoreId\x12$\n" +
+	"\breviewId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\breviewId\x12\x18\n" +
+	"\astoreId\x18\x02 \x01(\x03R\astoreId\x12$\n" +
 	"\acontent\x18\x03 \x01(\tB\n" +
-	"\xfaB\ar\x05\x10\x02\x18\xc8\x01R\acontent\x12\x18\n" +
-	"\apicInfo\x18\x04 \x01(\tR\apicInfo\x12\x1c\n" +
-	"\tvideoInfo\x18\x05 \x01(\tR\tvideoInfo\",\n" +
+	"\xfaB\ar\x05\x10\x02\x18\xc8\x01R\acontent\x128\n" +
+	"\x05media\x18\x06 \x03(\v2\x18.api.review.v1.MediaItemB\b\xfaB\x05\x92\x01\x02\x10\n" +
+	"R\x05mediaJ\x04\b\x04\x10\x05J\x04\b\x05\x10\x06R\apicInfoR\tvideoInfo\",\n" +
 	"\x10ReplyReviewReply\x12\x18\n" +
 	"\areplyId\x18\x01 \x01(\x03R\areplyId\"#\n" +
 	"\rTestConnReply\x12\x12\n" +
-	"\x04pong\x18\x01 \x01(\tR\x04pong\"\xeb\x01\n" +
+	"\x04pong\x18\x01 \x01(\tR\x04pong\"\x84\x02\n" +
 	"\x13AppealReviewRequest\x12#\n" +
-	"\breviewId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\breviewId\x12!\n" +
-	"\astoreId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\astoreId\x12$\n" +
+	"\breviewId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\breviewId\x12\x18\n" +
+	"\astoreId\x18\x02 \x01(\x03R\astoreId\x12$\n" +
 	"\acontent\x18\x03 \x01(\tB\n" +
-	"\xfaB\ar\x05\x10\x02\x18\xc8\x01R\acontent\x12\x18\n" +
-	"\apicInfo\x18\x04 \x01(\tR\apicInfo\x12\x1c\n" +
-	"\tvideoInfo\x18\x05 \x01(\tR\tvideoInfo\x12\x16\n" +
+	"\xfaB\ar\x05\x10\x02\x18\xc8\x01R\acontent\x128\n" +
+	"\x05media\x18\b \x03(\v2\x18.api.review.v1.MediaItemB\b\xfaB\x05\x92\x01\x02\x10\n" +
+	"R\x05media\x12\x16\n" +
 	"\x06opUser\x18\x06 \x01(\tR\x06opUser\x12\x16\n" +
-	"\x06reason\x18\a \x01(\tR\x06reason\"/\n" +
+	"\x06reason\x18\a \x01(\tR\x06reasonJ\x04\b\x04\x10\x05J\x04\b\x05\x10\x06R\apicInfoR\tvideoInfo\"/\n" +
 	"\x11AppealReviewReply\x12\x1a\n" +
-	"\bappealId\x18\x01 \x01(\x03R\bappealId\"\xf6\x01\n" +
+	"\bappealId\x18\x01 \x01(\x03R\bappealId\"\xc1\x01\n" +
+	"\x12AuditReviewRequest\x12#\n" +
+	"\breviewId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\breviewId\x12!\n" +
+	"\x06status\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x040\x140\x1eR\x06status\x12\x16\n" +
+	"\x06opUser\x18\x03 \x01(\tR\x06opUser\x12\x1a\n" +
+	"\bopReason\x18\x04 \x01(\tR\bopReason\x12!\n" +
+	"\topRemarks\x18\x05 \x01(\tH\x00R\topRemarks\x88\x01\x01B\f\n" +
+	"\n" +
+	"_opRemarks\"F\n" +
+	"\x10AuditReviewReply\x12\x1a\n" +
+	"\breviewId\x18\x01 \x01(\x03R\breviewId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\"\xed\x01\n" +
 	"\x12AuditAppealRequest\x12#\n" +
 	"\bappealId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bappealId\x12#\n" +
 	"\breviewId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\breviewId\x12\x1f\n" +
-	"\x06status\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06status\x12\x1f\n" +
-	"\x06opUser\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\x06opUser\x12#\n" +
+	"\x06status\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06status\x12\x16\n" +
+	"\x06opUser\x18\x04 \x01(\tR\x06opUser\x12#\n" +
 	"\bopReason\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\bopReason\x12!\n" +
 	"\topRemarks\x18\x06 \x01(\tH\x00R\topRemarks\x88\x01\x01B\f\n" +
 	"\n" +
 	"_opRemarks\"\x12\n" +
-	"\x10AuditAppealReply\"\x15\n" +
-	"\x13UpdateReviewRequest\"\x13\n" +
-	"\x11UpdateReviewReply\"\x15\n" +
-	"\x13DeleteReviewRequest\"\x13\n" +
-	"\x11DeleteReviewReply\"\x12\n" +
-	"\x10GetReviewRequest\"\x10\n" +
-	"\x0eGetReviewReply\"\x13\n" +
-	"\x11ListReviewRequest\"\x11\n" +
-	"\x0fListReviewReply2\x94\b\n" +
+	"\x10AuditAppealReply\"\xac\x03\n" +
+	"\x13UpdateReviewRequest\x12#\n" +
+	"\breviewId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\breviewId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x03R\x06userId\x12!\n" +
+	"\aversion\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\aversion\x12%\n" +
+	"\x05score\x18\x04 \x01(\x05B\x0f\xfaB\f\x1a\n" +
+	"0\x010\x020\x030\x040\x05R\x05score\x123\n" +
+	"\fserviceScore\x18\x05 \x01(\x05B\x0f\xfaB\f\x1a\n" +
+	"0\x010\x020\x030\x040\x05R\fserviceScore\x123\n" +
+	"\fexpressScore\x18\x06 \x01(\x05B\x0f\xfaB\f\x1a\n" +
+	"0\x010\x020\x030\x040\x05R\fexpressScore\x12$\n" +
+	"\acontent\x18\a \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\b\x18\xff\x01R\acontent\x128\n" +
+	"\x05media\x18\n" +
+	" \x03(\v2\x18.api.review.v1.MediaItemB\b\xfaB\x05\x92\x01\x02\x10\n" +
+	"R\x05media\x12$\n" +
+	"\x04tags\x18\v \x03(\tB\x10\xfaB\r\x92\x01\n" +
+	"\x10\n" +
+	"\"\x06r\x04\x10\x01\x18\x14R\x04tagsJ\x04\b\b\x10\tJ\x04\b\t\x10\n" +
+	"R\apicInfoR\tvideoInfo\"I\n" +
+	"\x11UpdateReviewReply\x12\x1a\n" +
+	"\breviewId\x18\x01 \x01(\x03R\breviewId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"j\n" +
+	"\x13DeleteReviewRequest\x12#\n" +
+	"\breviewId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\breviewId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06opUser\x18\x03 \x01(\tR\x06opUser\"\x13\n" +
+	"\x11DeleteReviewReply\"7\n" +
+	"\x10GetReviewRequest\x12#\n" +
+	"\breviewId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\breviewId\"\xb2\x01\n" +
+	"\x0eGetReviewReply\x121\n" +
+	"\x06review\x18\x01 \x01(\v2\x19.api.review.v1.ReviewInfoR\x06review\x124\n" +
+	"\x05reply\x18\x02 \x01(\v2\x1e.api.review.v1.ReviewReplyInfoR\x05reply\x127\n" +
+	"\x06appeal\x18\x03 \x01(\v2\x1f.api.review.v1.ReviewAppealInfoR\x06appeal\"\xa5\x04\n" +
+	"\x11ListReviewRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05spuId\x18\x02 \x01(\x03R\x05spuId\x12\x14\n" +
+	"\x05skuId\x18\x03 \x01(\x03R\x05skuId\x12\x18\n" +
+	"\astoreId\x18\x04 \x01(\x03R\astoreId\x12*\n" +
+	"\x06status\x18\x05 \x01(\x05B\r\xfaB\n" +
+	"\x1a\b0\n" +
+	"0\x140\x1e0(H\x00R\x06status\x88\x01\x01\x12%\n" +
+	"\bminScore\x18\x06 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x05(\x00R\bminScore\x12%\n" +
+	"\bmaxScore\x18\a \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x05(\x00R\bmaxScore\x12\x1f\n" +
+	"\bhasMedia\x18\b \x01(\bH\x01R\bhasMedia\x88\x01\x01\x12\x1f\n" +
+	"\bhasReply\x18\t \x01(\bH\x02R\bhasReply\x88\x01\x01\x12\x1c\n" +
+	"\tstartTime\x18\n" +
+	" \x01(\tR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\v \x01(\tR\aendTime\x12,\n" +
+	"\x06sortBy\x18\f \x01(\tB\x14\xfaB\x11r\x0fR\x00R\x04timeR\x05scoreR\x06sortBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\x12\x1c\n" +
+	"\tpageToken\x18\x0e \x01(\tR\tpageToken\x12\x1d\n" +
+	"\x04size\x18\x0f \x01(\x05B\t\xfaB\x06\x1a\x04\x182(\x00R\x04size\x12\x1c\n" +
+	"\x04tags\x18\x10 \x03(\tB\b\xfaB\x05\x92\x01\x02\x10\x05R\x04tagsB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_hasMediaB\v\n" +
+	"\t_hasReply\"|\n" +
+	"\x0fListReviewReply\x12-\n" +
+	"\x04list\x18\x01 \x03(\v2\x19.api.review.v1.ReviewInfoR\x04list\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12$\n" +
+	"\rnextPageToken\x18\x03 \x01(\tR\rnextPageToken\"\xcb\x02\n" +
+	"\x14SearchReviewsRequest\x12#\n" +
+	"\akeyword\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\akeyword\x12\x18\n" +
+	"\astoreId\x18\x02 \x01(\x03R\astoreId\x12\x14\n" +
+	"\x05spuId\x18\x03 \x01(\x03R\x05spuId\x12%\n" +
+	"\bminScore\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x05(\x00R\bminScore\x12%\n" +
+	"\bmaxScore\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x05(\x00R\bmaxScore\x12*\n" +
+	"\x06status\x18\x06 \x01(\x05B\r\xfaB\n" +
+	"\x1a\b0\n" +
+	"0\x140\x1e0(H\x00R\x06status\x88\x01\x01\x12\x1c\n" +
+	"\tpageToken\x18\a \x01(\tR\tpageToken\x12\x1d\n" +
+	"\x04size\x18\b \x01(\x05B\t\xfaB\x06\x1a\x04\x182(\x00R\x04size\x12\x1c\n" +
+	"\x04tags\x18\t \x03(\tB\b\xfaB\x05\x92\x01\x02\x10\x05R\x04tagsB\t\n" +
+	"\a_status\"\xc6\x01\n" +
+	"\x0fSearchReviewHit\x121\n" +
+	"\x06review\x18\x01 \x01(\v2\x19.api.review.v1.ReviewInfoR\x06review\x12*\n" +
+	"\x10contentHighlight\x18\x02 \x03(\tR\x10contentHighlight\x12$\n" +
+	"\rtagsHighlight\x18\x03 \x03(\tR\rtagsHighlight\x12.\n" +
+	"\x12opRemarksHighlight\x18\x04 \x03(\tR\x12opRemarksHighlight\"\x84\x01\n" +
+	"\x12SearchReviewsReply\x122\n" +
+	"\x04list\x18\x01 \x03(\v2\x1e.api.review.v1.SearchReviewHitR\x04list\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12$\n" +
+	"\rnextPageToken\x18\x03 \x01(\tR\rnextPageToken\"y\n" +
+	"\x1cGetStoreRatingSummaryRequest\x12!\n" +
+	"\astoreId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\astoreId\x12\x1c\n" +
+	"\tstartTime\x18\x02 \x01(\tR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x03 \x01(\tR\aendTime\"8\n" +
+	"\n" +
+	"ScoreCount\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x05R\x05score\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xe3\x02\n" +
+	"\x1aGetStoreRatingSummaryReply\x12\x18\n" +
+	"\astoreId\x18\x01 \x01(\x03R\astoreId\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x1a\n" +
+	"\bavgScore\x18\x03 \x01(\x01R\bavgScore\x12(\n" +
+	"\x0favgServiceScore\x18\x04 \x01(\x01R\x0favgServiceScore\x12(\n" +
+	"\x0favgExpressScore\x18\x05 \x01(\x01R\x0favgExpressScore\x12G\n" +
+	"\x11scoreDistribution\x18\x06 \x03(\v2\x19.api.review.v1.ScoreCountR\x11scoreDistribution\x12\x1e\n" +
+	"\n" +
+	"mediaCount\x18\a \x01(\x03R\n" +
+	"mediaCount\x12\x1e\n" +
+	"\n" +
+	"replyCount\x18\b \x01(\x03R\n" +
+	"replyCount\x12\x1c\n" +
+	"\treplyRate\x18\t \x01(\x01R\treplyRate\"\xec\x01\n" +
+	"\x16ListReviewBySpuRequest\x12\x1d\n" +
+	"\x05spuId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05spuId\x12\x14\n" +
+	"\x05skuId\x18\x02 \x01(\x03R\x05skuId\x12B\n" +
+	"\x03tab\x18\x03 \x01(\tB0\xfaB-r+R\x00R\x03allR\x05mediaR\bpositiveR\aneutralR\bnegativeR\x03tab\x12\x1c\n" +
+	"\tpageToken\x18\x04 \x01(\tR\tpageToken\x12\x1d\n" +
+	"\x04size\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x182(\x00R\x04size\x12\x1c\n" +
+	"\x04tags\x18\x06 \x03(\tB\b\xfaB\x05\x92\x01\x02\x10\x05R\x04tags\"8\n" +
+	"\x0eReviewTabCount\x12\x10\n" +
+	"\x03tab\x18\x01 \x01(\tR\x03tab\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\x9e\x01\n" +
+	"\x14ListReviewBySpuReply\x12-\n" +
+	"\x04list\x18\x01 \x03(\v2\x19.api.review.v1.ReviewInfoR\x04list\x121\n" +
+	"\x04tabs\x18\x02 \x03(\v2\x1d.api.review.v1.ReviewTabCountR\x04tabs\x12$\n" +
+	"\rnextPageToken\x18\x03 \x01(\tR\rnextPageToken\"v\n" +
+	"\x17ListReviewByUserRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06userId\x12\x1b\n" +
+	"\x04page\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12\x1d\n" +
+	"\x04size\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x182(\x00R\x04size\"y\n" +
+	"\x0eUserReviewItem\x121\n" +
+	"\x06review\x18\x01 \x01(\v2\x19.api.review.v1.ReviewInfoR\x06review\x124\n" +
+	"\x05reply\x18\x02 \x01(\v2\x1e.api.review.v1.ReviewReplyInfoR\x05reply\"`\n" +
+	"\x15ListReviewByUserReply\x121\n" +
+	"\x04list\x18\x01 \x03(\v2\x1d.api.review.v1.UserReviewItemR\x04list\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\x80\x01\n" +
+	"\n" +
+	"UploadFile\x12'\n" +
+	"\x04type\x18\x01 \x01(\tB\x13\xfaB\x10r\x0eR\x05imageR\x05videoR\x04type\x12,\n" +
+	"\vcontentType\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\vcontentType\x12\x1b\n" +
+	"\x04size\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x04size\"T\n" +
+	"\x15GetUploadTokenRequest\x12;\n" +
+	"\x05files\x18\x01 \x03(\v2\x19.api.review.v1.UploadFileB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x10\n" +
+	"R\x05files\"\x82\x02\n" +
+	"\vUploadToken\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1c\n" +
+	"\tuploadUrl\x18\x02 \x01(\tR\tuploadUrl\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12A\n" +
+	"\aheaders\x18\x04 \x03(\v2'.api.review.v1.UploadToken.HeadersEntryR\aheaders\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x12\x1a\n" +
+	"\bexpireAt\x18\x06 \x01(\tR\bexpireAt\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"I\n" +
+	"\x13GetUploadTokenReply\x122\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x1a.api.review.v1.UploadTokenR\x06tokens\"u\n" +
+	"\x12GetTagCloudRequest\x12!\n" +
+	"\astoreId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\astoreId\x12\x1d\n" +
+	"\x05spuId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x05spuId\x12\x1d\n" +
+	"\x04size\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x182(\x00R\x04size\"2\n" +
+	"\bTagCount\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"?\n" +
+	"\x10GetTagCloudReply\x12+\n" +
+	"\x04tags\x18\x01 \x03(\v2\x17.api.review.v1.TagCountR\x04tags\"\x9a\x01\n" +
+	"\x13AppendReviewRequest\x12#\n" +
+	"\breviewId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\breviewId\x12$\n" +
+	"\acontent\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\b\x18\xff\x01R\acontent\x128\n" +
+	"\x05media\x18\x03 \x03(\v2\x18.api.review.v1.MediaItemB\b\xfaB\x05\x92\x01\x02\x10\n" +
+	"R\x05media\"/\n" +
+	"\x11AppendReviewReply\x12\x1a\n" +
+	"\bappendId\x18\x01 \x01(\x03R\bappendId\"\xaf\x01\n" +
+	"\x18AuditAppendReviewRequest\x12#\n" +
+	"\breviewId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\breviewId\x12!\n" +
+	"\x06status\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x040\x140\x1eR\x06status\x12\x1a\n" +
+	"\bopReason\x18\x03 \x01(\tR\bopReason\x12!\n" +
+	"\topRemarks\x18\x04 \x01(\tH\x00R\topRemarks\x88\x01\x01B\f\n" +
+	"\n" +
+	"_opRemarks\"L\n" +
+	"\x16AuditAppendReviewReply\x12\x1a\n" +
+	"\bappendId\x18\x01 \x01(\x03R\bappendId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status2\xf9\x11\n" +
 	"\x06Review\x12o\n" +
 	"\fCreateReview\x12\".api.review.v1.CreateReviewRequest\x1a .api.review.v1.CreateReviewReply\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/review/add\x12a\n" +
 	"\bTestConn\x12\x1e.api.review.v1.TestConnRequest\x1a\x1c.api.review.v1.TestConnReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/review/ping\x12n\n" +
 	"\vReplyReview\x12!.api.review.v1.ReplyReviewRequest\x1a\x1f.api.review.v1.ReplyReviewReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/review/reply\x12r\n" +
-	"\fAppealReview\x12\".api.review.v1.AppealReviewRequest\x1a .api.review.v1.AppealReviewReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/review/appeal\x12u\n" +
+	"\fAppealReview\x12\".api.review.v1.AppealReviewRequest\x1a .api.review.v1.AppealReviewReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/review/appeal\x12n\n" +
+	"\vAuditReview\x12!.api.review.v1.AuditReviewRequest\x1a\x1f.api.review.v1.AuditReviewReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/review/audit\x12u\n" +
 	"\vAuditAppeal\x12!.api.review.v1.AuditAppealRequest\x1a\x1f.api.review.v1.AuditAppealReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/review/audit_appeal\x12\x91\x01\n" +
-	"\x13ListReviewByStoreId\x12).api.review.v1.ListReviewByStoreIdRequest\x1a'.api.review.v1.ListReviewByStoreIdReply\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/review/list_by_store_id\x12T\n" +
-	"\fUpdateReview\x12\".api.review.v1.UpdateReviewRequest\x1a .api.review.v1.UpdateReviewReply\x12T\n" +
-	"\fDeleteReview\x12\".api.review.v1.DeleteReviewRequest\x1a .api.review.v1.DeleteReviewReply\x12K\n" +
-	"\tGetReview\x12\x1f.api.review.v1.GetReviewRequest\x1a\x1d.api.review.v1.GetReviewReply\x12N\n" +
+	"\x13ListReviewByStoreId\x12).api.review.v1.ListReviewByStoreIdRequest\x1a'.api.review.v1.ListReviewByStoreIdReply\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/review/list_by_store_id\x12r\n" +
+	"\fUpdateReview\x12\".api.review.v1.UpdateReviewRequest\x1a .api.review.v1.UpdateReviewReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/review/update\x12r\n" +
+	"\fDeleteReview\x12\".api.review.v1.DeleteReviewRequest\x1a .api.review.v1.DeleteReviewReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/review/delete\x12f\n" +
+	"\tGetReview\x12\x1f.api.review.v1.GetReviewRequest\x1a\x1d.api.review.v1.GetReviewReply\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/review/get\x12j\n" +
 	"\n" +
-	"ListReview\x12 .api.review.v1.ListReviewRequest\x1a\x1e.api.review.v1.ListReviewReplyB2\n" +
+	"ListReview\x12 .api.review.v1.ListReviewRequest\x1a\x1e.api.review.v1.ListReviewReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/review/list\x12u\n" +
+	"\rSearchReviews\x12#.api.review.v1.SearchReviewsRequest\x1a!.api.review.v1.SearchReviewsReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/review/search\x12\x93\x01\n" +
+	"\x15GetStoreRatingSummary\x12+.api.review.v1.GetStoreRatingSummaryRequest\x1a).api.review.v1.GetStoreRatingSummaryReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/review/store/rating\x12}\n" +
+	"\x0fListReviewBySpu\x12%.api.review.v1.ListReviewBySpuRequest\x1a#.api.review.v1.ListReviewBySpuReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/review/spu/list\x12\x81\x01\n" +
+	"\x10ListReviewByUser\x12&.api.review.v1.ListReviewByUserRequest\x1a$.api.review.v1.ListReviewByUserReply\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/review/user/list\x12~\n" +
+	"\x0eGetUploadToken\x12$.api.review.v1.GetUploadTokenRequest\x1a\".api.review.v1.GetUploadTokenReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/review/upload/token\x12r\n" +
+	"\vGetTagCloud\x12!.api.review.v1.GetTagCloudRequest\x1a\x1f.api.review.v1.GetTagCloudReply\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/review/tag/cloud\x12r\n" +
+	"\fAppendReview\x12\".api.review.v1.AppendReviewRequest\x1a .api.review.v1.AppendReviewReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/review/append\x12\x87\x01\n" +
+	"\x11AuditAppendReview\x12'.api.review.v1.AuditAppendReviewRequest\x1a%.api.review.v1.AuditAppendReviewReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/review/append/auditB2\n" +
 	"\rapi.review.v1P\x01Z\x1freview-service/api/review/v1;v1b\x06proto3"
 
 var (
@@ -1240,57 +3879,131 @@ func file_api_review_v1_review_proto_rawDescGZIP() []byte {
 	return file_api_review_v1_review_proto_rawDescData
 }
 
-var file_api_review_v1_review_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_api_review_v1_review_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_api_review_v1_review_proto_goTypes = []any{
-	(*ListReviewByStoreIdRequest)(nil), // 0: api.review.v1.ListReviewByStoreIdRequest
-	(*ReviewInfo)(nil),                 // 1: api.review.v1.ReviewInfo
-	(*ListReviewByStoreIdReply)(nil),   // 2: api.review.v1.ListReviewByStoreIdReply
-	(*CreateReviewRequest)(nil),        // 3: api.review.v1.CreateReviewRequest
-	(*CreateReviewReply)(nil),          // 4: api.review.v1.CreateReviewReply
-	(*TestConnRequest)(nil),            // 5: api.review.v1.TestConnRequest
-	(*ReplyReviewRequest)(nil),         // 6: api.review.v1.ReplyReviewRequest
-	(*ReplyReviewReply)(nil),           // 7: api.review.v1.ReplyReviewReply
-	(*TestConnReply)(nil),              // 8: api.review.v1.TestConnReply
-	(*AppealReviewRequest)(nil),        // 9: api.review.v1.AppealReviewRequest
-	(*AppealReviewReply)(nil),          // 10: api.review.v1.AppealReviewReply
-	(*AuditAppealRequest)(nil),         // 11: api.review.v1.AuditAppealRequest
-	(*AuditAppealReply)(nil),           // 12: api.review.v1.AuditAppealReply
-	(*UpdateReviewRequest)(nil),        // 13: api.review.v1.UpdateReviewRequest
-	(*UpdateReviewReply)(nil),          // 14: api.review.v1.UpdateReviewReply
-	(*DeleteReviewRequest)(nil),        // 15: api.review.v1.DeleteReviewRequest
-	(*DeleteReviewReply)(nil),          // 16: api.review.v1.DeleteReviewReply
-	(*GetReviewRequest)(nil),           // 17: api.review.v1.GetReviewRequest
-	(*GetReviewReply)(nil),             // 18: api.review.v1.GetReviewReply
-	(*ListReviewRequest)(nil),          // 19: api.review.v1.ListReviewRequest
-	(*ListReviewReply)(nil),            // 20: api.review.v1.ListReviewReply
+	(*ListReviewByStoreIdRequest)(nil),   // 0: api.review.v1.ListReviewByStoreIdRequest
+	(*ReviewInfo)(nil),                   // 1: api.review.v1.ReviewInfo
+	(*ReviewAppendInfo)(nil),             // 2: api.review.v1.ReviewAppendInfo
+	(*MediaItem)(nil),                    // 3: api.review.v1.MediaItem
+	(*ReviewReplyInfo)(nil),              // 4: api.review.v1.ReviewReplyInfo
+	(*ReviewAppealInfo)(nil),             // 5: api.review.v1.ReviewAppealInfo
+	(*ListReviewByStoreIdReply)(nil),     // 6: api.review.v1.ListReviewByStoreIdReply
+	(*CreateReviewRequest)(nil),          // 7: api.review.v1.CreateReviewRequest
+	(*CreateReviewReply)(nil),            // 8: api.review.v1.CreateReviewReply
+	(*TestConnRequest)(nil),              // 9: api.review.v1.TestConnRequest
+	(*ReplyReviewRequest)(nil),           // 10: api.review.v1.ReplyReviewRequest
+	(*ReplyReviewReply)(nil),             // 11: api.review.v1.ReplyReviewReply
+	(*TestConnReply)(nil),                // 12: api.review.v1.TestConnReply
+	(*AppealReviewRequest)(nil),          // 13: api.review.v1.AppealReviewRequest
+	(*AppealReviewReply)(nil),            // 14: api.review.v1.AppealReviewReply
+	(*AuditReviewRequest)(nil),           // 15: api.review.v1.AuditReviewRequest
+	(*AuditReviewReply)(nil),             // 16: api.review.v1.AuditReviewReply
+	(*AuditAppealRequest)(nil),           // 17: api.review.v1.AuditAppealRequest
+	(*AuditAppealReply)(nil),             // 18: api.review.v1.AuditAppealReply
+	(*UpdateReviewRequest)(nil),          // 19: api.review.v1.UpdateReviewRequest
+	(*UpdateReviewReply)(nil),            // 20: api.review.v1.UpdateReviewReply
+	(*DeleteReviewRequest)(nil),          // 21: api.review.v1.DeleteReviewRequest
+	(*DeleteReviewReply)(nil),            // 22: api.review.v1.DeleteReviewReply
+	(*GetReviewRequest)(nil),             // 23: api.review.v1.GetReviewRequest
+	(*GetReviewReply)(nil),               // 24: api.review.v1.GetReviewReply
+	(*ListReviewRequest)(nil),            // 25: api.review.v1.ListReviewRequest
+	(*ListReviewReply)(nil),              // 26: api.review.v1.ListReviewReply
+	(*SearchReviewsRequest)(nil),         // 27: api.review.v1.SearchReviewsRequest
+	(*SearchReviewHit)(nil),              // 28: api.review.v1.SearchReviewHit
+	(*SearchReviewsReply)(nil),           // 29: api.review.v1.SearchReviewsReply
+	(*GetStoreRatingSummaryRequest)(nil), // 30: api.review.v1.GetStoreRatingSummaryRequest
+	(*ScoreCount)(nil),                   // 31: api.review.v1.ScoreCount
+	(*GetStoreRatingSummaryReply)(nil),   // 32: api.review.v1.GetStoreRatingSummaryReply
+	(*ListReviewBySpuRequest)(nil),       // 33: api.review.v1.ListReviewBySpuRequest
+	(*ReviewTabCount)(nil),               // 34: api.review.v1.ReviewTabCount
+	(*ListReviewBySpuReply)(nil),         // 35: api.review.v1.ListReviewBySpuReply
+	(*ListReviewByUserRequest)(nil),      // 36: api.review.v1.ListReviewByUserRequest
+	(*UserReviewItem)(nil),               // 37: api.review.v1.UserReviewItem
+	(*ListReviewByUserReply)(nil),        // 38: api.review.v1.ListReviewByUserReply
+	(*UploadFile)(nil),                   // 39: api.review.v1.UploadFile
+	(*GetUploadTokenRequest)(nil),        // 40: api.review.v1.GetUploadTokenRequest
+	(*UploadToken)(nil),                  // 41: api.review.v1.UploadToken
+	(*GetUploadTokenReply)(nil),          // 42: api.review.v1.GetUploadTokenReply
+	(*GetTagCloudRequest)(nil),           // 43: api.review.v1.GetTagCloudRequest
+	(*TagCount)(nil),                     // 44: api.review.v1.TagCount
+	(*GetTagCloudReply)(nil),             // 45: api.review.v1.GetTagCloudReply
+	(*AppendReviewRequest)(nil),          // 46: api.review.v1.AppendReviewRequest
+	(*AppendReviewReply)(nil),            // 47: api.review.v1.AppendReviewReply
+	(*AuditAppendReviewRequest)(nil),     // 48: api.review.v1.AuditAppendReviewRequest
+	(*AuditAppendReviewReply)(nil),       // 49: api.review.v1.AuditAppendReviewReply
+	nil,                                  // 50: api.review.v1.UploadToken.HeadersEntry
 }
 var file_api_review_v1_review_proto_depIdxs = []int32{
-	1,  // 0: api.review.v1.ListReviewByStoreIdReply.list:type_name -> api.review.v1.ReviewInfo
-	3,  // 1: api.review.v1.Review.CreateReview:input_type -> api.review.v1.CreateReviewRequest
-	5,  // 2: api.review.v1.Review.TestConn:input_type -> api.review.v1.TestConnRequest
-	6,  // 3: api.review.v1.Review.ReplyReview:input_type -> api.review.v1.ReplyReviewRequest
-	9,  // 4: api.review.v1.Review.AppealReview:input_type -> api.review.v1.AppealReviewRequest
-	11, // 5: api.review.v1.Review.AuditAppeal:input_type -> api.review.v1.AuditAppealRequest
-	0,  // 6: api.review.v1.Review.ListReviewByStoreId:input_type -> api.review.v1.ListReviewByStoreIdRequest
-	13, // 7: api.review.v1.Review.UpdateReview:input_type -> api.review.v1.UpdateReviewRequest
-	15, // 8: api.review.v1.Review.DeleteReview:input_type -> api.review.v1.DeleteReviewRequest
-	17, // 9: api.review.v1.Review.GetReview:input_type -> api.review.v1.GetReviewRequest
-	19, // 10: api.review.v1.Review.ListReview:input_type -> api.review.v1.ListReviewRequest
-	4,  // 11: api.review.v1.Review.CreateReview:output_type -> api.review.v1.CreateReviewReply
-	8,  // 12: api.review.v1.Review.TestConn:output_type -> api.review.v1.TestConnReply
-	7,  // 13: api.review.v1.Review.ReplyReview:output_type -> api.review.v1.ReplyReviewReply
-	10, // 14: api.review.v1.Review.AppealReview:output_type -> api.review.v1.AppealReviewReply
-	12, // 15: api.review.v1.Review.AuditAppeal:output_type -> api.review.v1.AuditAppealReply
-	2,  // 16: api.review.v1.Review.ListReviewByStoreId:output_type -> api.review.v1.ListReviewByStoreIdReply
-	14, // 17: api.review.v1.Review.UpdateReview:output_type -> api.review.v1.UpdateReviewReply
-	16, // 18: api.review.v1.Review.DeleteReview:output_type -> api.review.v1.DeleteReviewReply
-	18, // 19: api.review.v1.Review.GetReview:output_type -> api.review.v1.GetReviewReply
-	20, // 20: api.review.v1.Review.ListReview:output_type -> api.review.v1.ListReviewReply
-	11, // [11:21] is the sub-list for method output_type
-	1,  // [1:11] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	3,  // 0: api.review.v1.ReviewInfo.media:type_name -> api.review.v1.MediaItem
+	2,  // 1: api.review.v1.ReviewInfo.append:type_name -> api.review.v1.ReviewAppendInfo
+	3,  // 2: api.review.v1.ReviewAppendInfo.media:type_name -> api.review.v1.MediaItem
+	3,  // 3: api.review.v1.ReviewReplyInfo.media:type_name -> api.review.v1.MediaItem
+	3,  // 4: api.review.v1.ReviewAppealInfo.media:type_name -> api.review.v1.MediaItem
+	1,  // 5: api.review.v1.ListReviewByStoreIdReply.list:type_name -> api.review.v1.ReviewInfo
+	3,  // 6: api.review.v1.CreateReviewRequest.media:type_name -> api.review.v1.MediaItem
+	3,  // 7: api.review.v1.ReplyReviewRequest.media:type_name -> api.review.v1.MediaItem
+	3,  // 8: api.review.v1.AppealReviewRequest.media:type_name -> api.review.v1.MediaItem
+	3,  // 9: api.review.v1.UpdateReviewRequest.media:type_name -> api.review.v1.MediaItem
+	1,  // 10: api.review.v1.GetReviewReply.review:type_name -> api.review.v1.ReviewInfo
+	4,  // 11: api.review.v1.GetReviewReply.reply:type_name -> api.review.v1.ReviewReplyInfo
+	5,  // 12: api.review.v1.GetReviewReply.appeal:type_name -> api.review.v1.ReviewAppealInfo
+	1,  // 13: api.review.v1.ListReviewReply.list:type_name -> api.review.v1.ReviewInfo
+	1,  // 14: api.review.v1.SearchReviewHit.review:type_name -> api.review.v1.ReviewInfo
+	28, // 15: api.review.v1.SearchReviewsReply.list:type_name -> api.review.v1.SearchReviewHit
+	31, // 16: api.review.v1.GetStoreRatingSummaryReply.scoreDistribution:type_name -> api.review.v1.ScoreCount
+	1,  // 17: api.review.v1.ListReviewBySpuReply.list:type_name -> api.review.v1.ReviewInfo
+	34, // 18: api.review.v1.ListReviewBySpuReply.tabs:type_name -> api.review.v1.ReviewTabCount
+	1,  // 19: api.review.v1.UserReviewItem.review:type_name -> api.review.v1.ReviewInfo
+	4,  // 20: api.review.v1.UserReviewItem.reply:type_name -> api.review.v1.ReviewReplyInfo
+	37, // 21: api.review.v1.ListReviewByUserReply.list:type_name -> api.review.v1.UserReviewItem
+	39, // 22: api.review.v1.GetUploadTokenRequest.files:type_name -> api.review.v1.UploadFile
+	50, // 23: api.review.v1.UploadToken.headers:type_name -> api.review.v1.UploadToken.HeadersEntry
+	41, // 24: api.review.v1.GetUploadTokenReply.tokens:type_name -> api.review.v1.UploadToken
+	44, // 25: api.review.v1.GetTagCloudReply.tags:type_name -> api.review.v1.TagCount
+	3,  // 26: api.review.v1.AppendReviewRequest.media:type_name -> api.review.v1.MediaItem
+	7,  // 27: api.review.v1.Review.CreateReview:input_type -> api.review.v1.CreateReviewRequest
+	9,  // 28: api.review.v1.Review.TestConn:input_type -> api.review.v1.TestConnRequest
+	10, // 29: api.review.v1.Review.ReplyReview:input_type -> api.review.v1.ReplyReviewRequest
+	13, // 30: api.review.v1.Review.AppealReview:input_type -> api.review.v1.AppealReviewRequest
+	15, // 31: api.review.v1.Review.AuditReview:input_type -> api.review.v1.AuditReviewRequest
+	17, // 32: api.review.v1.Review.AuditAppeal:input_type -> api.review.v1.AuditAppealRequest
+	0,  // 33: api.review.v1.Review.ListReviewByStoreId:input_type -> api.review.v1.ListReviewByStoreIdRequest
+	19, // 34: api.review.v1.Review.UpdateReview:input_type -> api.review.v1.UpdateReviewRequest
+	21, // 35: api.review.v1.Review.DeleteReview:input_type -> api.review.v1.DeleteReviewRequest
+	23, // 36: api.review.v1.Review.GetReview:input_type -> api.review.v1.GetReviewRequest
+	25, // 37: api.review.v1.Review.ListReview:input_type -> api.review.v1.ListReviewRequest
+	27, // 38: api.review.v1.Review.SearchReviews:input_type -> api.review.v1.SearchReviewsRequest
+	30, // 39: api.review.v1.Review.GetStoreRatingSummary:input_type -> api.review.v1.GetStoreRatingSummaryRequest
+	33, // 40: api.review.v1.Review.ListReviewBySpu:input_type -> api.review.v1.ListReviewBySpuRequest
+	36, // 41: api.review.v1.Review.ListReviewByUser:input_type -> api.review.v1.ListReviewByUserRequest
+	40, // 42: api.review.v1.Review.GetUploadToken:input_type -> api.review.v1.GetUploadTokenRequest
+	43, // 43: api.review.v1.Review.GetTagCloud:input_type -> api.review.v1.GetTagCloudRequest
+	46, // 44: api.review.v1.Review.AppendReview:input_type -> api.review.v1.AppendReviewRequest
+	48, // 45: api.review.v1.Review.AuditAppendReview:input_type -> api.review.v1.AuditAppendReviewRequest
+	8,  // 46: api.review.v1.Review.CreateReview:output_type -> api.review.v1.CreateReviewReply
+	12, // 47: api.review.v1.Review.TestConn:output_type -> api.review.v1.TestConnReply
+	11, // 48: api.review.v1.Review.ReplyReview:output_type -> api.review.v1.ReplyReviewReply
+	14, // 49: api.review.v1.Review.AppealReview:output_type -> api.review.v1.AppealReviewReply
+	16, // 50: api.review.v1.Review.AuditReview:output_type -> api.review.v1.AuditReviewReply
+	18, // 51: api.review.v1.Review.AuditAppeal:output_type -> api.review.v1.AuditAppealReply
+	6,  // 52: api.review.v1.Review.ListReviewByStoreId:output_type -> api.review.v1.ListReviewByStoreIdReply
+	20, // 53: api.review.v1.Review.UpdateReview:output_type -> api.review.v1.UpdateReviewReply
+	22, // 54: api.review.v1.Review.DeleteReview:output_type -> api.review.v1.DeleteReviewReply
+	24, // 55: api.review.v1.Review.GetReview:output_type -> api.review.v1.GetReviewReply
+	26, // 56: api.review.v1.Review.ListReview:output_type -> api.review.v1.ListReviewReply
+	29, // 57: api.review.v1.Review.SearchReviews:output_type -> api.review.v1.SearchReviewsReply
+	32, // 58: api.review.v1.Review.GetStoreRatingSummary:output_type -> api.review.v1.GetStoreRatingSummaryReply
+	35, // 59: api.review.v1.Review.ListReviewBySpu:output_type -> api.review.v1.ListReviewBySpuReply
+	38, // 60: api.review.v1.Review.ListReviewByUser:output_type -> api.review.v1.ListReviewByUserReply
+	42, // 61: api.review.v1.Review.GetUploadToken:output_type -> api.review.v1.GetUploadTokenReply
+	45, // 62: api.review.v1.Review.GetTagCloud:output_type -> api.review.v1.GetTagCloudReply
+	47, // 63: api.review.v1.Review.AppendReview:output_type -> api.review.v1.AppendReviewReply
+	49, // 64: api.review.v1.Review.AuditAppendReview:output_type -> api.review.v1.AuditAppendReviewReply
+	46, // [46:65] is the sub-list for method output_type
+	27, // [27:46] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_api_review_v1_review_proto_init() }
@@ -1298,14 +4011,18 @@ func file_api_review_v1_review_proto_init() {
 	if File_api_review_v1_review_proto != nil {
 		return
 	}
-	file_api_review_v1_review_proto_msgTypes[11].OneofWrappers = []any{}
+	file_api_review_v1_review_proto_msgTypes[15].OneofWrappers = []any{}
+	file_api_review_v1_review_proto_msgTypes[17].OneofWrappers = []any{}
+	file_api_review_v1_review_proto_msgTypes[25].OneofWrappers = []any{}
+	file_api_review_v1_review_proto_msgTypes[27].OneofWrappers = []any{}
+	file_api_review_v1_review_proto_msgTypes[48].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_review_v1_review_proto_rawDesc), len(file_api_review_v1_review_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -90,6 +90,17 @@ func (m *ListReviewByStoreIdRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if len(m.GetTags()) > 5 {
+		err := ListReviewByStoreIdRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 5 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ListReviewByStoreIdRequestMultiError(errors)
 	}
@@ -206,9 +217,100 @@ func (m *ReviewInfo) validate(all bool) error {
 
 	// no validation rules for Content
 
-	// no validation rules for PicInfo
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReviewInfoValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReviewInfoValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReviewInfoValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for StoreId
+
+	// no validation rules for SkuId
+
+	// no validation rules for SpuId
+
+	// no validation rules for Status
+
+	// no validation rules for Anonymous
+
+	// no validation rules for HasMedia
+
+	// no validation rules for HasReply
+
+	// no validation rules for IsDefault
+
+	// no validation rules for OpReason
+
+	// no validation rules for OpRemarks
+
+	// no validation rules for OpUser
+
+	// no validation rules for GoodsSnapshoot
 
-	// no validation rules for VideoInfo
+	// no validation rules for Version
+
+	// no validation rules for CreateAt
+
+	// no validation rules for UpdateAt
+
+	// no validation rules for UserAlias
+
+	if all {
+		switch v := interface{}(m.GetAppend()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewInfoValidationError{
+					field:  "Append",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewInfoValidationError{
+					field:  "Append",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAppend()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewInfoValidationError{
+				field:  "Append",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
 		return ReviewInfoMultiError(errors)
@@ -287,45 +389,51 @@ var _ interface {
 	ErrorName() string
 } = ReviewInfoValidationError{}
 
-// Validate checks the field values on ListReviewByStoreIdReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListReviewByStoreIdReply) Validate() error {
+// Validate checks the field values on ReviewAppendInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReviewAppendInfo) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListReviewByStoreIdReply with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ReviewAppendInfo with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListReviewByStoreIdReplyMultiError, or nil if none found.
-func (m *ListReviewByStoreIdReply) ValidateAll() error {
+// ReviewAppendInfoMultiError, or nil if none found.
+func (m *ReviewAppendInfo) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListReviewByStoreIdReply) validate(all bool) error {
+func (m *ReviewAppendInfo) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetList() {
+	// no validation rules for AppendId
+
+	// no validation rules for ReviewId
+
+	// no validation rules for Content
+
+	for idx, item := range m.GetMedia() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListReviewByStoreIdReplyValidationError{
-						field:  fmt.Sprintf("List[%v]", idx),
+					errors = append(errors, ReviewAppendInfoValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListReviewByStoreIdReplyValidationError{
-						field:  fmt.Sprintf("List[%v]", idx),
+					errors = append(errors, ReviewAppendInfoValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -333,8 +441,8 @@ func (m *ListReviewByStoreIdReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListReviewByStoreIdReplyValidationError{
-					field:  fmt.Sprintf("List[%v]", idx),
+				return ReviewAppendInfoValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -343,20 +451,26 @@ func (m *ListReviewByStoreIdReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for Status
+
+	// no validation rules for OpReason
+
+	// no validation rules for CreateAt
+
 	if len(errors) > 0 {
-		return ListReviewByStoreIdReplyMultiError(errors)
+		return ReviewAppendInfoMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListReviewByStoreIdReplyMultiError is an error wrapping multiple validation
-// errors returned by ListReviewByStoreIdReply.ValidateAll() if the designated
-// constraints aren't met.
-type ListReviewByStoreIdReplyMultiError []error
+// ReviewAppendInfoMultiError is an error wrapping multiple validation errors
+// returned by ReviewAppendInfo.ValidateAll() if the designated constraints
+// aren't met.
+type ReviewAppendInfoMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListReviewByStoreIdReplyMultiError) Error() string {
+func (m ReviewAppendInfoMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -365,11 +479,11 @@ func (m ListReviewByStoreIdReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListReviewByStoreIdReplyMultiError) AllErrors() []error { return m }
+func (m ReviewAppendInfoMultiError) AllErrors() []error { return m }
 
-// ListReviewByStoreIdReplyValidationError is the validation error returned by
-// ListReviewByStoreIdReply.Validate if the designated constraints aren't met.
-type ListReviewByStoreIdReplyValidationError struct {
+// ReviewAppendInfoValidationError is the validation error returned by
+// ReviewAppendInfo.Validate if the designated constraints aren't met.
+type ReviewAppendInfoValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -377,24 +491,22 @@ type ListReviewByStoreIdReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReviewByStoreIdReplyValidationError) Field() string { return e.field }
+func (e ReviewAppendInfoValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReviewByStoreIdReplyValidationError) Reason() string { return e.reason }
+func (e ReviewAppendInfoValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReviewByStoreIdReplyValidationError) Cause() error { return e.cause }
+func (e ReviewAppendInfoValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReviewByStoreIdReplyValidationError) Key() bool { return e.key }
+func (e ReviewAppendInfoValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReviewByStoreIdReplyValidationError) ErrorName() string {
-	return "ListReviewByStoreIdReplyValidationError"
-}
+func (e ReviewAppendInfoValidationError) ErrorName() string { return "ReviewAppendInfoValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListReviewByStoreIdReplyValidationError) Error() string {
+func (e ReviewAppendInfoValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -406,14 +518,14 @@ func (e ListReviewByStoreIdReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReviewByStoreIdReply.%s: %s%s",
+		"invalid %sReviewAppendInfo.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReviewByStoreIdReplyValidationError{}
+var _ error = ReviewAppendInfoValidationError{}
 
 var _ interface {
 	Field() string
@@ -421,34 +533,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReviewByStoreIdReplyValidationError{}
+} = ReviewAppendInfoValidationError{}
 
-// Validate checks the field values on CreateReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReviewRequest) Validate() error {
+// Validate checks the field values on MediaItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MediaItem) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateReviewRequestMultiError, or nil if none found.
-func (m *CreateReviewRequest) ValidateAll() error {
+// ValidateAll checks the field values on MediaItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MediaItemMultiError, or nil
+// if none found.
+func (m *MediaItem) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReviewRequest) validate(all bool) error {
+func (m *MediaItem) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUserId() <= 0 {
-		err := CreateReviewRequestValidationError{
-			field:  "UserId",
-			reason: "value must be greater than 0",
+	if utf8.RuneCountInString(m.GetUrl()) > 512 {
+		err := MediaItemValidationError{
+			field:  "Url",
+			reason: "value length must be at most 512 runes",
 		}
 		if !all {
 			return err
@@ -456,10 +568,20 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetOrderId() <= 0 {
-		err := CreateReviewRequestValidationError{
-			field:  "OrderId",
-			reason: "value must be greater than 0",
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = MediaItemValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := MediaItemValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
 		}
 		if !all {
 			return err
@@ -467,10 +589,10 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _CreateReviewRequest_Score_InLookup[m.GetScore()]; !ok {
-		err := CreateReviewRequestValidationError{
-			field:  "Score",
-			reason: "value must be in list [1 2 3 4 5]",
+	if _, ok := _MediaItem_Type_InLookup[m.GetType()]; !ok {
+		err := MediaItemValidationError{
+			field:  "Type",
+			reason: "value must be in list [image video]",
 		}
 		if !all {
 			return err
@@ -478,10 +600,10 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _CreateReviewRequest_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
-		err := CreateReviewRequestValidationError{
-			field:  "ServiceScore",
-			reason: "value must be in list [1 2 3 4 5]",
+	if m.GetWidth() < 0 {
+		err := MediaItemValidationError{
+			field:  "Width",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -489,10 +611,10 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _CreateReviewRequest_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
-		err := CreateReviewRequestValidationError{
-			field:  "ExpressScore",
-			reason: "value must be in list [1 2 3 4 5]",
+	if m.GetHeight() < 0 {
+		err := MediaItemValidationError{
+			field:  "Height",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -500,10 +622,10 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetContent()); l < 8 || l > 255 {
-		err := CreateReviewRequestValidationError{
-			field:  "Content",
-			reason: "value length must be between 8 and 255 runes, inclusive",
+	if m.GetDuration() < 0 {
+		err := MediaItemValidationError{
+			field:  "Duration",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -511,26 +633,55 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for PicInfo
+	if m.GetCover() != "" {
 
-	// no validation rules for VideoInfo
+		if utf8.RuneCountInString(m.GetCover()) > 512 {
+			err := MediaItemValidationError{
+				field:  "Cover",
+				reason: "value length must be at most 512 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	// no validation rules for Anonymous
+		if uri, err := url.Parse(m.GetCover()); err != nil {
+			err = MediaItemValidationError{
+				field:  "Cover",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := MediaItemValidationError{
+				field:  "Cover",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return CreateReviewRequestMultiError(errors)
+		return MediaItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReviewRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateReviewRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateReviewRequestMultiError []error
+// MediaItemMultiError is an error wrapping multiple validation errors returned
+// by MediaItem.ValidateAll() if the designated constraints aren't met.
+type MediaItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReviewRequestMultiError) Error() string {
+func (m MediaItemMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -539,11 +690,11 @@ func (m CreateReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReviewRequestMultiError) AllErrors() []error { return m }
+func (m MediaItemMultiError) AllErrors() []error { return m }
 
-// CreateReviewRequestValidationError is the validation error returned by
-// CreateReviewRequest.Validate if the designated constraints aren't met.
-type CreateReviewRequestValidationError struct {
+// MediaItemValidationError is the validation error returned by
+// MediaItem.Validate if the designated constraints aren't met.
+type MediaItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -551,24 +702,22 @@ type CreateReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReviewRequestValidationError) Field() string { return e.field }
+func (e MediaItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReviewRequestValidationError) Reason() string { return e.reason }
+func (e MediaItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReviewRequestValidationError) Cause() error { return e.cause }
+func (e MediaItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReviewRequestValidationError) Key() bool { return e.key }
+func (e MediaItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReviewRequestValidationError) ErrorName() string {
-	return "CreateReviewRequestValidationError"
-}
+func (e MediaItemValidationError) ErrorName() string { return "MediaItemValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateReviewRequestValidationError) Error() string {
+func (e MediaItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -580,14 +729,14 @@ func (e CreateReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReviewRequest.%s: %s%s",
+		"invalid %sMediaItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReviewRequestValidationError{}
+var _ error = MediaItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -595,70 +744,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReviewRequestValidationError{}
-
-var _CreateReviewRequest_Score_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
-}
-
-var _CreateReviewRequest_ServiceScore_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
-}
+} = MediaItemValidationError{}
 
-var _CreateReviewRequest_ExpressScore_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
+var _MediaItem_Type_InLookup = map[string]struct{}{
+	"image": {},
+	"video": {},
 }
 
-// Validate checks the field values on CreateReviewReply with the rules defined
+// Validate checks the field values on ReviewReplyInfo with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *CreateReviewReply) Validate() error {
+func (m *ReviewReplyInfo) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReviewReply with the rules
+// ValidateAll checks the field values on ReviewReplyInfo with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReviewReplyMultiError, or nil if none found.
-func (m *CreateReviewReply) ValidateAll() error {
+// ReviewReplyInfoMultiError, or nil if none found.
+func (m *ReviewReplyInfo) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReviewReply) validate(all bool) error {
+func (m *ReviewReplyInfo) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for ReplyId
+
 	// no validation rules for ReviewId
 
+	// no validation rules for StoreId
+
+	// no validation rules for Content
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReviewReplyInfoValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReviewReplyInfoValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReviewReplyInfoValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CreateAt
+
 	if len(errors) > 0 {
-		return CreateReviewReplyMultiError(errors)
+		return ReviewReplyInfoMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by CreateReviewReply.ValidateAll() if the designated constraints
+// ReviewReplyInfoMultiError is an error wrapping multiple validation errors
+// returned by ReviewReplyInfo.ValidateAll() if the designated constraints
 // aren't met.
-type CreateReviewReplyMultiError []error
+type ReviewReplyInfoMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReviewReplyMultiError) Error() string {
+func (m ReviewReplyInfoMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -667,11 +839,11 @@ func (m CreateReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReviewReplyMultiError) AllErrors() []error { return m }
+func (m ReviewReplyInfoMultiError) AllErrors() []error { return m }
 
-// CreateReviewReplyValidationError is the validation error returned by
-// CreateReviewReply.Validate if the designated constraints aren't met.
-type CreateReviewReplyValidationError struct {
+// ReviewReplyInfoValidationError is the validation error returned by
+// ReviewReplyInfo.Validate if the designated constraints aren't met.
+type ReviewReplyInfoValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -679,24 +851,4131 @@ type CreateReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReviewReplyValidationError) Field() string { return e.field }
+func (e ReviewReplyInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewReplyInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewReplyInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewReplyInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewReplyInfoValidationError) ErrorName() string { return "ReviewReplyInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewReplyInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewReplyInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewReplyInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewReplyInfoValidationError{}
+
+// Validate checks the field values on ReviewAppealInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReviewAppealInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewAppealInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewAppealInfoMultiError, or nil if none found.
+func (m *ReviewAppealInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewAppealInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppealId
+
+	// no validation rules for ReviewId
+
+	// no validation rules for StoreId
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for Content
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReviewAppealInfoValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReviewAppealInfoValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReviewAppealInfoValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for OpRemarks
+
+	// no validation rules for OpUser
+
+	// no validation rules for CreateAt
+
+	if len(errors) > 0 {
+		return ReviewAppealInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewAppealInfoMultiError is an error wrapping multiple validation errors
+// returned by ReviewAppealInfo.ValidateAll() if the designated constraints
+// aren't met.
+type ReviewAppealInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewAppealInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewAppealInfoMultiError) AllErrors() []error { return m }
+
+// ReviewAppealInfoValidationError is the validation error returned by
+// ReviewAppealInfo.Validate if the designated constraints aren't met.
+type ReviewAppealInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewAppealInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewAppealInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewAppealInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewAppealInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewAppealInfoValidationError) ErrorName() string { return "ReviewAppealInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewAppealInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewAppealInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewAppealInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewAppealInfoValidationError{}
+
+// Validate checks the field values on ListReviewByStoreIdReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewByStoreIdReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewByStoreIdReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewByStoreIdReplyMultiError, or nil if none found.
+func (m *ListReviewByStoreIdReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewByStoreIdReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewByStoreIdReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewByStoreIdReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewByStoreIdReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListReviewByStoreIdReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewByStoreIdReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReviewByStoreIdReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewByStoreIdReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewByStoreIdReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewByStoreIdReplyMultiError) AllErrors() []error { return m }
+
+// ListReviewByStoreIdReplyValidationError is the validation error returned by
+// ListReviewByStoreIdReply.Validate if the designated constraints aren't met.
+type ListReviewByStoreIdReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewByStoreIdReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewByStoreIdReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewByStoreIdReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewByStoreIdReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewByStoreIdReplyValidationError) ErrorName() string {
+	return "ListReviewByStoreIdReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewByStoreIdReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewByStoreIdReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewByStoreIdReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewByStoreIdReplyValidationError{}
+
+// Validate checks the field values on CreateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewRequestMultiError, or nil if none found.
+func (m *CreateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if m.GetOrderId() <= 0 {
+		err := CreateReviewRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateReviewRequest_Score_InLookup[m.GetScore()]; !ok {
+		err := CreateReviewRequestValidationError{
+			field:  "Score",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateReviewRequest_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
+		err := CreateReviewRequestValidationError{
+			field:  "ServiceScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateReviewRequest_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
+		err := CreateReviewRequestValidationError{
+			field:  "ExpressScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 8 || l > 255 {
+		err := CreateReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 8 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMedia()) > 10 {
+		err := CreateReviewRequestValidationError{
+			field:  "Media",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateReviewRequestValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Anonymous
+
+	if len(m.GetTags()) > 10 {
+		err := CreateReviewRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 20 {
+			err := CreateReviewRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 20 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReviewRequestMultiError) AllErrors() []error { return m }
+
+// CreateReviewRequestValidationError is the validation error returned by
+// CreateReviewRequest.Validate if the designated constraints aren't met.
+type CreateReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReviewRequestValidationError) ErrorName() string {
+	return "CreateReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReviewRequestValidationError{}
+
+var _CreateReviewRequest_Score_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _CreateReviewRequest_ServiceScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _CreateReviewRequest_ExpressScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+// Validate checks the field values on CreateReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewReplyMultiError, or nil if none found.
+func (m *CreateReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewId
+
+	if len(errors) > 0 {
+		return CreateReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReviewReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReviewReplyMultiError) AllErrors() []error { return m }
+
+// CreateReviewReplyValidationError is the validation error returned by
+// CreateReviewReply.Validate if the designated constraints aren't met.
+type CreateReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReviewReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
 func (e CreateReviewReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReviewReplyValidationError) Cause() error { return e.cause }
+func (e CreateReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReviewReplyValidationError) ErrorName() string {
+	return "CreateReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReviewReplyValidationError{}
+
+// Validate checks the field values on TestConnRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TestConnRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestConnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TestConnRequestMultiError, or nil if none found.
+func (m *TestConnRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestConnRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TestConnRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestConnRequestMultiError is an error wrapping multiple validation errors
+// returned by TestConnRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TestConnRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestConnRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestConnRequestMultiError) AllErrors() []error { return m }
+
+// TestConnRequestValidationError is the validation error returned by
+// TestConnRequest.Validate if the designated constraints aren't met.
+type TestConnRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestConnRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestConnRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestConnRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestConnRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestConnRequestValidationError) ErrorName() string { return "TestConnRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestConnRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestConnRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestConnRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestConnRequestValidationError{}
+
+// Validate checks the field values on ReplyReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplyReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplyReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplyReviewRequestMultiError, or nil if none found.
+func (m *ReplyReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplyReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewId() <= 0 {
+		err := ReplyReviewRequestValidationError{
+			field:  "ReviewId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StoreId
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 2 || l > 200 {
+		err := ReplyReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 2 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMedia()) > 10 {
+		err := ReplyReviewRequestValidationError{
+			field:  "Media",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReplyReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReplyReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReplyReviewRequestValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReplyReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplyReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by ReplyReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReplyReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplyReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplyReviewRequestMultiError) AllErrors() []error { return m }
+
+// ReplyReviewRequestValidationError is the validation error returned by
+// ReplyReviewRequest.Validate if the designated constraints aren't met.
+type ReplyReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplyReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplyReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplyReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplyReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplyReviewRequestValidationError) ErrorName() string {
+	return "ReplyReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplyReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplyReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplyReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplyReviewRequestValidationError{}
+
+// Validate checks the field values on ReplyReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReplyReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplyReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplyReviewReplyMultiError, or nil if none found.
+func (m *ReplyReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplyReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReplyId
+
+	if len(errors) > 0 {
+		return ReplyReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplyReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by ReplyReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type ReplyReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplyReviewReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplyReviewReplyMultiError) AllErrors() []error { return m }
+
+// ReplyReviewReplyValidationError is the validation error returned by
+// ReplyReviewReply.Validate if the designated constraints aren't met.
+type ReplyReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplyReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplyReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplyReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplyReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplyReviewReplyValidationError) ErrorName() string { return "ReplyReviewReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReplyReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplyReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplyReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplyReviewReplyValidationError{}
+
+// Validate checks the field values on TestConnReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestConnReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestConnReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TestConnReplyMultiError, or
+// nil if none found.
+func (m *TestConnReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestConnReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pong
+
+	if len(errors) > 0 {
+		return TestConnReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestConnReplyMultiError is an error wrapping multiple validation errors
+// returned by TestConnReply.ValidateAll() if the designated constraints
+// aren't met.
+type TestConnReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestConnReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestConnReplyMultiError) AllErrors() []error { return m }
+
+// TestConnReplyValidationError is the validation error returned by
+// TestConnReply.Validate if the designated constraints aren't met.
+type TestConnReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestConnReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestConnReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestConnReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestConnReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestConnReplyValidationError) ErrorName() string { return "TestConnReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestConnReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestConnReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestConnReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestConnReplyValidationError{}
+
+// Validate checks the field values on AppealReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AppealReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppealReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppealReviewRequestMultiError, or nil if none found.
+func (m *AppealReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppealReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewId() <= 0 {
+		err := AppealReviewRequestValidationError{
+			field:  "ReviewId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StoreId
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 2 || l > 200 {
+		err := AppealReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 2 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMedia()) > 10 {
+		err := AppealReviewRequestValidationError{
+			field:  "Media",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AppealReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AppealReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppealReviewRequestValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for OpUser
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return AppealReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppealReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by AppealReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AppealReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppealReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppealReviewRequestMultiError) AllErrors() []error { return m }
+
+// AppealReviewRequestValidationError is the validation error returned by
+// AppealReviewRequest.Validate if the designated constraints aren't met.
+type AppealReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppealReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppealReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppealReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppealReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppealReviewRequestValidationError) ErrorName() string {
+	return "AppealReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AppealReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppealReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppealReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppealReviewRequestValidationError{}
+
+// Validate checks the field values on AppealReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AppealReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppealReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppealReviewReplyMultiError, or nil if none found.
+func (m *AppealReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppealReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppealId
+
+	if len(errors) > 0 {
+		return AppealReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppealReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by AppealReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type AppealReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppealReviewReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppealReviewReplyMultiError) AllErrors() []error { return m }
+
+// AppealReviewReplyValidationError is the validation error returned by
+// AppealReviewReply.Validate if the designated constraints aren't met.
+type AppealReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppealReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppealReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppealReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppealReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppealReviewReplyValidationError) ErrorName() string {
+	return "AppealReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AppealReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppealReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppealReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppealReviewReplyValidationError{}
+
+// Validate checks the field values on AuditReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditReviewRequestMultiError, or nil if none found.
+func (m *AuditReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewId() <= 0 {
+		err := AuditReviewRequestValidationError{
+			field:  "ReviewId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AuditReviewRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := AuditReviewRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [20 30]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OpUser
+
+	// no validation rules for OpReason
+
+	if m.OpRemarks != nil {
+		// no validation rules for OpRemarks
+	}
+
+	if len(errors) > 0 {
+		return AuditReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by AuditReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuditReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditReviewRequestMultiError) AllErrors() []error { return m }
+
+// AuditReviewRequestValidationError is the validation error returned by
+// AuditReviewRequest.Validate if the designated constraints aren't met.
+type AuditReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditReviewRequestValidationError) ErrorName() string {
+	return "AuditReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditReviewRequestValidationError{}
+
+var _AuditReviewRequest_Status_InLookup = map[int32]struct{}{
+	20: {},
+	30: {},
+}
+
+// Validate checks the field values on AuditReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuditReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditReviewReplyMultiError, or nil if none found.
+func (m *AuditReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AuditReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by AuditReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type AuditReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditReviewReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditReviewReplyMultiError) AllErrors() []error { return m }
+
+// AuditReviewReplyValidationError is the validation error returned by
+// AuditReviewReply.Validate if the designated constraints aren't met.
+type AuditReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditReviewReplyValidationError) ErrorName() string { return "AuditReviewReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditReviewReplyValidationError{}
+
+// Validate checks the field values on AuditAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditAppealRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditAppealRequestMultiError, or nil if none found.
+func (m *AuditAppealRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditAppealRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAppealId() <= 0 {
+		err := AuditAppealRequestValidationError{
+			field:  "AppealId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReviewId() <= 0 {
+		err := AuditAppealRequestValidationError{
+			field:  "ReviewId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStatus() <= 0 {
+		err := AuditAppealRequestValidationError{
+			field:  "Status",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OpUser
+
+	if utf8.RuneCountInString(m.GetOpReason()) < 2 {
+		err := AuditAppealRequestValidationError{
+			field:  "OpReason",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.OpRemarks != nil {
+		// no validation rules for OpRemarks
+	}
+
+	if len(errors) > 0 {
+		return AuditAppealRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditAppealRequestMultiError is an error wrapping multiple validation errors
+// returned by AuditAppealRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuditAppealRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditAppealRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditAppealRequestMultiError) AllErrors() []error { return m }
+
+// AuditAppealRequestValidationError is the validation error returned by
+// AuditAppealRequest.Validate if the designated constraints aren't met.
+type AuditAppealRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditAppealRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditAppealRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditAppealRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditAppealRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditAppealRequestValidationError) ErrorName() string {
+	return "AuditAppealRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditAppealRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditAppealRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditAppealRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditAppealRequestValidationError{}
+
+// Validate checks the field values on AuditAppealReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuditAppealReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditAppealReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditAppealReplyMultiError, or nil if none found.
+func (m *AuditAppealReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditAppealReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AuditAppealReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditAppealReplyMultiError is an error wrapping multiple validation errors
+// returned by AuditAppealReply.ValidateAll() if the designated constraints
+// aren't met.
+type AuditAppealReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditAppealReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditAppealReplyMultiError) AllErrors() []error { return m }
+
+// AuditAppealReplyValidationError is the validation error returned by
+// AuditAppealReply.Validate if the designated constraints aren't met.
+type AuditAppealReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditAppealReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditAppealReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditAppealReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditAppealReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditAppealReplyValidationError) ErrorName() string { return "AuditAppealReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditAppealReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditAppealReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditAppealReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditAppealReplyValidationError{}
+
+// Validate checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewRequestMultiError, or nil if none found.
+func (m *UpdateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewId() <= 0 {
+		err := UpdateReviewRequestValidationError{
+			field:  "ReviewId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for UserId
+
+	if m.GetVersion() < 0 {
+		err := UpdateReviewRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateReviewRequest_Score_InLookup[m.GetScore()]; !ok {
+		err := UpdateReviewRequestValidationError{
+			field:  "Score",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateReviewRequest_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
+		err := UpdateReviewRequestValidationError{
+			field:  "ServiceScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateReviewRequest_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
+		err := UpdateReviewRequestValidationError{
+			field:  "ExpressScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 8 || l > 255 {
+		err := UpdateReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 8 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMedia()) > 10 {
+		err := UpdateReviewRequestValidationError{
+			field:  "Media",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateReviewRequestValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetTags()) > 10 {
+		err := UpdateReviewRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 20 {
+			err := UpdateReviewRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 20 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewRequestMultiError) AllErrors() []error { return m }
+
+// UpdateReviewRequestValidationError is the validation error returned by
+// UpdateReviewRequest.Validate if the designated constraints aren't met.
+type UpdateReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewRequestValidationError) ErrorName() string {
+	return "UpdateReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewRequestValidationError{}
+
+var _UpdateReviewRequest_Score_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _UpdateReviewRequest_ServiceScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _UpdateReviewRequest_ExpressScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+// Validate checks the field values on UpdateReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewReplyMultiError, or nil if none found.
+func (m *UpdateReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewId
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return UpdateReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by UpdateReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewReplyMultiError) AllErrors() []error { return m }
+
+// UpdateReviewReplyValidationError is the validation error returned by
+// UpdateReviewReply.Validate if the designated constraints aren't met.
+type UpdateReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewReplyValidationError) ErrorName() string {
+	return "UpdateReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewReplyValidationError{}
+
+// Validate checks the field values on DeleteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReviewRequestMultiError, or nil if none found.
+func (m *DeleteReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewId() <= 0 {
+		err := DeleteReviewRequestValidationError{
+			field:  "ReviewId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for OpUser
+
+	if len(errors) > 0 {
+		return DeleteReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReviewRequestMultiError) AllErrors() []error { return m }
+
+// DeleteReviewRequestValidationError is the validation error returned by
+// DeleteReviewRequest.Validate if the designated constraints aren't met.
+type DeleteReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReviewRequestValidationError) ErrorName() string {
+	return "DeleteReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReviewRequestValidationError{}
+
+// Validate checks the field values on DeleteReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReviewReplyMultiError, or nil if none found.
+func (m *DeleteReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReviewReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReviewReplyMultiError) AllErrors() []error { return m }
+
+// DeleteReviewReplyValidationError is the validation error returned by
+// DeleteReviewReply.Validate if the designated constraints aren't met.
+type DeleteReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReviewReplyValidationError) ErrorName() string {
+	return "DeleteReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReviewReplyValidationError{}
+
+// Validate checks the field values on GetReviewRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewRequestMultiError, or nil if none found.
+func (m *GetReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewId() <= 0 {
+		err := GetReviewRequestValidationError{
+			field:  "ReviewId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by GetReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewRequestMultiError) AllErrors() []error { return m }
+
+// GetReviewRequestValidationError is the validation error returned by
+// GetReviewRequest.Validate if the designated constraints aren't met.
+type GetReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewRequestValidationError) ErrorName() string { return "GetReviewRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewRequestValidationError{}
+
+// Validate checks the field values on GetReviewReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetReviewReplyMultiError,
+// or nil if none found.
+func (m *GetReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewReplyValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewReplyValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewReplyValidationError{
+				field:  "Review",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReply()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewReplyValidationError{
+					field:  "Reply",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewReplyValidationError{
+					field:  "Reply",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReply()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewReplyValidationError{
+				field:  "Reply",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAppeal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewReplyValidationError{
+					field:  "Appeal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewReplyValidationError{
+					field:  "Appeal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAppeal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewReplyValidationError{
+				field:  "Appeal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by GetReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewReplyMultiError) AllErrors() []error { return m }
+
+// GetReviewReplyValidationError is the validation error returned by
+// GetReviewReply.Validate if the designated constraints aren't met.
+type GetReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewReplyValidationError) ErrorName() string { return "GetReviewReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewReplyValidationError{}
+
+// Validate checks the field values on ListReviewRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewRequestMultiError, or nil if none found.
+func (m *ListReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for SpuId
+
+	// no validation rules for SkuId
+
+	// no validation rules for StoreId
+
+	if val := m.GetMinScore(); val < 0 || val > 5 {
+		err := ListReviewRequestValidationError{
+			field:  "MinScore",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxScore(); val < 0 || val > 5 {
+		err := ListReviewRequestValidationError{
+			field:  "MaxScore",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	if _, ok := _ListReviewRequest_SortBy_InLookup[m.GetSortBy()]; !ok {
+		err := ListReviewRequestValidationError{
+			field:  "SortBy",
+			reason: "value must be in list [ time score]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Asc
+
+	// no validation rules for PageToken
+
+	if val := m.GetSize(); val < 0 || val > 50 {
+		err := ListReviewRequestValidationError{
+			field:  "Size",
+			reason: "value must be inside range [0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) > 5 {
+		err := ListReviewRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 5 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Status != nil {
+
+		if _, ok := _ListReviewRequest_Status_InLookup[m.GetStatus()]; !ok {
+			err := ListReviewRequestValidationError{
+				field:  "Status",
+				reason: "value must be in list [10 20 30 40]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.HasMedia != nil {
+		// no validation rules for HasMedia
+	}
+
+	if m.HasReply != nil {
+		// no validation rules for HasReply
+	}
+
+	if len(errors) > 0 {
+		return ListReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by ListReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewRequestMultiError) AllErrors() []error { return m }
+
+// ListReviewRequestValidationError is the validation error returned by
+// ListReviewRequest.Validate if the designated constraints aren't met.
+type ListReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewRequestValidationError) ErrorName() string {
+	return "ListReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewRequestValidationError{}
+
+var _ListReviewRequest_Status_InLookup = map[int32]struct{}{
+	10: {},
+	20: {},
+	30: {},
+	40: {},
+}
+
+var _ListReviewRequest_SortBy_InLookup = map[string]struct{}{
+	"":      {},
+	"time":  {},
+	"score": {},
+}
+
+// Validate checks the field values on ListReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewReplyMultiError, or nil if none found.
+func (m *ListReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by ListReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewReplyMultiError) AllErrors() []error { return m }
+
+// ListReviewReplyValidationError is the validation error returned by
+// ListReviewReply.Validate if the designated constraints aren't met.
+type ListReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewReplyValidationError) ErrorName() string { return "ListReviewReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewReplyValidationError{}
+
+// Validate checks the field values on SearchReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchReviewsRequestMultiError, or nil if none found.
+func (m *SearchReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetKeyword()); l < 1 || l > 64 {
+		err := SearchReviewsRequestValidationError{
+			field:  "Keyword",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StoreId
+
+	// no validation rules for SpuId
+
+	if val := m.GetMinScore(); val < 0 || val > 5 {
+		err := SearchReviewsRequestValidationError{
+			field:  "MinScore",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxScore(); val < 0 || val > 5 {
+		err := SearchReviewsRequestValidationError{
+			field:  "MaxScore",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if val := m.GetSize(); val < 0 || val > 50 {
+		err := SearchReviewsRequestValidationError{
+			field:  "Size",
+			reason: "value must be inside range [0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) > 5 {
+		err := SearchReviewsRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 5 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Status != nil {
+
+		if _, ok := _SearchReviewsRequest_Status_InLookup[m.GetStatus()]; !ok {
+			err := SearchReviewsRequestValidationError{
+				field:  "Status",
+				reason: "value must be in list [10 20 30 40]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchReviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchReviewsRequestMultiError) AllErrors() []error { return m }
+
+// SearchReviewsRequestValidationError is the validation error returned by
+// SearchReviewsRequest.Validate if the designated constraints aren't met.
+type SearchReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchReviewsRequestValidationError) ErrorName() string {
+	return "SearchReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchReviewsRequestValidationError{}
+
+var _SearchReviewsRequest_Status_InLookup = map[int32]struct{}{
+	10: {},
+	20: {},
+	30: {},
+	40: {},
+}
+
+// Validate checks the field values on SearchReviewHit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchReviewHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchReviewHit with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchReviewHitMultiError, or nil if none found.
+func (m *SearchReviewHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchReviewHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchReviewHitValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchReviewHitValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchReviewHitValidationError{
+				field:  "Review",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchReviewHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchReviewHitMultiError is an error wrapping multiple validation errors
+// returned by SearchReviewHit.ValidateAll() if the designated constraints
+// aren't met.
+type SearchReviewHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchReviewHitMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchReviewHitMultiError) AllErrors() []error { return m }
+
+// SearchReviewHitValidationError is the validation error returned by
+// SearchReviewHit.Validate if the designated constraints aren't met.
+type SearchReviewHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchReviewHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchReviewHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchReviewHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchReviewHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchReviewHitValidationError) ErrorName() string { return "SearchReviewHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchReviewHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchReviewHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchReviewHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchReviewHitValidationError{}
+
+// Validate checks the field values on SearchReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchReviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchReviewsReplyMultiError, or nil if none found.
+func (m *SearchReviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchReviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchReviewsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchReviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchReviewsReplyMultiError is an error wrapping multiple validation errors
+// returned by SearchReviewsReply.ValidateAll() if the designated constraints
+// aren't met.
+type SearchReviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchReviewsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchReviewsReplyMultiError) AllErrors() []error { return m }
+
+// SearchReviewsReplyValidationError is the validation error returned by
+// SearchReviewsReply.Validate if the designated constraints aren't met.
+type SearchReviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchReviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchReviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchReviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchReviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchReviewsReplyValidationError) ErrorName() string {
+	return "SearchReviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchReviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchReviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchReviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchReviewsReplyValidationError{}
+
+// Validate checks the field values on GetStoreRatingSummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStoreRatingSummaryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStoreRatingSummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStoreRatingSummaryRequestMultiError, or nil if none found.
+func (m *GetStoreRatingSummaryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStoreRatingSummaryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreId() <= 0 {
+		err := GetStoreRatingSummaryRequestValidationError{
+			field:  "StoreId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	if len(errors) > 0 {
+		return GetStoreRatingSummaryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStoreRatingSummaryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetStoreRatingSummaryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetStoreRatingSummaryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStoreRatingSummaryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStoreRatingSummaryRequestMultiError) AllErrors() []error { return m }
+
+// GetStoreRatingSummaryRequestValidationError is the validation error returned
+// by GetStoreRatingSummaryRequest.Validate if the designated constraints
+// aren't met.
+type GetStoreRatingSummaryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStoreRatingSummaryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStoreRatingSummaryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStoreRatingSummaryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStoreRatingSummaryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStoreRatingSummaryRequestValidationError) ErrorName() string {
+	return "GetStoreRatingSummaryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStoreRatingSummaryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStoreRatingSummaryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStoreRatingSummaryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStoreRatingSummaryRequestValidationError{}
+
+// Validate checks the field values on ScoreCount with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ScoreCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScoreCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScoreCountMultiError, or
+// nil if none found.
+func (m *ScoreCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScoreCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Score
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ScoreCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScoreCountMultiError is an error wrapping multiple validation errors
+// returned by ScoreCount.ValidateAll() if the designated constraints aren't met.
+type ScoreCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScoreCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScoreCountMultiError) AllErrors() []error { return m }
+
+// ScoreCountValidationError is the validation error returned by
+// ScoreCount.Validate if the designated constraints aren't met.
+type ScoreCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScoreCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScoreCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScoreCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScoreCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScoreCountValidationError) ErrorName() string { return "ScoreCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScoreCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScoreCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScoreCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScoreCountValidationError{}
+
+// Validate checks the field values on GetStoreRatingSummaryReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStoreRatingSummaryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStoreRatingSummaryReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStoreRatingSummaryReplyMultiError, or nil if none found.
+func (m *GetStoreRatingSummaryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStoreRatingSummaryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StoreId
+
+	// no validation rules for Total
+
+	// no validation rules for AvgScore
+
+	// no validation rules for AvgServiceScore
+
+	// no validation rules for AvgExpressScore
+
+	for idx, item := range m.GetScoreDistribution() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetStoreRatingSummaryReplyValidationError{
+						field:  fmt.Sprintf("ScoreDistribution[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetStoreRatingSummaryReplyValidationError{
+						field:  fmt.Sprintf("ScoreDistribution[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetStoreRatingSummaryReplyValidationError{
+					field:  fmt.Sprintf("ScoreDistribution[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MediaCount
+
+	// no validation rules for ReplyCount
+
+	// no validation rules for ReplyRate
+
+	if len(errors) > 0 {
+		return GetStoreRatingSummaryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStoreRatingSummaryReplyMultiError is an error wrapping multiple
+// validation errors returned by GetStoreRatingSummaryReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetStoreRatingSummaryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStoreRatingSummaryReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStoreRatingSummaryReplyMultiError) AllErrors() []error { return m }
+
+// GetStoreRatingSummaryReplyValidationError is the validation error returned
+// by GetStoreRatingSummaryReply.Validate if the designated constraints aren't met.
+type GetStoreRatingSummaryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStoreRatingSummaryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStoreRatingSummaryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStoreRatingSummaryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStoreRatingSummaryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStoreRatingSummaryReplyValidationError) ErrorName() string {
+	return "GetStoreRatingSummaryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStoreRatingSummaryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStoreRatingSummaryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStoreRatingSummaryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStoreRatingSummaryReplyValidationError{}
+
+// Validate checks the field values on ListReviewBySpuRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewBySpuRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewBySpuRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewBySpuRequestMultiError, or nil if none found.
+func (m *ListReviewBySpuRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewBySpuRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSpuId() <= 0 {
+		err := ListReviewBySpuRequestValidationError{
+			field:  "SpuId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SkuId
+
+	if _, ok := _ListReviewBySpuRequest_Tab_InLookup[m.GetTab()]; !ok {
+		err := ListReviewBySpuRequestValidationError{
+			field:  "Tab",
+			reason: "value must be in list [ all media positive neutral negative]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if val := m.GetSize(); val < 0 || val > 50 {
+		err := ListReviewBySpuRequestValidationError{
+			field:  "Size",
+			reason: "value must be inside range [0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) > 5 {
+		err := ListReviewBySpuRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 5 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListReviewBySpuRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewBySpuRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReviewBySpuRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewBySpuRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewBySpuRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewBySpuRequestMultiError) AllErrors() []error { return m }
+
+// ListReviewBySpuRequestValidationError is the validation error returned by
+// ListReviewBySpuRequest.Validate if the designated constraints aren't met.
+type ListReviewBySpuRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewBySpuRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewBySpuRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewBySpuRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReviewReplyValidationError) Key() bool { return e.key }
+func (e ListReviewBySpuRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReviewReplyValidationError) ErrorName() string {
-	return "CreateReviewReplyValidationError"
+func (e ListReviewBySpuRequestValidationError) ErrorName() string {
+	return "ListReviewBySpuRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReviewReplyValidationError) Error() string {
+func (e ListReviewBySpuRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -708,14 +4987,14 @@ func (e CreateReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReviewReply.%s: %s%s",
+		"invalid %sListReviewBySpuRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReviewReplyValidationError{}
+var _ error = ListReviewBySpuRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -723,44 +5002,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReviewReplyValidationError{}
+} = ListReviewBySpuRequestValidationError{}
 
-// Validate checks the field values on TestConnRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TestConnRequest) Validate() error {
+var _ListReviewBySpuRequest_Tab_InLookup = map[string]struct{}{
+	"":         {},
+	"all":      {},
+	"media":    {},
+	"positive": {},
+	"neutral":  {},
+	"negative": {},
+}
+
+// Validate checks the field values on ReviewTabCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewTabCount) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TestConnRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TestConnRequestMultiError, or nil if none found.
-func (m *TestConnRequest) ValidateAll() error {
+// ValidateAll checks the field values on ReviewTabCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewTabCountMultiError,
+// or nil if none found.
+func (m *ReviewTabCount) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TestConnRequest) validate(all bool) error {
+func (m *ReviewTabCount) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Tab
+
+	// no validation rules for Count
+
 	if len(errors) > 0 {
-		return TestConnRequestMultiError(errors)
+		return ReviewTabCountMultiError(errors)
 	}
 
 	return nil
 }
 
-// TestConnRequestMultiError is an error wrapping multiple validation errors
-// returned by TestConnRequest.ValidateAll() if the designated constraints
+// ReviewTabCountMultiError is an error wrapping multiple validation errors
+// returned by ReviewTabCount.ValidateAll() if the designated constraints
 // aren't met.
-type TestConnRequestMultiError []error
+type ReviewTabCountMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TestConnRequestMultiError) Error() string {
+func (m ReviewTabCountMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -769,11 +5061,11 @@ func (m TestConnRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TestConnRequestMultiError) AllErrors() []error { return m }
+func (m ReviewTabCountMultiError) AllErrors() []error { return m }
 
-// TestConnRequestValidationError is the validation error returned by
-// TestConnRequest.Validate if the designated constraints aren't met.
-type TestConnRequestValidationError struct {
+// ReviewTabCountValidationError is the validation error returned by
+// ReviewTabCount.Validate if the designated constraints aren't met.
+type ReviewTabCountValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -781,22 +5073,22 @@ type TestConnRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TestConnRequestValidationError) Field() string { return e.field }
+func (e ReviewTabCountValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TestConnRequestValidationError) Reason() string { return e.reason }
+func (e ReviewTabCountValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TestConnRequestValidationError) Cause() error { return e.cause }
+func (e ReviewTabCountValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TestConnRequestValidationError) Key() bool { return e.key }
+func (e ReviewTabCountValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TestConnRequestValidationError) ErrorName() string { return "TestConnRequestValidationError" }
+func (e ReviewTabCountValidationError) ErrorName() string { return "ReviewTabCountValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TestConnRequestValidationError) Error() string {
+func (e ReviewTabCountValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -808,14 +5100,14 @@ func (e TestConnRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTestConnRequest.%s: %s%s",
+		"invalid %sReviewTabCount.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TestConnRequestValidationError{}
+var _ error = ReviewTabCountValidationError{}
 
 var _ interface {
 	Field() string
@@ -823,81 +5115,114 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TestConnRequestValidationError{}
+} = ReviewTabCountValidationError{}
 
-// Validate checks the field values on ReplyReviewRequest with the rules
+// Validate checks the field values on ListReviewBySpuReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReplyReviewRequest) Validate() error {
+func (m *ListReviewBySpuReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReplyReviewRequest with the rules
+// ValidateAll checks the field values on ListReviewBySpuReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReplyReviewRequestMultiError, or nil if none found.
-func (m *ReplyReviewRequest) ValidateAll() error {
+// ListReviewBySpuReplyMultiError, or nil if none found.
+func (m *ListReviewBySpuReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReplyReviewRequest) validate(all bool) error {
+func (m *ListReviewBySpuReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReviewId() <= 0 {
-		err := ReplyReviewRequestValidationError{
-			field:  "ReviewId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
 
-	if m.GetStoreId() <= 0 {
-		err := ReplyReviewRequestValidationError{
-			field:  "StoreId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewBySpuReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewBySpuReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewBySpuReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
-	if l := utf8.RuneCountInString(m.GetContent()); l < 2 || l > 200 {
-		err := ReplyReviewRequestValidationError{
-			field:  "Content",
-			reason: "value length must be between 2 and 200 runes, inclusive",
-		}
-		if !all {
-			return err
+	for idx, item := range m.GetTabs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewBySpuReplyValidationError{
+						field:  fmt.Sprintf("Tabs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewBySpuReplyValidationError{
+						field:  fmt.Sprintf("Tabs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewBySpuReplyValidationError{
+					field:  fmt.Sprintf("Tabs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	// no validation rules for PicInfo
+	}
 
-	// no validation rules for VideoInfo
+	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return ReplyReviewRequestMultiError(errors)
+		return ListReviewBySpuReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReplyReviewRequestMultiError is an error wrapping multiple validation errors
-// returned by ReplyReviewRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ReplyReviewRequestMultiError []error
+// ListReviewBySpuReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReviewBySpuReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewBySpuReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReplyReviewRequestMultiError) Error() string {
+func (m ListReviewBySpuReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -906,11 +5231,11 @@ func (m ReplyReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReplyReviewRequestMultiError) AllErrors() []error { return m }
+func (m ListReviewBySpuReplyMultiError) AllErrors() []error { return m }
 
-// ReplyReviewRequestValidationError is the validation error returned by
-// ReplyReviewRequest.Validate if the designated constraints aren't met.
-type ReplyReviewRequestValidationError struct {
+// ListReviewBySpuReplyValidationError is the validation error returned by
+// ListReviewBySpuReply.Validate if the designated constraints aren't met.
+type ListReviewBySpuReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -918,24 +5243,24 @@ type ReplyReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReplyReviewRequestValidationError) Field() string { return e.field }
+func (e ListReviewBySpuReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReplyReviewRequestValidationError) Reason() string { return e.reason }
+func (e ListReviewBySpuReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReplyReviewRequestValidationError) Cause() error { return e.cause }
+func (e ListReviewBySpuReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReplyReviewRequestValidationError) Key() bool { return e.key }
+func (e ListReviewBySpuReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReplyReviewRequestValidationError) ErrorName() string {
-	return "ReplyReviewRequestValidationError"
+func (e ListReviewBySpuReplyValidationError) ErrorName() string {
+	return "ListReviewBySpuReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReplyReviewRequestValidationError) Error() string {
+func (e ListReviewBySpuReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -947,14 +5272,14 @@ func (e ReplyReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReplyReviewRequest.%s: %s%s",
+		"invalid %sListReviewBySpuReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReplyReviewRequestValidationError{}
+var _ error = ListReviewBySpuReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -962,46 +5287,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReplyReviewRequestValidationError{}
+} = ListReviewBySpuReplyValidationError{}
 
-// Validate checks the field values on ReplyReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ReplyReviewReply) Validate() error {
+// Validate checks the field values on ListReviewByUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewByUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReplyReviewReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListReviewByUserRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReplyReviewReplyMultiError, or nil if none found.
-func (m *ReplyReviewReply) ValidateAll() error {
+// ListReviewByUserRequestMultiError, or nil if none found.
+func (m *ListReviewByUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReplyReviewReply) validate(all bool) error {
+func (m *ListReviewByUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReplyId
+	if m.GetUserId() < 0 {
+		err := ListReviewByUserRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListReviewByUserRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetSize(); val < 0 || val > 50 {
+		err := ListReviewByUserRequestValidationError{
+			field:  "Size",
+			reason: "value must be inside range [0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ReplyReviewReplyMultiError(errors)
+		return ListReviewByUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReplyReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by ReplyReviewReply.ValidateAll() if the designated constraints
-// aren't met.
-type ReplyReviewReplyMultiError []error
-
+// ListReviewByUserRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReviewByUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewByUserRequestMultiError []error
+
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReplyReviewReplyMultiError) Error() string {
+func (m ListReviewByUserRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1010,11 +5366,11 @@ func (m ReplyReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReplyReviewReplyMultiError) AllErrors() []error { return m }
+func (m ListReviewByUserRequestMultiError) AllErrors() []error { return m }
 
-// ReplyReviewReplyValidationError is the validation error returned by
-// ReplyReviewReply.Validate if the designated constraints aren't met.
-type ReplyReviewReplyValidationError struct {
+// ListReviewByUserRequestValidationError is the validation error returned by
+// ListReviewByUserRequest.Validate if the designated constraints aren't met.
+type ListReviewByUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1022,22 +5378,24 @@ type ReplyReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReplyReviewReplyValidationError) Field() string { return e.field }
+func (e ListReviewByUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReplyReviewReplyValidationError) Reason() string { return e.reason }
+func (e ListReviewByUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReplyReviewReplyValidationError) Cause() error { return e.cause }
+func (e ListReviewByUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReplyReviewReplyValidationError) Key() bool { return e.key }
+func (e ListReviewByUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReplyReviewReplyValidationError) ErrorName() string { return "ReplyReviewReplyValidationError" }
+func (e ListReviewByUserRequestValidationError) ErrorName() string {
+	return "ListReviewByUserRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ReplyReviewReplyValidationError) Error() string {
+func (e ListReviewByUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1049,14 +5407,14 @@ func (e ReplyReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReplyReviewReply.%s: %s%s",
+		"invalid %sListReviewByUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReplyReviewReplyValidationError{}
+var _ error = ListReviewByUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1064,46 +5422,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReplyReviewReplyValidationError{}
+} = ListReviewByUserRequestValidationError{}
 
-// Validate checks the field values on TestConnReply with the rules defined in
+// Validate checks the field values on UserReviewItem with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *TestConnReply) Validate() error {
+func (m *UserReviewItem) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TestConnReply with the rules defined
+// ValidateAll checks the field values on UserReviewItem with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TestConnReplyMultiError, or
-// nil if none found.
-func (m *TestConnReply) ValidateAll() error {
+// result is a list of violation errors wrapped in UserReviewItemMultiError,
+// or nil if none found.
+func (m *UserReviewItem) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TestConnReply) validate(all bool) error {
+func (m *UserReviewItem) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Pong
+	if all {
+		switch v := interface{}(m.GetReview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserReviewItemValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserReviewItemValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserReviewItemValidationError{
+				field:  "Review",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReply()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserReviewItemValidationError{
+					field:  "Reply",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserReviewItemValidationError{
+					field:  "Reply",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReply()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserReviewItemValidationError{
+				field:  "Reply",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return TestConnReplyMultiError(errors)
+		return UserReviewItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// TestConnReplyMultiError is an error wrapping multiple validation errors
-// returned by TestConnReply.ValidateAll() if the designated constraints
+// UserReviewItemMultiError is an error wrapping multiple validation errors
+// returned by UserReviewItem.ValidateAll() if the designated constraints
 // aren't met.
-type TestConnReplyMultiError []error
+type UserReviewItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TestConnReplyMultiError) Error() string {
+func (m UserReviewItemMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1112,11 +5526,11 @@ func (m TestConnReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TestConnReplyMultiError) AllErrors() []error { return m }
+func (m UserReviewItemMultiError) AllErrors() []error { return m }
 
-// TestConnReplyValidationError is the validation error returned by
-// TestConnReply.Validate if the designated constraints aren't met.
-type TestConnReplyValidationError struct {
+// UserReviewItemValidationError is the validation error returned by
+// UserReviewItem.Validate if the designated constraints aren't met.
+type UserReviewItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1124,22 +5538,22 @@ type TestConnReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e TestConnReplyValidationError) Field() string { return e.field }
+func (e UserReviewItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TestConnReplyValidationError) Reason() string { return e.reason }
+func (e UserReviewItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TestConnReplyValidationError) Cause() error { return e.cause }
+func (e UserReviewItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TestConnReplyValidationError) Key() bool { return e.key }
+func (e UserReviewItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TestConnReplyValidationError) ErrorName() string { return "TestConnReplyValidationError" }
+func (e UserReviewItemValidationError) ErrorName() string { return "UserReviewItemValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TestConnReplyValidationError) Error() string {
+func (e UserReviewItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1151,14 +5565,14 @@ func (e TestConnReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTestConnReply.%s: %s%s",
+		"invalid %sUserReviewItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TestConnReplyValidationError{}
+var _ error = UserReviewItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -1166,85 +5580,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TestConnReplyValidationError{}
+} = UserReviewItemValidationError{}
 
-// Validate checks the field values on AppealReviewRequest with the rules
+// Validate checks the field values on ListReviewByUserReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AppealReviewRequest) Validate() error {
+func (m *ListReviewByUserReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AppealReviewRequest with the rules
+// ValidateAll checks the field values on ListReviewByUserReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AppealReviewRequestMultiError, or nil if none found.
-func (m *AppealReviewRequest) ValidateAll() error {
+// ListReviewByUserReplyMultiError, or nil if none found.
+func (m *ListReviewByUserReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AppealReviewRequest) validate(all bool) error {
+func (m *ListReviewByUserReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReviewId() <= 0 {
-		err := AppealReviewRequestValidationError{
-			field:  "ReviewId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
 
-	if m.GetStoreId() <= 0 {
-		err := AppealReviewRequestValidationError{
-			field:  "StoreId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewByUserReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewByUserReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewByUserReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if l := utf8.RuneCountInString(m.GetContent()); l < 2 || l > 200 {
-		err := AppealReviewRequestValidationError{
-			field:  "Content",
-			reason: "value length must be between 2 and 200 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for PicInfo
-
-	// no validation rules for VideoInfo
-
-	// no validation rules for OpUser
-
-	// no validation rules for Reason
+	// no validation rules for Total
 
 	if len(errors) > 0 {
-		return AppealReviewRequestMultiError(errors)
+		return ListReviewByUserReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AppealReviewRequestMultiError is an error wrapping multiple validation
-// errors returned by AppealReviewRequest.ValidateAll() if the designated
+// ListReviewByUserReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReviewByUserReply.ValidateAll() if the designated
 // constraints aren't met.
-type AppealReviewRequestMultiError []error
+type ListReviewByUserReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AppealReviewRequestMultiError) Error() string {
+func (m ListReviewByUserReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1253,11 +5662,11 @@ func (m AppealReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AppealReviewRequestMultiError) AllErrors() []error { return m }
+func (m ListReviewByUserReplyMultiError) AllErrors() []error { return m }
 
-// AppealReviewRequestValidationError is the validation error returned by
-// AppealReviewRequest.Validate if the designated constraints aren't met.
-type AppealReviewRequestValidationError struct {
+// ListReviewByUserReplyValidationError is the validation error returned by
+// ListReviewByUserReply.Validate if the designated constraints aren't met.
+type ListReviewByUserReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1265,24 +5674,24 @@ type AppealReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppealReviewRequestValidationError) Field() string { return e.field }
+func (e ListReviewByUserReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppealReviewRequestValidationError) Reason() string { return e.reason }
+func (e ListReviewByUserReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppealReviewRequestValidationError) Cause() error { return e.cause }
+func (e ListReviewByUserReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppealReviewRequestValidationError) Key() bool { return e.key }
+func (e ListReviewByUserReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppealReviewRequestValidationError) ErrorName() string {
-	return "AppealReviewRequestValidationError"
+func (e ListReviewByUserReplyValidationError) ErrorName() string {
+	return "ListReviewByUserReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AppealReviewRequestValidationError) Error() string {
+func (e ListReviewByUserReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1294,14 +5703,14 @@ func (e AppealReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAppealReviewRequest.%s: %s%s",
+		"invalid %sListReviewByUserReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppealReviewRequestValidationError{}
+var _ error = ListReviewByUserReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1309,46 +5718,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppealReviewRequestValidationError{}
+} = ListReviewByUserReplyValidationError{}
 
-// Validate checks the field values on AppealReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AppealReviewReply) Validate() error {
+// Validate checks the field values on UploadFile with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UploadFile) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AppealReviewReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AppealReviewReplyMultiError, or nil if none found.
-func (m *AppealReviewReply) ValidateAll() error {
+// ValidateAll checks the field values on UploadFile with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadFileMultiError, or
+// nil if none found.
+func (m *UploadFile) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AppealReviewReply) validate(all bool) error {
+func (m *UploadFile) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AppealId
+	if _, ok := _UploadFile_Type_InLookup[m.GetType()]; !ok {
+		err := UploadFileValidationError{
+			field:  "Type",
+			reason: "value must be in list [image video]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContentType()); l < 1 || l > 128 {
+		err := UploadFileValidationError{
+			field:  "ContentType",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := UploadFileValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return AppealReviewReplyMultiError(errors)
+		return UploadFileMultiError(errors)
 	}
 
 	return nil
 }
 
-// AppealReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by AppealReviewReply.ValidateAll() if the designated constraints
-// aren't met.
-type AppealReviewReplyMultiError []error
+// UploadFileMultiError is an error wrapping multiple validation errors
+// returned by UploadFile.ValidateAll() if the designated constraints aren't met.
+type UploadFileMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AppealReviewReplyMultiError) Error() string {
+func (m UploadFileMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1357,11 +5796,11 @@ func (m AppealReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AppealReviewReplyMultiError) AllErrors() []error { return m }
+func (m UploadFileMultiError) AllErrors() []error { return m }
 
-// AppealReviewReplyValidationError is the validation error returned by
-// AppealReviewReply.Validate if the designated constraints aren't met.
-type AppealReviewReplyValidationError struct {
+// UploadFileValidationError is the validation error returned by
+// UploadFile.Validate if the designated constraints aren't met.
+type UploadFileValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1369,24 +5808,22 @@ type AppealReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppealReviewReplyValidationError) Field() string { return e.field }
+func (e UploadFileValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppealReviewReplyValidationError) Reason() string { return e.reason }
+func (e UploadFileValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppealReviewReplyValidationError) Cause() error { return e.cause }
+func (e UploadFileValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppealReviewReplyValidationError) Key() bool { return e.key }
+func (e UploadFileValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppealReviewReplyValidationError) ErrorName() string {
-	return "AppealReviewReplyValidationError"
-}
+func (e UploadFileValidationError) ErrorName() string { return "UploadFileValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AppealReviewReplyValidationError) Error() string {
+func (e UploadFileValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1398,14 +5835,14 @@ func (e AppealReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAppealReviewReply.%s: %s%s",
+		"invalid %sUploadFile.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppealReviewReplyValidationError{}
+var _ error = UploadFileValidationError{}
 
 var _ interface {
 	Field() string
@@ -1413,67 +5850,39 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppealReviewReplyValidationError{}
+} = UploadFileValidationError{}
 
-// Validate checks the field values on AuditAppealRequest with the rules
+var _UploadFile_Type_InLookup = map[string]struct{}{
+	"image": {},
+	"video": {},
+}
+
+// Validate checks the field values on GetUploadTokenRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuditAppealRequest) Validate() error {
+func (m *GetUploadTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuditAppealRequest with the rules
+// ValidateAll checks the field values on GetUploadTokenRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuditAppealRequestMultiError, or nil if none found.
-func (m *AuditAppealRequest) ValidateAll() error {
+// GetUploadTokenRequestMultiError, or nil if none found.
+func (m *GetUploadTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
-
-func (m *AuditAppealRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if m.GetAppealId() <= 0 {
-		err := AuditAppealRequestValidationError{
-			field:  "AppealId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetReviewId() <= 0 {
-		err := AuditAppealRequestValidationError{
-			field:  "ReviewId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetStatus() <= 0 {
-		err := AuditAppealRequestValidationError{
-			field:  "Status",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+
+func (m *GetUploadTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
-		err := AuditAppealRequestValidationError{
-			field:  "OpUser",
-			reason: "value length must be at least 2 runes",
+	var errors []error
+
+	if l := len(m.GetFiles()); l < 1 || l > 10 {
+		err := GetUploadTokenRequestValidationError{
+			field:  "Files",
+			reason: "value must contain between 1 and 10 items, inclusive",
 		}
 		if !all {
 			return err
@@ -1481,35 +5890,54 @@ func (m *AuditAppealRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetOpReason()) < 2 {
-		err := AuditAppealRequestValidationError{
-			field:  "OpReason",
-			reason: "value length must be at least 2 runes",
-		}
-		if !all {
-			return err
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUploadTokenRequestValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUploadTokenRequestValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUploadTokenRequestValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if m.OpRemarks != nil {
-		// no validation rules for OpRemarks
 	}
 
 	if len(errors) > 0 {
-		return AuditAppealRequestMultiError(errors)
+		return GetUploadTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuditAppealRequestMultiError is an error wrapping multiple validation errors
-// returned by AuditAppealRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AuditAppealRequestMultiError []error
+// GetUploadTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUploadTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUploadTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuditAppealRequestMultiError) Error() string {
+func (m GetUploadTokenRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1518,11 +5946,11 @@ func (m AuditAppealRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuditAppealRequestMultiError) AllErrors() []error { return m }
+func (m GetUploadTokenRequestMultiError) AllErrors() []error { return m }
 
-// AuditAppealRequestValidationError is the validation error returned by
-// AuditAppealRequest.Validate if the designated constraints aren't met.
-type AuditAppealRequestValidationError struct {
+// GetUploadTokenRequestValidationError is the validation error returned by
+// GetUploadTokenRequest.Validate if the designated constraints aren't met.
+type GetUploadTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1530,24 +5958,24 @@ type AuditAppealRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuditAppealRequestValidationError) Field() string { return e.field }
+func (e GetUploadTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuditAppealRequestValidationError) Reason() string { return e.reason }
+func (e GetUploadTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuditAppealRequestValidationError) Cause() error { return e.cause }
+func (e GetUploadTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuditAppealRequestValidationError) Key() bool { return e.key }
+func (e GetUploadTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuditAppealRequestValidationError) ErrorName() string {
-	return "AuditAppealRequestValidationError"
+func (e GetUploadTokenRequestValidationError) ErrorName() string {
+	return "GetUploadTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuditAppealRequestValidationError) Error() string {
+func (e GetUploadTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1559,14 +5987,14 @@ func (e AuditAppealRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuditAppealRequest.%s: %s%s",
+		"invalid %sGetUploadTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuditAppealRequestValidationError{}
+var _ error = GetUploadTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1574,44 +6002,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuditAppealRequestValidationError{}
+} = GetUploadTokenRequestValidationError{}
 
-// Validate checks the field values on AuditAppealReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AuditAppealReply) Validate() error {
+// Validate checks the field values on UploadToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UploadToken) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuditAppealReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AuditAppealReplyMultiError, or nil if none found.
-func (m *AuditAppealReply) ValidateAll() error {
+// ValidateAll checks the field values on UploadToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadTokenMultiError, or
+// nil if none found.
+func (m *UploadToken) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuditAppealReply) validate(all bool) error {
+func (m *UploadToken) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Key
+
+	// no validation rules for UploadUrl
+
+	// no validation rules for Method
+
+	// no validation rules for Headers
+
+	// no validation rules for Url
+
+	// no validation rules for ExpireAt
+
 	if len(errors) > 0 {
-		return AuditAppealReplyMultiError(errors)
+		return UploadTokenMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuditAppealReplyMultiError is an error wrapping multiple validation errors
-// returned by AuditAppealReply.ValidateAll() if the designated constraints
-// aren't met.
-type AuditAppealReplyMultiError []error
+// UploadTokenMultiError is an error wrapping multiple validation errors
+// returned by UploadToken.ValidateAll() if the designated constraints aren't met.
+type UploadTokenMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuditAppealReplyMultiError) Error() string {
+func (m UploadTokenMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1620,11 +6059,11 @@ func (m AuditAppealReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuditAppealReplyMultiError) AllErrors() []error { return m }
+func (m UploadTokenMultiError) AllErrors() []error { return m }
 
-// AuditAppealReplyValidationError is the validation error returned by
-// AuditAppealReply.Validate if the designated constraints aren't met.
-type AuditAppealReplyValidationError struct {
+// UploadTokenValidationError is the validation error returned by
+// UploadToken.Validate if the designated constraints aren't met.
+type UploadTokenValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1632,22 +6071,22 @@ type AuditAppealReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuditAppealReplyValidationError) Field() string { return e.field }
+func (e UploadTokenValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuditAppealReplyValidationError) Reason() string { return e.reason }
+func (e UploadTokenValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuditAppealReplyValidationError) Cause() error { return e.cause }
+func (e UploadTokenValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuditAppealReplyValidationError) Key() bool { return e.key }
+func (e UploadTokenValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuditAppealReplyValidationError) ErrorName() string { return "AuditAppealReplyValidationError" }
+func (e UploadTokenValidationError) ErrorName() string { return "UploadTokenValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AuditAppealReplyValidationError) Error() string {
+func (e UploadTokenValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1659,14 +6098,14 @@ func (e AuditAppealReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuditAppealReply.%s: %s%s",
+		"invalid %sUploadToken.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuditAppealReplyValidationError{}
+var _ error = UploadTokenValidationError{}
 
 var _ interface {
 	Field() string
@@ -1674,44 +6113,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuditAppealReplyValidationError{}
+} = UploadTokenValidationError{}
 
-// Validate checks the field values on UpdateReviewRequest with the rules
+// Validate checks the field values on GetUploadTokenReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateReviewRequest) Validate() error {
+func (m *GetUploadTokenReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateReviewRequest with the rules
+// ValidateAll checks the field values on GetUploadTokenReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateReviewRequestMultiError, or nil if none found.
-func (m *UpdateReviewRequest) ValidateAll() error {
+// GetUploadTokenReplyMultiError, or nil if none found.
+func (m *GetUploadTokenReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateReviewRequest) validate(all bool) error {
+func (m *GetUploadTokenReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUploadTokenReplyValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUploadTokenReplyValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUploadTokenReplyValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return UpdateReviewRequestMultiError(errors)
+		return GetUploadTokenReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateReviewRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateReviewRequest.ValidateAll() if the designated
+// GetUploadTokenReplyMultiError is an error wrapping multiple validation
+// errors returned by GetUploadTokenReply.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateReviewRequestMultiError []error
+type GetUploadTokenReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateReviewRequestMultiError) Error() string {
+func (m GetUploadTokenReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1720,11 +6193,11 @@ func (m UpdateReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateReviewRequestMultiError) AllErrors() []error { return m }
+func (m GetUploadTokenReplyMultiError) AllErrors() []error { return m }
 
-// UpdateReviewRequestValidationError is the validation error returned by
-// UpdateReviewRequest.Validate if the designated constraints aren't met.
-type UpdateReviewRequestValidationError struct {
+// GetUploadTokenReplyValidationError is the validation error returned by
+// GetUploadTokenReply.Validate if the designated constraints aren't met.
+type GetUploadTokenReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1732,24 +6205,24 @@ type UpdateReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateReviewRequestValidationError) Field() string { return e.field }
+func (e GetUploadTokenReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateReviewRequestValidationError) Reason() string { return e.reason }
+func (e GetUploadTokenReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateReviewRequestValidationError) Cause() error { return e.cause }
+func (e GetUploadTokenReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateReviewRequestValidationError) Key() bool { return e.key }
+func (e GetUploadTokenReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateReviewRequestValidationError) ErrorName() string {
-	return "UpdateReviewRequestValidationError"
+func (e GetUploadTokenReplyValidationError) ErrorName() string {
+	return "GetUploadTokenReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateReviewRequestValidationError) Error() string {
+func (e GetUploadTokenReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1761,14 +6234,14 @@ func (e UpdateReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateReviewRequest.%s: %s%s",
+		"invalid %sGetUploadTokenReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateReviewRequestValidationError{}
+var _ error = GetUploadTokenReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1776,44 +6249,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateReviewRequestValidationError{}
+} = GetUploadTokenReplyValidationError{}
 
-// Validate checks the field values on UpdateReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UpdateReviewReply) Validate() error {
+// Validate checks the field values on GetTagCloudRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTagCloudRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateReviewReply with the rules
+// ValidateAll checks the field values on GetTagCloudRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateReviewReplyMultiError, or nil if none found.
-func (m *UpdateReviewReply) ValidateAll() error {
+// GetTagCloudRequestMultiError, or nil if none found.
+func (m *GetTagCloudRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateReviewReply) validate(all bool) error {
+func (m *GetTagCloudRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetStoreId() < 0 {
+		err := GetTagCloudRequestValidationError{
+			field:  "StoreId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSpuId() < 0 {
+		err := GetTagCloudRequestValidationError{
+			field:  "SpuId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetSize(); val < 0 || val > 50 {
+		err := GetTagCloudRequestValidationError{
+			field:  "Size",
+			reason: "value must be inside range [0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return UpdateReviewReplyMultiError(errors)
+		return GetTagCloudRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by UpdateReviewReply.ValidateAll() if the designated constraints
+// GetTagCloudRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTagCloudRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UpdateReviewReplyMultiError []error
+type GetTagCloudRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateReviewReplyMultiError) Error() string {
+func (m GetTagCloudRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1822,11 +6328,11 @@ func (m UpdateReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateReviewReplyMultiError) AllErrors() []error { return m }
+func (m GetTagCloudRequestMultiError) AllErrors() []error { return m }
 
-// UpdateReviewReplyValidationError is the validation error returned by
-// UpdateReviewReply.Validate if the designated constraints aren't met.
-type UpdateReviewReplyValidationError struct {
+// GetTagCloudRequestValidationError is the validation error returned by
+// GetTagCloudRequest.Validate if the designated constraints aren't met.
+type GetTagCloudRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1834,24 +6340,24 @@ type UpdateReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateReviewReplyValidationError) Field() string { return e.field }
+func (e GetTagCloudRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateReviewReplyValidationError) Reason() string { return e.reason }
+func (e GetTagCloudRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateReviewReplyValidationError) Cause() error { return e.cause }
+func (e GetTagCloudRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateReviewReplyValidationError) Key() bool { return e.key }
+func (e GetTagCloudRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateReviewReplyValidationError) ErrorName() string {
-	return "UpdateReviewReplyValidationError"
+func (e GetTagCloudRequestValidationError) ErrorName() string {
+	return "GetTagCloudRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateReviewReplyValidationError) Error() string {
+func (e GetTagCloudRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1863,14 +6369,14 @@ func (e UpdateReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateReviewReply.%s: %s%s",
+		"invalid %sGetTagCloudRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateReviewReplyValidationError{}
+var _ error = GetTagCloudRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1878,44 +6384,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateReviewReplyValidationError{}
+} = GetTagCloudRequestValidationError{}
 
-// Validate checks the field values on DeleteReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReviewRequest) Validate() error {
+// Validate checks the field values on TagCount with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TagCount) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteReviewRequestMultiError, or nil if none found.
-func (m *DeleteReviewRequest) ValidateAll() error {
+// ValidateAll checks the field values on TagCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TagCountMultiError, or nil
+// if none found.
+func (m *TagCount) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReviewRequest) validate(all bool) error {
+func (m *TagCount) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Tag
+
+	// no validation rules for Count
+
 	if len(errors) > 0 {
-		return DeleteReviewRequestMultiError(errors)
+		return TagCountMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReviewRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteReviewRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteReviewRequestMultiError []error
+// TagCountMultiError is an error wrapping multiple validation errors returned
+// by TagCount.ValidateAll() if the designated constraints aren't met.
+type TagCountMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReviewRequestMultiError) Error() string {
+func (m TagCountMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1924,11 +6433,11 @@ func (m DeleteReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReviewRequestMultiError) AllErrors() []error { return m }
+func (m TagCountMultiError) AllErrors() []error { return m }
 
-// DeleteReviewRequestValidationError is the validation error returned by
-// DeleteReviewRequest.Validate if the designated constraints aren't met.
-type DeleteReviewRequestValidationError struct {
+// TagCountValidationError is the validation error returned by
+// TagCount.Validate if the designated constraints aren't met.
+type TagCountValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1936,24 +6445,22 @@ type DeleteReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReviewRequestValidationError) Field() string { return e.field }
+func (e TagCountValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReviewRequestValidationError) Reason() string { return e.reason }
+func (e TagCountValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReviewRequestValidationError) Cause() error { return e.cause }
+func (e TagCountValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReviewRequestValidationError) Key() bool { return e.key }
+func (e TagCountValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReviewRequestValidationError) ErrorName() string {
-	return "DeleteReviewRequestValidationError"
-}
+func (e TagCountValidationError) ErrorName() string { return "TagCountValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteReviewRequestValidationError) Error() string {
+func (e TagCountValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1965,14 +6472,14 @@ func (e DeleteReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReviewRequest.%s: %s%s",
+		"invalid %sTagCount.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReviewRequestValidationError{}
+var _ error = TagCountValidationError{}
 
 var _ interface {
 	Field() string
@@ -1980,44 +6487,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReviewRequestValidationError{}
+} = TagCountValidationError{}
 
-// Validate checks the field values on DeleteReviewReply with the rules defined
+// Validate checks the field values on GetTagCloudReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReviewReply) Validate() error {
+func (m *GetTagCloudReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReviewReply with the rules
+// ValidateAll checks the field values on GetTagCloudReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteReviewReplyMultiError, or nil if none found.
-func (m *DeleteReviewReply) ValidateAll() error {
+// GetTagCloudReplyMultiError, or nil if none found.
+func (m *GetTagCloudReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReviewReply) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *GetTagCloudReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetTagCloudReplyValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetTagCloudReplyValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetTagCloudReplyValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
-
 	if len(errors) > 0 {
-		return DeleteReviewReplyMultiError(errors)
+		return GetTagCloudReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by DeleteReviewReply.ValidateAll() if the designated constraints
+// GetTagCloudReplyMultiError is an error wrapping multiple validation errors
+// returned by GetTagCloudReply.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteReviewReplyMultiError []error
+type GetTagCloudReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReviewReplyMultiError) Error() string {
+func (m GetTagCloudReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2026,11 +6567,11 @@ func (m DeleteReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReviewReplyMultiError) AllErrors() []error { return m }
+func (m GetTagCloudReplyMultiError) AllErrors() []error { return m }
 
-// DeleteReviewReplyValidationError is the validation error returned by
-// DeleteReviewReply.Validate if the designated constraints aren't met.
-type DeleteReviewReplyValidationError struct {
+// GetTagCloudReplyValidationError is the validation error returned by
+// GetTagCloudReply.Validate if the designated constraints aren't met.
+type GetTagCloudReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2038,24 +6579,22 @@ type DeleteReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReviewReplyValidationError) Field() string { return e.field }
+func (e GetTagCloudReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReviewReplyValidationError) Reason() string { return e.reason }
+func (e GetTagCloudReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReviewReplyValidationError) Cause() error { return e.cause }
+func (e GetTagCloudReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReviewReplyValidationError) Key() bool { return e.key }
+func (e GetTagCloudReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReviewReplyValidationError) ErrorName() string {
-	return "DeleteReviewReplyValidationError"
-}
+func (e GetTagCloudReplyValidationError) ErrorName() string { return "GetTagCloudReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteReviewReplyValidationError) Error() string {
+func (e GetTagCloudReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2067,14 +6606,14 @@ func (e DeleteReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReviewReply.%s: %s%s",
+		"invalid %sGetTagCloudReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReviewReplyValidationError{}
+var _ error = GetTagCloudReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2082,44 +6621,111 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReviewReplyValidationError{}
+} = GetTagCloudReplyValidationError{}
 
-// Validate checks the field values on GetReviewRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetReviewRequest) Validate() error {
+// Validate checks the field values on AppendReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AppendReviewRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReviewRequest with the rules
+// ValidateAll checks the field values on AppendReviewRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReviewRequestMultiError, or nil if none found.
-func (m *GetReviewRequest) ValidateAll() error {
+// AppendReviewRequestMultiError, or nil if none found.
+func (m *AppendReviewRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReviewRequest) validate(all bool) error {
+func (m *AppendReviewRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetReviewId() <= 0 {
+		err := AppendReviewRequestValidationError{
+			field:  "ReviewId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 8 || l > 255 {
+		err := AppendReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 8 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMedia()) > 10 {
+		err := AppendReviewRequestValidationError{
+			field:  "Media",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AppendReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AppendReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppendReviewRequestValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return GetReviewRequestMultiError(errors)
+		return AppendReviewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReviewRequestMultiError is an error wrapping multiple validation errors
-// returned by GetReviewRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetReviewRequestMultiError []error
+// AppendReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by AppendReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AppendReviewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReviewRequestMultiError) Error() string {
+func (m AppendReviewRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2128,11 +6734,11 @@ func (m GetReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReviewRequestMultiError) AllErrors() []error { return m }
+func (m AppendReviewRequestMultiError) AllErrors() []error { return m }
 
-// GetReviewRequestValidationError is the validation error returned by
-// GetReviewRequest.Validate if the designated constraints aren't met.
-type GetReviewRequestValidationError struct {
+// AppendReviewRequestValidationError is the validation error returned by
+// AppendReviewRequest.Validate if the designated constraints aren't met.
+type AppendReviewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2140,22 +6746,24 @@ type GetReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReviewRequestValidationError) Field() string { return e.field }
+func (e AppendReviewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReviewRequestValidationError) Reason() string { return e.reason }
+func (e AppendReviewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReviewRequestValidationError) Cause() error { return e.cause }
+func (e AppendReviewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReviewRequestValidationError) Key() bool { return e.key }
+func (e AppendReviewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReviewRequestValidationError) ErrorName() string { return "GetReviewRequestValidationError" }
+func (e AppendReviewRequestValidationError) ErrorName() string {
+	return "AppendReviewRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetReviewRequestValidationError) Error() string {
+func (e AppendReviewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2167,14 +6775,14 @@ func (e GetReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReviewRequest.%s: %s%s",
+		"invalid %sAppendReviewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReviewRequestValidationError{}
+var _ error = AppendReviewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2182,44 +6790,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReviewRequestValidationError{}
+} = AppendReviewRequestValidationError{}
 
-// Validate checks the field values on GetReviewReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetReviewReply) Validate() error {
+// Validate checks the field values on AppendReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AppendReviewReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetReviewReplyMultiError,
-// or nil if none found.
-func (m *GetReviewReply) ValidateAll() error {
+// ValidateAll checks the field values on AppendReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppendReviewReplyMultiError, or nil if none found.
+func (m *AppendReviewReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReviewReply) validate(all bool) error {
+func (m *AppendReviewReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for AppendId
+
 	if len(errors) > 0 {
-		return GetReviewReplyMultiError(errors)
+		return AppendReviewReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by GetReviewReply.ValidateAll() if the designated constraints
+// AppendReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by AppendReviewReply.ValidateAll() if the designated constraints
 // aren't met.
-type GetReviewReplyMultiError []error
+type AppendReviewReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReviewReplyMultiError) Error() string {
+func (m AppendReviewReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2228,11 +6838,11 @@ func (m GetReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReviewReplyMultiError) AllErrors() []error { return m }
+func (m AppendReviewReplyMultiError) AllErrors() []error { return m }
 
-// GetReviewReplyValidationError is the validation error returned by
-// GetReviewReply.Validate if the designated constraints aren't met.
-type GetReviewReplyValidationError struct {
+// AppendReviewReplyValidationError is the validation error returned by
+// AppendReviewReply.Validate if the designated constraints aren't met.
+type AppendReviewReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2240,22 +6850,24 @@ type GetReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReviewReplyValidationError) Field() string { return e.field }
+func (e AppendReviewReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReviewReplyValidationError) Reason() string { return e.reason }
+func (e AppendReviewReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReviewReplyValidationError) Cause() error { return e.cause }
+func (e AppendReviewReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReviewReplyValidationError) Key() bool { return e.key }
+func (e AppendReviewReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReviewReplyValidationError) ErrorName() string { return "GetReviewReplyValidationError" }
+func (e AppendReviewReplyValidationError) ErrorName() string {
+	return "AppendReviewReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetReviewReplyValidationError) Error() string {
+func (e AppendReviewReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2267,14 +6879,14 @@ func (e GetReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReviewReply.%s: %s%s",
+		"invalid %sAppendReviewReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReviewReplyValidationError{}
+var _ error = AppendReviewReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2282,44 +6894,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReviewReplyValidationError{}
+} = AppendReviewReplyValidationError{}
 
-// Validate checks the field values on ListReviewRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListReviewRequest) Validate() error {
+// Validate checks the field values on AuditAppendReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditAppendReviewRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AuditAppendReviewRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListReviewRequestMultiError, or nil if none found.
-func (m *ListReviewRequest) ValidateAll() error {
+// AuditAppendReviewRequestMultiError, or nil if none found.
+func (m *AuditAppendReviewRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListReviewRequest) validate(all bool) error {
+func (m *AuditAppendReviewRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetReviewId() <= 0 {
+		err := AuditAppendReviewRequestValidationError{
+			field:  "ReviewId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AuditAppendReviewRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := AuditAppendReviewRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [20 30]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OpReason
+
+	if m.OpRemarks != nil {
+		// no validation rules for OpRemarks
+	}
+
 	if len(errors) > 0 {
-		return ListReviewRequestMultiError(errors)
+		return AuditAppendReviewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListReviewRequestMultiError is an error wrapping multiple validation errors
-// returned by ListReviewRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListReviewRequestMultiError []error
+// AuditAppendReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by AuditAppendReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuditAppendReviewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListReviewRequestMultiError) Error() string {
+func (m AuditAppendReviewRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2328,11 +6968,11 @@ func (m ListReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListReviewRequestMultiError) AllErrors() []error { return m }
+func (m AuditAppendReviewRequestMultiError) AllErrors() []error { return m }
 
-// ListReviewRequestValidationError is the validation error returned by
-// ListReviewRequest.Validate if the designated constraints aren't met.
-type ListReviewRequestValidationError struct {
+// AuditAppendReviewRequestValidationError is the validation error returned by
+// AuditAppendReviewRequest.Validate if the designated constraints aren't met.
+type AuditAppendReviewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2340,24 +6980,24 @@ type ListReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReviewRequestValidationError) Field() string { return e.field }
+func (e AuditAppendReviewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReviewRequestValidationError) Reason() string { return e.reason }
+func (e AuditAppendReviewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReviewRequestValidationError) Cause() error { return e.cause }
+func (e AuditAppendReviewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReviewRequestValidationError) Key() bool { return e.key }
+func (e AuditAppendReviewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReviewRequestValidationError) ErrorName() string {
-	return "ListReviewRequestValidationError"
+func (e AuditAppendReviewRequestValidationError) ErrorName() string {
+	return "AuditAppendReviewRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListReviewRequestValidationError) Error() string {
+func (e AuditAppendReviewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2369,14 +7009,14 @@ func (e ListReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReviewRequest.%s: %s%s",
+		"invalid %sAuditAppendReviewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReviewRequestValidationError{}
+var _ error = AuditAppendReviewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2384,44 +7024,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReviewRequestValidationError{}
+} = AuditAppendReviewRequestValidationError{}
 
-// Validate checks the field values on ListReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListReviewReply) Validate() error {
+var _AuditAppendReviewRequest_Status_InLookup = map[int32]struct{}{
+	20: {},
+	30: {},
+}
+
+// Validate checks the field values on AuditAppendReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditAppendReviewReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListReviewReply with the rules
+// ValidateAll checks the field values on AuditAppendReviewReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListReviewReplyMultiError, or nil if none found.
-func (m *ListReviewReply) ValidateAll() error {
+// AuditAppendReviewReplyMultiError, or nil if none found.
+func (m *AuditAppendReviewReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListReviewReply) validate(all bool) error {
+func (m *AuditAppendReviewReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for AppendId
+
+	// no validation rules for Status
+
 	if len(errors) > 0 {
-		return ListReviewReplyMultiError(errors)
+		return AuditAppendReviewReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by ListReviewReply.ValidateAll() if the designated constraints
-// aren't met.
-type ListReviewReplyMultiError []error
+// AuditAppendReviewReplyMultiError is an error wrapping multiple validation
+// errors returned by AuditAppendReviewReply.ValidateAll() if the designated
+// constraints aren't met.
+type AuditAppendReviewReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListReviewReplyMultiError) Error() string {
+func (m AuditAppendReviewReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2430,11 +7079,11 @@ func (m ListReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListReviewReplyMultiError) AllErrors() []error { return m }
+func (m AuditAppendReviewReplyMultiError) AllErrors() []error { return m }
 
-// ListReviewReplyValidationError is the validation error returned by
-// ListReviewReply.Validate if the designated constraints aren't met.
-type ListReviewReplyValidationError struct {
+// AuditAppendReviewReplyValidationError is the validation error returned by
+// AuditAppendReviewReply.Validate if the designated constraints aren't met.
+type AuditAppendReviewReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2442,22 +7091,24 @@ type ListReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReviewReplyValidationError) Field() string { return e.field }
+func (e AuditAppendReviewReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReviewReplyValidationError) Reason() string { return e.reason }
+func (e AuditAppendReviewReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReviewReplyValidationError) Cause() error { return e.cause }
+func (e AuditAppendReviewReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReviewReplyValidationError) Key() bool { return e.key }
+func (e AuditAppendReviewReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReviewReplyValidationError) ErrorName() string { return "ListReviewReplyValidationError" }
+func (e AuditAppendReviewReplyValidationError) ErrorName() string {
+	return "AuditAppendReviewReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListReviewReplyValidationError) Error() string {
+func (e AuditAppendReviewReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2469,14 +7120,14 @@ func (e ListReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReviewReply.%s: %s%s",
+		"invalid %sAuditAppendReviewReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReviewReplyValidationError{}
+var _ error = AuditAppendReviewReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2484,4 +7135,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReviewReplyValidationError{}
+} = AuditAppendReviewReplyValidationError{}
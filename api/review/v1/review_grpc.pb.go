@@ -19,16 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Review_CreateReview_FullMethodName        = "/api.review.v1.Review/CreateReview"
-	Review_TestConn_FullMethodName            = "/api.review.v1.Review/TestConn"
-	Review_ReplyReview_FullMethodName         = "/api.review.v1.Review/ReplyReview"
-	Review_AppealReview_FullMethodName        = "/api.review.v1.Review/AppealReview"
-	Review_AuditAppeal_FullMethodName         = "/api.review.v1.Review/AuditAppeal"
-	Review_ListReviewByStoreId_FullMethodName = "/api.review.v1.Review/ListReviewByStoreId"
-	Review_UpdateReview_FullMethodName        = "/api.review.v1.Review/UpdateReview"
-	Review_DeleteReview_FullMethodName        = "/api.review.v1.Review/DeleteReview"
-	Review_GetReview_FullMethodName           = "/api.review.v1.Review/GetReview"
-	Review_ListReview_FullMethodName          = "/api.review.v1.Review/ListReview"
+	Review_CreateReview_FullMethodName          = "/api.review.v1.Review/CreateReview"
+	Review_TestConn_FullMethodName              = "/api.review.v1.Review/TestConn"
+	Review_ReplyReview_FullMethodName           = "/api.review.v1.Review/ReplyReview"
+	Review_AppealReview_FullMethodName          = "/api.review.v1.Review/AppealReview"
+	Review_AuditReview_FullMethodName           = "/api.review.v1.Review/AuditReview"
+	Review_AuditAppeal_FullMethodName           = "/api.review.v1.Review/AuditAppeal"
+	Review_ListReviewByStoreId_FullMethodName   = "/api.review.v1.Review/ListReviewByStoreId"
+	Review_UpdateReview_FullMethodName          = "/api.review.v1.Review/UpdateReview"
+	Review_DeleteReview_FullMethodName          = "/api.review.v1.Review/DeleteReview"
+	Review_GetReview_FullMethodName             = "/api.review.v1.Review/GetReview"
+	Review_ListReview_FullMethodName            = "/api.review.v1.Review/ListReview"
+	Review_SearchReviews_FullMethodName         = "/api.review.v1.Review/SearchReviews"
+	Review_GetStoreRatingSummary_FullMethodName = "/api.review.v1.Review/GetStoreRatingSummary"
+	Review_ListReviewBySpu_FullMethodName       = "/api.review.v1.Review/ListReviewBySpu"
+	Review_ListReviewByUser_FullMethodName      = "/api.review.v1.Review/ListReviewByUser"
+	Review_GetUploadToken_FullMethodName        = "/api.review.v1.Review/GetUploadToken"
+	Review_GetTagCloud_FullMethodName           = "/api.review.v1.Review/GetTagCloud"
+	Review_AppendReview_FullMethodName          = "/api.review.v1.Review/AppendReview"
+	Review_AuditAppendReview_FullMethodName     = "/api.review.v1.Review/AuditAppendReview"
 )
 
 // ReviewClient is the client API for Review service.
@@ -44,13 +53,35 @@ type ReviewClient interface {
 	ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...grpc.CallOption) (*ReplyReviewReply, error)
 	// 商家申述评价
 	AppealReview(ctx context.Context, in *AppealReviewRequest, opts ...grpc.CallOption) (*AppealReviewReply, error)
+	// O端运营审核评价
+	AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...grpc.CallOption) (*AuditReviewReply, error)
 	AuditAppeal(ctx context.Context, in *AuditAppealRequest, opts ...grpc.CallOption) (*AuditAppealReply, error)
 	// 根据商家Id查询评价列表(分页)
 	ListReviewByStoreId(ctx context.Context, in *ListReviewByStoreIdRequest, opts ...grpc.CallOption) (*ListReviewByStoreIdReply, error)
+	// C端用户修改评价
 	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*UpdateReviewReply, error)
+	// 删除评价(用户删除自己的评价或运营删除)
 	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewReply, error)
+	// 根据评价Id查询评价详情(包含商家回复和申诉)
 	GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewReply, error)
+	// 评价列表(多条件筛选,游标分页)
 	ListReview(ctx context.Context, in *ListReviewRequest, opts ...grpc.CallOption) (*ListReviewReply, error)
+	// 关键词搜索评价(评价内容、标签、运营备注),返回高亮片段
+	SearchReviews(ctx context.Context, in *SearchReviewsRequest, opts ...grpc.CallOption) (*SearchReviewsReply, error)
+	// 店铺评分汇总(平均分、星级分布、带图数、回复率)
+	GetStoreRatingSummary(ctx context.Context, in *GetStoreRatingSummaryRequest, opts ...grpc.CallOption) (*GetStoreRatingSummaryReply, error)
+	// 商品详情页的评价列表(按spu,可以再按sku筛选),分tab展示并返回各tab的评价数
+	ListReviewBySpu(ctx context.Context, in *ListReviewBySpuRequest, opts ...grpc.CallOption) (*ListReviewBySpuReply, error)
+	// C端用户查询自己的评价(所有状态,带商家回复)
+	ListReviewByUser(ctx context.Context, in *ListReviewByUserRequest, opts ...grpc.CallOption) (*ListReviewByUserReply, error)
+	// 获取图片视频的上传地址,客户端直接PUT到对象存储,上传完成后把url放到评价、回复、申诉的media中
+	GetUploadToken(ctx context.Context, in *GetUploadTokenRequest, opts ...grpc.CallOption) (*GetUploadTokenReply, error)
+	// 店铺或者商品的标签云,按评价数倒序
+	GetTagCloud(ctx context.Context, in *GetTagCloudRequest, opts ...grpc.CallOption) (*GetTagCloudReply, error)
+	// C端用户追评,审核通过的评价在期限内可以追评一次
+	AppendReview(ctx context.Context, in *AppendReviewRequest, opts ...grpc.CallOption) (*AppendReviewReply, error)
+	// O端运营审核追评
+	AuditAppendReview(ctx context.Context, in *AuditAppendReviewRequest, opts ...grpc.CallOption) (*AuditAppendReviewReply, error)
 }
 
 type reviewClient struct {
@@ -101,6 +132,16 @@ func (c *reviewClient) AppealReview(ctx context.Context, in *AppealReviewRequest
 	return out, nil
 }
 
+func (c *reviewClient) AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...grpc.CallOption) (*AuditReviewReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditReviewReply)
+	err := c.cc.Invoke(ctx, Review_AuditReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reviewClient) AuditAppeal(ctx context.Context, in *AuditAppealRequest, opts ...grpc.CallOption) (*AuditAppealReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AuditAppealReply)
@@ -161,6 +202,86 @@ func (c *reviewClient) ListReview(ctx context.Context, in *ListReviewRequest, op
 	return out, nil
 }
 
+func (c *reviewClient) SearchReviews(ctx context.Context, in *SearchReviewsRequest, opts ...grpc.CallOption) (*SearchReviewsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchReviewsReply)
+	err := c.cc.Invoke(ctx, Review_SearchReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) GetStoreRatingSummary(ctx context.Context, in *GetStoreRatingSummaryRequest, opts ...grpc.CallOption) (*GetStoreRatingSummaryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStoreRatingSummaryReply)
+	err := c.cc.Invoke(ctx, Review_GetStoreRatingSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListReviewBySpu(ctx context.Context, in *ListReviewBySpuRequest, opts ...grpc.CallOption) (*ListReviewBySpuReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewBySpuReply)
+	err := c.cc.Invoke(ctx, Review_ListReviewBySpu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListReviewByUser(ctx context.Context, in *ListReviewByUserRequest, opts ...grpc.CallOption) (*ListReviewByUserReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewByUserReply)
+	err := c.cc.Invoke(ctx, Review_ListReviewByUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) GetUploadToken(ctx context.Context, in *GetUploadTokenRequest, opts ...grpc.CallOption) (*GetUploadTokenReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUploadTokenReply)
+	err := c.cc.Invoke(ctx, Review_GetUploadToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) GetTagCloud(ctx context.Context, in *GetTagCloudRequest, opts ...grpc.CallOption) (*GetTagCloudReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTagCloudReply)
+	err := c.cc.Invoke(ctx, Review_GetTagCloud_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) AppendReview(ctx context.Context, in *AppendReviewRequest, opts ...grpc.CallOption) (*AppendReviewReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppendReviewReply)
+	err := c.cc.Invoke(ctx, Review_AppendReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) AuditAppendReview(ctx context.Context, in *AuditAppendReviewRequest, opts ...grpc.CallOption) (*AuditAppendReviewReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditAppendReviewReply)
+	err := c.cc.Invoke(ctx, Review_AuditAppendReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReviewServer is the server API for Review service.
 // All implementations must embed UnimplementedReviewServer
 // for forward compatibility.
@@ -174,13 +295,35 @@ type ReviewServer interface {
 	ReplyReview(context.Context, *ReplyReviewRequest) (*ReplyReviewReply, error)
 	// 商家申述评价
 	AppealReview(context.Context, *AppealReviewRequest) (*AppealReviewReply, error)
+	// O端运营审核评价
+	AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error)
 	AuditAppeal(context.Context, *AuditAppealRequest) (*AuditAppealReply, error)
 	// 根据商家Id查询评价列表(分页)
 	ListReviewByStoreId(context.Context, *ListReviewByStoreIdRequest) (*ListReviewByStoreIdReply, error)
+	// C端用户修改评价
 	UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewReply, error)
+	// 删除评价(用户删除自己的评价或运营删除)
 	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewReply, error)
+	// 根据评价Id查询评价详情(包含商家回复和申诉)
 	GetReview(context.Context, *GetReviewRequest) (*GetReviewReply, error)
+	// 评价列表(多条件筛选,游标分页)
 	ListReview(context.Context, *ListReviewRequest) (*ListReviewReply, error)
+	// 关键词搜索评价(评价内容、标签、运营备注),返回高亮片段
+	SearchReviews(context.Context, *SearchReviewsRequest) (*SearchReviewsReply, error)
+	// 店铺评分汇总(平均分、星级分布、带图数、回复率)
+	GetStoreRatingSummary(context.Context, *GetStoreRatingSummaryRequest) (*GetStoreRatingSummaryReply, error)
+	// 商品详情页的评价列表(按spu,可以再按sku筛选),分tab展示并返回各tab的评价数
+	ListReviewBySpu(context.Context, *ListReviewBySpuRequest) (*ListReviewBySpuReply, error)
+	// C端用户查询自己的评价(所有状态,带商家回复)
+	ListReviewByUser(context.Context, *ListReviewByUserRequest) (*ListReviewByUserReply, error)
+	// 获取图片视频的上传地址,客户端直接PUT到对象存储,上传完成后把url放到评价、回复、申诉的media中
+	GetUploadToken(context.Context, *GetUploadTokenRequest) (*GetUploadTokenReply, error)
+	// 店铺或者商品的标签云,按评价数倒序
+	GetTagCloud(context.Context, *GetTagCloudRequest) (*GetTagCloudReply, error)
+	// C端用户追评,审核通过的评价在期限内可以追评一次
+	AppendReview(context.Context, *AppendReviewRequest) (*AppendReviewReply, error)
+	// O端运营审核追评
+	AuditAppendReview(context.Context, *AuditAppendReviewRequest) (*AuditAppendReviewReply, error)
 	mustEmbedUnimplementedReviewServer()
 }
 
@@ -203,6 +346,9 @@ func (UnimplementedReviewServer) ReplyReview(context.Context, *ReplyReviewReques
 func (UnimplementedReviewServer) AppealReview(context.Context, *AppealReviewRequest) (*AppealReviewReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppealReview not implemented")
 }
+func (UnimplementedReviewServer) AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditReview not implemented")
+}
 func (UnimplementedReviewServer) AuditAppeal(context.Context, *AuditAppealRequest) (*AuditAppealReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuditAppeal not implemented")
 }
@@ -221,6 +367,30 @@ func (UnimplementedReviewServer) GetReview(context.Context, *GetReviewRequest) (
 func (UnimplementedReviewServer) ListReview(context.Context, *ListReviewRequest) (*ListReviewReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReview not implemented")
 }
+func (UnimplementedReviewServer) SearchReviews(context.Context, *SearchReviewsRequest) (*SearchReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchReviews not implemented")
+}
+func (UnimplementedReviewServer) GetStoreRatingSummary(context.Context, *GetStoreRatingSummaryRequest) (*GetStoreRatingSummaryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoreRatingSummary not implemented")
+}
+func (UnimplementedReviewServer) ListReviewBySpu(context.Context, *ListReviewBySpuRequest) (*ListReviewBySpuReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewBySpu not implemented")
+}
+func (UnimplementedReviewServer) ListReviewByUser(context.Context, *ListReviewByUserRequest) (*ListReviewByUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewByUser not implemented")
+}
+func (UnimplementedReviewServer) GetUploadToken(context.Context, *GetUploadTokenRequest) (*GetUploadTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadToken not implemented")
+}
+func (UnimplementedReviewServer) GetTagCloud(context.Context, *GetTagCloudRequest) (*GetTagCloudReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagCloud not implemented")
+}
+func (UnimplementedReviewServer) AppendReview(context.Context, *AppendReviewRequest) (*AppendReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendReview not implemented")
+}
+func (UnimplementedReviewServer) AuditAppendReview(context.Context, *AuditAppendReviewRequest) (*AuditAppendReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditAppendReview not implemented")
+}
 func (UnimplementedReviewServer) mustEmbedUnimplementedReviewServer() {}
 func (UnimplementedReviewServer) testEmbeddedByValue()                {}
 
@@ -314,6 +484,24 @@ func _Review_AppealReview_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Review_AuditReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).AuditReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_AuditReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).AuditReview(ctx, req.(*AuditReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Review_AuditAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuditAppealRequest)
 	if err := dec(in); err != nil {
@@ -422,6 +610,150 @@ func _Review_ListReview_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Review_SearchReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).SearchReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_SearchReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).SearchReviews(ctx, req.(*SearchReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_GetStoreRatingSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoreRatingSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetStoreRatingSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_GetStoreRatingSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetStoreRatingSummary(ctx, req.(*GetStoreRatingSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ListReviewBySpu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewBySpuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListReviewBySpu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListReviewBySpu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListReviewBySpu(ctx, req.(*ListReviewBySpuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ListReviewByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListReviewByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListReviewByUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListReviewByUser(ctx, req.(*ListReviewByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_GetUploadToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetUploadToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_GetUploadToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetUploadToken(ctx, req.(*GetUploadTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_GetTagCloud_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagCloudRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetTagCloud(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_GetTagCloud_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetTagCloud(ctx, req.(*GetTagCloudRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_AppendReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).AppendReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_AppendReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).AppendReview(ctx, req.(*AppendReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_AuditAppendReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditAppendReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).AuditAppendReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_AuditAppendReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).AuditAppendReview(ctx, req.(*AuditAppendReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Review_ServiceDesc is the grpc.ServiceDesc for Review service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -445,6 +777,10 @@ var Review_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AppealReview",
 			Handler:    _Review_AppealReview_Handler,
 		},
+		{
+			MethodName: "AuditReview",
+			Handler:    _Review_AuditReview_Handler,
+		},
 		{
 			MethodName: "AuditAppeal",
 			Handler:    _Review_AuditAppeal_Handler,
@@ -469,6 +805,38 @@ var Review_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReview",
 			Handler:    _Review_ListReview_Handler,
 		},
+		{
+			MethodName: "SearchReviews",
+			Handler:    _Review_SearchReviews_Handler,
+		},
+		{
+			MethodName: "GetStoreRatingSummary",
+			Handler:    _Review_GetStoreRatingSummary_Handler,
+		},
+		{
+			MethodName: "ListReviewBySpu",
+			Handler:    _Review_ListReviewBySpu_Handler,
+		},
+		{
+			MethodName: "ListReviewByUser",
+			Handler:    _Review_ListReviewByUser_Handler,
+		},
+		{
+			MethodName: "GetUploadToken",
+			Handler:    _Review_GetUploadToken_Handler,
+		},
+		{
+			MethodName: "GetTagCloud",
+			Handler:    _Review_GetTagCloud_Handler,
+		},
+		{
+			MethodName: "AppendReview",
+			Handler:    _Review_AppendReview_Handler,
+		},
+		{
+			MethodName: "AuditAppendReview",
+			Handler:    _Review_AuditAppendReview_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/review/v1/review.proto",
@@ -20,23 +20,62 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationReviewAppealReview = "/api.review.v1.Review/AppealReview"
+const OperationReviewAppendReview = "/api.review.v1.Review/AppendReview"
 const OperationReviewAuditAppeal = "/api.review.v1.Review/AuditAppeal"
+const OperationReviewAuditAppendReview = "/api.review.v1.Review/AuditAppendReview"
+const OperationReviewAuditReview = "/api.review.v1.Review/AuditReview"
 const OperationReviewCreateReview = "/api.review.v1.Review/CreateReview"
+const OperationReviewDeleteReview = "/api.review.v1.Review/DeleteReview"
+const OperationReviewGetReview = "/api.review.v1.Review/GetReview"
+const OperationReviewGetStoreRatingSummary = "/api.review.v1.Review/GetStoreRatingSummary"
+const OperationReviewGetTagCloud = "/api.review.v1.Review/GetTagCloud"
+const OperationReviewGetUploadToken = "/api.review.v1.Review/GetUploadToken"
+const OperationReviewListReview = "/api.review.v1.Review/ListReview"
+const OperationReviewListReviewBySpu = "/api.review.v1.Review/ListReviewBySpu"
 const OperationReviewListReviewByStoreId = "/api.review.v1.Review/ListReviewByStoreId"
+const OperationReviewListReviewByUser = "/api.review.v1.Review/ListReviewByUser"
 const OperationReviewReplyReview = "/api.review.v1.Review/ReplyReview"
+const OperationReviewSearchReviews = "/api.review.v1.Review/SearchReviews"
 const OperationReviewTestConn = "/api.review.v1.Review/TestConn"
+const OperationReviewUpdateReview = "/api.review.v1.Review/UpdateReview"
 
 type ReviewHTTPServer interface {
 	// AppealReview 商家申述评价
 	AppealReview(context.Context, *AppealReviewRequest) (*AppealReviewReply, error)
+	// AppendReview C端用户追评,审核通过的评价在期限内可以追评一次
+	AppendReview(context.Context, *AppendReviewRequest) (*AppendReviewReply, error)
 	AuditAppeal(context.Context, *AuditAppealRequest) (*AuditAppealReply, error)
+	// AuditAppendReview O端运营审核追评
+	AuditAppendReview(context.Context, *AuditAppendReviewRequest) (*AuditAppendReviewReply, error)
+	// AuditReview O端运营审核评价
+	AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error)
 	// CreateReview 创建评价
 	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewReply, error)
+	// DeleteReview 删除评价(用户删除自己的评价或运营删除)
+	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewReply, error)
+	// GetReview 根据评价Id查询评价详情(包含商家回复和申诉)
+	GetReview(context.Context, *GetReviewRequest) (*GetReviewReply, error)
+	// GetStoreRatingSummary 店铺评分汇总(平均分、星级分布、带图数、回复率)
+	GetStoreRatingSummary(context.Context, *GetStoreRatingSummaryRequest) (*GetStoreRatingSummaryReply, error)
+	// GetTagCloud 店铺或者商品的标签云,按评价数倒序
+	GetTagCloud(context.Context, *GetTagCloudRequest) (*GetTagCloudReply, error)
+	// GetUploadToken 获取图片视频的上传地址,客户端直接PUT到对象存储,上传完成后把url放到评价、回复、申诉的media中
+	GetUploadToken(context.Context, *GetUploadTokenRequest) (*GetUploadTokenReply, error)
+	// ListReview 评价列表(多条件筛选,游标分页)
+	ListReview(context.Context, *ListReviewRequest) (*ListReviewReply, error)
+	// ListReviewBySpu 商品详情页的评价列表(按spu,可以再按sku筛选),分tab展示并返回各tab的评价数
+	ListReviewBySpu(context.Context, *ListReviewBySpuRequest) (*ListReviewBySpuReply, error)
 	// ListReviewByStoreId 根据商家Id查询评价列表(分页)
 	ListReviewByStoreId(context.Context, *ListReviewByStoreIdRequest) (*ListReviewByStoreIdReply, error)
+	// ListReviewByUser C端用户查询自己的评价(所有状态,带商家回复)
+	ListReviewByUser(context.Context, *ListReviewByUserRequest) (*ListReviewByUserReply, error)
 	// ReplyReview B端回复评价
 	ReplyReview(context.Context, *ReplyReviewRequest) (*ReplyReviewReply, error)
+	// SearchReviews 关键词搜索评价(评价内容、标签、运营备注),返回高亮片段
+	SearchReviews(context.Context, *SearchReviewsRequest) (*SearchReviewsReply, error)
 	TestConn(context.Context, *TestConnRequest) (*TestConnReply, error)
+	// UpdateReview C端用户修改评价
+	UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewReply, error)
 }
 
 func RegisterReviewHTTPServer(s *http.Server, srv ReviewHTTPServer) {
@@ -45,8 +84,21 @@ func RegisterReviewHTTPServer(s *http.Server, srv ReviewHTTPServer) {
 	r.GET("/v1/review/ping", _Review_TestConn0_HTTP_Handler(srv))
 	r.POST("/v1/review/reply", _Review_ReplyReview0_HTTP_Handler(srv))
 	r.POST("/v1/review/appeal", _Review_AppealReview0_HTTP_Handler(srv))
+	r.POST("/v1/review/audit", _Review_AuditReview0_HTTP_Handler(srv))
 	r.POST("/v1/review/audit_appeal", _Review_AuditAppeal0_HTTP_Handler(srv))
 	r.POST("/v1/review/list_by_store_id", _Review_ListReviewByStoreId0_HTTP_Handler(srv))
+	r.POST("/v1/review/update", _Review_UpdateReview0_HTTP_Handler(srv))
+	r.POST("/v1/review/delete", _Review_DeleteReview0_HTTP_Handler(srv))
+	r.POST("/v1/review/get", _Review_GetReview0_HTTP_Handler(srv))
+	r.POST("/v1/review/list", _Review_ListReview0_HTTP_Handler(srv))
+	r.POST("/v1/review/search", _Review_SearchReviews0_HTTP_Handler(srv))
+	r.POST("/v1/review/store/rating", _Review_GetStoreRatingSummary0_HTTP_Handler(srv))
+	r.POST("/v1/review/spu/list", _Review_ListReviewBySpu0_HTTP_Handler(srv))
+	r.POST("/v1/review/user/list", _Review_ListReviewByUser0_HTTP_Handler(srv))
+	r.POST("/v1/review/upload/token", _Review_GetUploadToken0_HTTP_Handler(srv))
+	r.POST("/v1/review/tag/cloud", _Review_GetTagCloud0_HTTP_Handler(srv))
+	r.POST("/v1/review/append", _Review_AppendReview0_HTTP_Handler(srv))
+	r.POST("/v1/review/append/audit", _Review_AuditAppendReview0_HTTP_Handler(srv))
 }
 
 func _Review_CreateReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
@@ -134,6 +186,28 @@ func _Review_AppealReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Cont
 	}
 }
 
+func _Review_AuditReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AuditReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewAuditReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AuditReview(ctx, req.(*AuditReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuditReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Review_AuditAppeal0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AuditAppealRequest
@@ -178,13 +252,290 @@ func _Review_ListReviewByStoreId0_HTTP_Handler(srv ReviewHTTPServer) func(ctx ht
 	}
 }
 
+func _Review_UpdateReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewUpdateReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateReview(ctx, req.(*UpdateReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_DeleteReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewDeleteReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteReview(ctx, req.(*DeleteReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_GetReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewGetReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReview(ctx, req.(*GetReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_ListReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReview(ctx, req.(*ListReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_SearchReviews0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchReviewsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewSearchReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchReviews(ctx, req.(*SearchReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchReviewsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_GetStoreRatingSummary0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetStoreRatingSummaryRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewGetStoreRatingSummary)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetStoreRatingSummary(ctx, req.(*GetStoreRatingSummaryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetStoreRatingSummaryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_ListReviewBySpu0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReviewBySpuRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListReviewBySpu)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReviewBySpu(ctx, req.(*ListReviewBySpuRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReviewBySpuReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_ListReviewByUser0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReviewByUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListReviewByUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReviewByUser(ctx, req.(*ListReviewByUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReviewByUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_GetUploadToken0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUploadTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewGetUploadToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUploadToken(ctx, req.(*GetUploadTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUploadTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_GetTagCloud0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTagCloudRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewGetTagCloud)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTagCloud(ctx, req.(*GetTagCloudRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTagCloudReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_AppendReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AppendReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewAppendReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AppendReview(ctx, req.(*AppendReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AppendReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_AuditAppendReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AuditAppendReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewAuditAppendReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AuditAppendReview(ctx, req.(*AuditAppendReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuditAppendReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ReviewHTTPClient interface {
 	AppealReview(ctx context.Context, req *AppealReviewRequest, opts ...http.CallOption) (rsp *AppealReviewReply, err error)
+	AppendReview(ctx context.Context, req *AppendReviewRequest, opts ...http.CallOption) (rsp *AppendReviewReply, err error)
 	AuditAppeal(ctx context.Context, req *AuditAppealRequest, opts ...http.CallOption) (rsp *AuditAppealReply, err error)
+	AuditAppendReview(ctx context.Context, req *AuditAppendReviewRequest, opts ...http.CallOption) (rsp *AuditAppendReviewReply, err error)
+	AuditReview(ctx context.Context, req *AuditReviewRequest, opts ...http.CallOption) (rsp *AuditReviewReply, err error)
 	CreateReview(ctx context.Context, req *CreateReviewRequest, opts ...http.CallOption) (rsp *CreateReviewReply, err error)
+	DeleteReview(ctx context.Context, req *DeleteReviewRequest, opts ...http.CallOption) (rsp *DeleteReviewReply, err error)
+	GetReview(ctx context.Context, req *GetReviewRequest, opts ...http.CallOption) (rsp *GetReviewReply, err error)
+	GetStoreRatingSummary(ctx context.Context, req *GetStoreRatingSummaryRequest, opts ...http.CallOption) (rsp *GetStoreRatingSummaryReply, err error)
+	GetTagCloud(ctx context.Context, req *GetTagCloudRequest, opts ...http.CallOption) (rsp *GetTagCloudReply, err error)
+	GetUploadToken(ctx context.Context, req *GetUploadTokenRequest, opts ...http.CallOption) (rsp *GetUploadTokenReply, err error)
+	ListReview(ctx context.Context, req *ListReviewRequest, opts ...http.CallOption) (rsp *ListReviewReply, err error)
+	ListReviewBySpu(ctx context.Context, req *ListReviewBySpuRequest, opts ...http.CallOption) (rsp *ListReviewBySpuReply, err error)
 	ListReviewByStoreId(ctx context.Context, req *ListReviewByStoreIdRequest, opts ...http.CallOption) (rsp *ListReviewByStoreIdReply, err error)
+	ListReviewByUser(ctx context.Context, req *ListReviewByUserRequest, opts ...http.CallOption) (rsp *ListReviewByUserReply, err error)
 	ReplyReview(ctx context.Context, req *ReplyReviewRequest, opts ...http.CallOption) (rsp *ReplyReviewReply, err error)
+	SearchReviews(ctx context.Context, req *SearchReviewsRequest, opts ...http.CallOption) (rsp *SearchReviewsReply, err error)
 	TestConn(ctx context.Context, req *TestConnRequest, opts ...http.CallOption) (rsp *TestConnReply, err error)
+	UpdateReview(ctx context.Context, req *UpdateReviewRequest, opts ...http.CallOption) (rsp *UpdateReviewReply, err error)
 }
 
 type ReviewHTTPClientImpl struct {
@@ -208,6 +559,19 @@ func (c *ReviewHTTPClientImpl) AppealReview(ctx context.Context, in *AppealRevie
 	return &out, nil
 }
 
+func (c *ReviewHTTPClientImpl) AppendReview(ctx context.Context, in *AppendReviewRequest, opts ...http.CallOption) (*AppendReviewReply, error) {
+	var out AppendReviewReply
+	pattern := "/v1/review/append"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewAppendReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) AuditAppeal(ctx context.Context, in *AuditAppealRequest, opts ...http.CallOption) (*AuditAppealReply, error) {
 	var out AuditAppealReply
 	pattern := "/v1/review/audit_appeal"
@@ -221,6 +585,32 @@ func (c *ReviewHTTPClientImpl) AuditAppeal(ctx context.Context, in *AuditAppealR
 	return &out, nil
 }
 
+func (c *ReviewHTTPClientImpl) AuditAppendReview(ctx context.Context, in *AuditAppendReviewRequest, opts ...http.CallOption) (*AuditAppendReviewReply, error) {
+	var out AuditAppendReviewReply
+	pattern := "/v1/review/append/audit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewAuditAppendReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...http.CallOption) (*AuditReviewReply, error) {
+	var out AuditReviewReply
+	pattern := "/v1/review/audit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewAuditReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...http.CallOption) (*CreateReviewReply, error) {
 	var out CreateReviewReply
 	pattern := "/v1/review/add"
@@ -234,6 +624,97 @@ func (c *ReviewHTTPClientImpl) CreateReview(ctx context.Context, in *CreateRevie
 	return &out, nil
 }
 
+func (c *ReviewHTTPClientImpl) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...http.CallOption) (*DeleteReviewReply, error) {
+	var out DeleteReviewReply
+	pattern := "/v1/review/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewDeleteReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) GetReview(ctx context.Context, in *GetReviewRequest, opts ...http.CallOption) (*GetReviewReply, error) {
+	var out GetReviewReply
+	pattern := "/v1/review/get"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewGetReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) GetStoreRatingSummary(ctx context.Context, in *GetStoreRatingSummaryRequest, opts ...http.CallOption) (*GetStoreRatingSummaryReply, error) {
+	var out GetStoreRatingSummaryReply
+	pattern := "/v1/review/store/rating"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewGetStoreRatingSummary))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) GetTagCloud(ctx context.Context, in *GetTagCloudRequest, opts ...http.CallOption) (*GetTagCloudReply, error) {
+	var out GetTagCloudReply
+	pattern := "/v1/review/tag/cloud"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewGetTagCloud))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) GetUploadToken(ctx context.Context, in *GetUploadTokenRequest, opts ...http.CallOption) (*GetUploadTokenReply, error) {
+	var out GetUploadTokenReply
+	pattern := "/v1/review/upload/token"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewGetUploadToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) ListReview(ctx context.Context, in *ListReviewRequest, opts ...http.CallOption) (*ListReviewReply, error) {
+	var out ListReviewReply
+	pattern := "/v1/review/list"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewListReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) ListReviewBySpu(ctx context.Context, in *ListReviewBySpuRequest, opts ...http.CallOption) (*ListReviewBySpuReply, error) {
+	var out ListReviewBySpuReply
+	pattern := "/v1/review/spu/list"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewListReviewBySpu))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) ListReviewByStoreId(ctx context.Context, in *ListReviewByStoreIdRequest, opts ...http.CallOption) (*ListReviewByStoreIdReply, error) {
 	var out ListReviewByStoreIdReply
 	pattern := "/v1/review/list_by_store_id"
@@ -247,6 +728,19 @@ func (c *ReviewHTTPClientImpl) ListReviewByStoreId(ctx context.Context, in *List
 	return &out, nil
 }
 
+func (c *ReviewHTTPClientImpl) ListReviewByUser(ctx context.Context, in *ListReviewByUserRequest, opts ...http.CallOption) (*ListReviewByUserReply, error) {
+	var out ListReviewByUserReply
+	pattern := "/v1/review/user/list"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewListReviewByUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...http.CallOption) (*ReplyReviewReply, error) {
 	var out ReplyReviewReply
 	pattern := "/v1/review/reply"
@@ -260,6 +754,19 @@ func (c *ReviewHTTPClientImpl) ReplyReview(ctx context.Context, in *ReplyReviewR
 	return &out, nil
 }
 
+func (c *ReviewHTTPClientImpl) SearchReviews(ctx context.Context, in *SearchReviewsRequest, opts ...http.CallOption) (*SearchReviewsReply, error) {
+	var out SearchReviewsReply
+	pattern := "/v1/review/search"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewSearchReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) TestConn(ctx context.Context, in *TestConnRequest, opts ...http.CallOption) (*TestConnReply, error) {
 	var out TestConnReply
 	pattern := "/v1/review/ping"
@@ -272,3 +779,16 @@ func (c *ReviewHTTPClientImpl) TestConn(ctx context.Context, in *TestConnRequest
 	}
 	return &out, nil
 }
+
+func (c *ReviewHTTPClientImpl) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...http.CallOption) (*UpdateReviewReply, error) {
+	var out UpdateReviewReply
+	pattern := "/v1/review/update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewUpdateReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,97 @@
+package main
+
+// ES索引管理工具
+// 创建索引:   go run ./cmd/review-es -conf configs -job create
+// 切换别名:   go run ./cmd/review-es -conf configs -job alias -index review_20251101120000
+// 全量重建:   go run ./cmd/review-es -conf configs -job reindex
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"review-service/internal/conf"
+	"review-service/internal/data"
+	"review-service/internal/data/query"
+	"review-service/internal/esindex"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	flagconf string
+	job      string
+	index    string
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "configs", "config path, eg: -conf config.yaml")
+	flag.StringVar(&job, "job", "", "create: 创建新版本索引; alias: 把别名指向-index; reindex: 从MySQL全量重建索引并切换别名")
+	flag.StringVar(&index, "index", "", "alias任务要指向的索引名")
+}
+
+func main() {
+	flag.Parse()
+
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+			// 和review-service共用配置文件,密钥同样从REVIEW_前缀的环境变量读取
+			env.NewSource("REVIEW_"),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+	// 密钥未配置或者使用了公开的默认值时拒绝启动
+	if err := conf.CheckSecrets(&bc); err != nil {
+		panic(err)
+	}
+
+	es, err := data.NewESClient(bc.Elasticsearch)
+	if err != nil {
+		panic(err)
+	}
+	m := esindex.NewManager(es, log.DefaultLogger)
+	ctx := context.Background()
+
+	switch job {
+	case "create":
+		name := esindex.NewIndexName()
+		if err := m.CreateIndex(ctx, name); err != nil {
+			panic(err)
+		}
+		fmt.Println(name)
+	case "alias":
+		if index == "" {
+			fmt.Println("alias任务需要指定-index")
+			os.Exit(1)
+		}
+		if err := m.SwitchAlias(ctx, index); err != nil {
+			panic(err)
+		}
+	case "reindex":
+		db, err := data.NewDB(bc.Data)
+		if err != nil {
+			panic(err)
+		}
+		name, err := m.Reindex(ctx, query.Use(db))
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(name)
+	default:
+		flag.Usage()
+		os.Exit(1)
+	}
+}
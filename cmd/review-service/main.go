@@ -9,9 +9,12 @@ import (
 	"os"
 	"review-service/pkg/snowflake"
 
+	"review-service/internal/biz"
 	"review-service/internal/conf"
+	"review-service/internal/data"
 
 	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
@@ -37,7 +40,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.Server, relay *data.OutboxRelay, job *biz.DefaultReviewJob) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -47,6 +50,8 @@ func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.S
 		kratos.Server(
 			gs,
 			hs,
+			relay,
+			job,
 		),
 		kratos.Registrar(r),
 	)
@@ -79,6 +84,8 @@ func main() {
 	c := config.New(
 		config.WithSource(
 			file.NewSource(flagconf),
+			// 密钥等敏感配置从REVIEW_前缀的环境变量读取,配置文件里用${KEY}引用
+			env.NewSource("REVIEW_"),
 		),
 	)
 	defer c.Close()
@@ -91,6 +98,10 @@ func main() {
 	if err := c.Scan(&bc); err != nil {
 		panic(err)
 	}
+	// 密钥未配置或者使用了公开的默认值时拒绝启动
+	if err := conf.CheckSecrets(&bc); err != nil {
+		panic(err)
+	}
 
 	// 解析registry.yaml中的配置
 	var rc conf.Registry
@@ -98,7 +109,7 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, &rc, bc.Data, bc.Elasticsearch, logger)
+	app, cleanup, err := wireApp(bc.Server, &rc, bc.Data, bc.Elasticsearch, bc.Review, bc.Client, bc.Event, logger)
 	if err != nil {
 		panic(err)
 	}
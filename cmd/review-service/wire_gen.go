@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, elasticsearch *conf.Elasticsearch, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, elasticsearch *conf.Elasticsearch, review *conf.Review, client *conf.Client, event *conf.Event, logger log.Logger) (*kratos.App, func(), error) {
 	registrar := server.NewRegistrar(registry)
 	db, err := data.NewDB(confData)
 	if err != nil {
@@ -33,17 +33,79 @@ func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Da
 	if err != nil {
 		return nil, nil, err
 	}
-	dataData, cleanup, err := data.NewData(db, typedClient, logger)
+	redisClient := data.NewRedisClient(confData)
+	dataData, cleanup, err := data.NewData(db, typedClient, redisClient, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	reviewRepo := data.NewReviewRepo(dataData, logger)
-	reviewUsecase := biz.NewReviewUsecase(reviewRepo, logger)
-	reviewService := service.NewReviewService(reviewUsecase)
+	discovery := data.NewDiscovery(registry)
+	orderClient, cleanup2, err := data.NewOrderClient(client, discovery, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	productClient, cleanup3, err := data.NewProductClient(client, discovery, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	contentFilter, cleanup4, err := biz.NewContentFilter(review, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	mediaModerator := data.NewMediaModerator(review, logger)
+	moderationPipeline := biz.NewModerationPipelineFromConf(review, contentFilter, mediaModerator, reviewRepo, logger)
+	objectStorage, err := data.NewObjectStorage(review)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tagDict, err := biz.NewTagDict(review)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	reviewUsecase := biz.NewReviewUsecase(review, reviewRepo, orderClient, productClient, contentFilter, moderationPipeline, objectStorage, tagDict, logger)
+	reviewService := service.NewReviewService(review, reviewUsecase)
 	grpcServer := server.NewGRPCServer(confServer, reviewService, logger)
 	httpServer := server.NewHTTPServer(confServer, reviewService, logger)
-	app := newApp(logger, registrar, grpcServer, httpServer)
+	eventPublisher, cleanup5, err := data.NewEventPublisher(event)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	outboxRelay := data.NewOutboxRelay(event, dataData, eventPublisher, logger)
+	orderSource, cleanup6, err := data.NewOrderSource(review, client, discovery, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	defaultReviewJob := biz.NewDefaultReviewJob(review, reviewUsecase, orderSource, logger)
+	app := newApp(logger, registrar, grpcServer, httpServer, outboxRelay, defaultReviewJob)
 	return app, func() {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"flag"
+	kratosLog "github.com/go-kratos/kratos/contrib/log/logrus/v2"
+	"github.com/go-kratos/kratos/v2"
+	"github.com/sirupsen/logrus"
+	"os"
+	"review-service/internal/task"
+
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+
+	_ "go.uber.org/automaxprocs"
+)
+
+// go build -ldflags "-X main.Version=x.y.z"
+var (
+	// Name is the name of the compiled software.
+	Name string = "review.task"
+	// Version is the version of the compiled software.
+	Version string = "v0.1"
+	// flagconf is the config flag.
+	flagconf string
+
+	id, _ = os.Hostname()
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+}
+
+// newApp review-task 不对外提供服务,只运行binlog同步的worker
+func newApp(logger log.Logger, w *task.SyncWorker) *kratos.App {
+	return kratos.New(
+		kratos.ID(id),
+		kratos.Name(Name),
+		kratos.Version(Version),
+		kratos.Metadata(map[string]string{}),
+		kratos.Logger(logger),
+		kratos.Server(w),
+	)
+}
+
+func main() {
+	flag.Parse()
+
+	logrusLogger := logrus.New()
+	logrusLogger.SetOutput(os.Stdout)
+	logrusLogger.SetFormatter(&logrus.TextFormatter{
+		ForceColors:     true,
+		FullTimestamp:   true,
+		TimestampFormat: "2006-01-02 15:04:05",
+	})
+
+	logger := log.With(kratosLog.NewLogger(logrusLogger),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+		"service.id", id,
+		"service.name", Name,
+		"service.version", Version,
+	)
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+			// 和review-service共用配置文件,密钥同样从REVIEW_前缀的环境变量读取
+			env.NewSource("REVIEW_"),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+	// 密钥未配置或者使用了公开的默认值时拒绝启动
+	if err := conf.CheckSecrets(&bc); err != nil {
+		panic(err)
+	}
+
+	app, cleanup, err := wireApp(bc.Kafka, bc.Elasticsearch, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	// start and wait for stop signal
+	if err := app.Run(); err != nil {
+		panic(err)
+	}
+}
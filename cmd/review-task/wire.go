@@ -0,0 +1,21 @@
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package main
+
+import (
+	"review-service/internal/conf"
+	"review-service/internal/data"
+	"review-service/internal/task"
+
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// wireApp init kratos application.
+func wireApp(*conf.Kafka, *conf.Elasticsearch, log.Logger) (*kratos.App, func(), error) {
+	panic(wire.Build(task.ProviderSet, data.NewESClient, newApp))
+}
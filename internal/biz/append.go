@@ -0,0 +1,165 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"review-service/pkg/snowflake"
+)
+
+// defaultAppendWindow 没有配置时评价允许追评的时间窗口
+const defaultAppendWindow = 180 * 24 * time.Hour
+
+// AppendReview 用户追评
+// 只能追评自己审核通过的评价,一条评价只能追评一次,且只能在创建后的一段时间内追评
+// 追评有自己的审核状态,审核通过前只有追评的用户和运营可见
+func (uc *ReviewUsecase) AppendReview(ctx context.Context, param *AppendParam) (*model.ReviewAppendInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] AppendReview, param:%+v", param)
+	caller, err := requireRole(ctx, RoleUser)
+	if err != nil {
+		return nil, err
+	}
+	param.UserId = caller.UserId
+	review, err := uc.repo.GetReview(ctx, param.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+	// 水平越权校验(用户只能追评自己的评价)
+	if review.UserID != param.UserId {
+		return nil, v1.ErrorForbiddenUser("水平越权")
+	}
+	if ReviewStatus(review.Status) != Approved {
+		return nil, v1.ErrorStatusTransitionNotAllowed("评价%s,审核通过后才能追评", ReviewStatus(review.Status))
+	}
+	if time.Since(review.CreateAt) > uc.appendWindow {
+		return nil, v1.ErrorAppendWindowExpired("评价已超过可追评期限")
+	}
+	current, err := uc.repo.GetAppendByReviewId(ctx, param.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil {
+		return nil, v1.ErrorAppendAlreadyExists("评价:%d已追评", param.ReviewId)
+	}
+	picInfo, videoInfo, err := uc.encodeMedia(ctx, uploadOwner(caller), param.Media)
+	if err != nil {
+		return nil, err
+	}
+	info := &model.ReviewAppendInfo{
+		AppendID:  snowflake.GenerateID(),
+		ReviewID:  review.ReviewID,
+		UserID:    review.UserID,
+		StoreID:   review.StoreID,
+		Content:   param.Content,
+		PicInfo:   picInfo,
+		VideoInfo: videoInfo,
+		Status:    int32(PendingReview),
+	}
+	if len(param.Media) > 0 {
+		info.HasMedia = 1
+	}
+	// 追评和评价一样需要审核,敏感词检查结果记录到ctrl_json
+	if _, err := uc.filterContent(ctx, &info.Content, &info.CtrlJSON); err != nil {
+		return nil, err
+	}
+	return uc.repo.SaveAppend(ctx, info)
+}
+
+// AuditAppendReview 运营审核追评,只有待审核的追评才能审核
+func (uc *ReviewUsecase) AuditAppendReview(ctx context.Context, param *AuditParam) (*model.ReviewAppendInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] AuditAppendReview, param:%+v", param)
+	caller, err := requireRole(ctx, RoleOperator)
+	if err != nil {
+		return nil, err
+	}
+	param.OpUser = caller.OpUser
+	to := ReviewStatus(param.Status)
+	if to != Approved && to != ReviewNotApproved {
+		return nil, v1.ErrorInvalidParam("审核状态不合法: %d", param.Status)
+	}
+	if to == ReviewNotApproved && param.OpReason == "" {
+		return nil, v1.ErrorInvalidParam("审核不通过时需要填写原因")
+	}
+	info, err := uc.repo.GetAppendByReviewId(ctx, param.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, v1.ErrorReviewNotFound("评价:%d没有追评", param.ReviewId)
+	}
+	if ReviewStatus(info.Status) != PendingReview {
+		return nil, newTransitionError("追评", ReviewStatus(info.Status).String(), to.String())
+	}
+	if err := uc.repo.AuditAppend(ctx, param); err != nil {
+		return nil, err
+	}
+	info.Status = param.Status
+	info.OpUser = param.OpUser
+	info.OpReason = param.OpReason
+	info.OpRemarks = param.OpRemarks
+	return info, nil
+}
+
+// visibleAppend 按调用方过滤追评: 审核通过的追评所有人可见
+// 待审核和审核不通过的追评只有追评的用户本人和运营可见
+func visibleAppend(caller *Caller, info *model.ReviewAppendInfo) *model.ReviewAppendInfo {
+	if info == nil || ReviewStatus(info.Status) == Approved {
+		return info
+	}
+	if caller.Role == RoleOperator || (caller.Role == RoleUser && caller.UserId == info.UserID) {
+		return info
+	}
+	return nil
+}
+
+// loadAppends 批量查询评价的追评并按调用方过滤,reviewId -> 追评
+func (uc *ReviewUsecase) loadAppends(ctx context.Context, reviewIds []int64) (map[int64]*model.ReviewAppendInfo, error) {
+	if len(reviewIds) == 0 {
+		return nil, nil
+	}
+	appends, err := uc.repo.ListAppendByReviewIds(ctx, reviewIds)
+	if err != nil {
+		return nil, err
+	}
+	caller := CallerFromContext(ctx)
+	for id, v := range appends {
+		if visibleAppend(caller, v) == nil {
+			delete(appends, id)
+		}
+	}
+	return appends, nil
+}
+
+// fillAppends 给ES查出来的评价带上追评,追评不写入ES,每页查一次MySQL
+func (uc *ReviewUsecase) fillAppends(ctx context.Context, list []*MyReviewInfo) error {
+	reviewIds := make([]int64, 0, len(list))
+	for _, v := range list {
+		reviewIds = append(reviewIds, v.ReviewID)
+	}
+	appends, err := uc.loadAppends(ctx, reviewIds)
+	if err != nil {
+		return err
+	}
+	for _, v := range list {
+		v.Append = appends[v.ReviewID]
+	}
+	return nil
+}
+
+// fillDetailAppends 给MySQL查出来的评价详情带上追评
+func (uc *ReviewUsecase) fillDetailAppends(ctx context.Context, list []*ReviewDetail) error {
+	reviewIds := make([]int64, 0, len(list))
+	for _, v := range list {
+		reviewIds = append(reviewIds, v.Review.ReviewID)
+	}
+	appends, err := uc.loadAppends(ctx, reviewIds)
+	if err != nil {
+		return err
+	}
+	for _, v := range list {
+		v.Append = appends[v.Review.ReviewID]
+	}
+	return nil
+}
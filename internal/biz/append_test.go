@@ -0,0 +1,182 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+)
+
+func (r *memRepo) SaveAppend(_ context.Context, info *model.ReviewAppendInfo) (*model.ReviewAppendInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// 和uk_append_review_id一样,一条评价只能有一条追评
+	if _, ok := r.appends[info.ReviewID]; ok {
+		return nil, v1.ErrorAppendAlreadyExists("评价:%d已追评", info.ReviewID)
+	}
+	v := *info
+	r.appends[info.ReviewID] = &v
+	return info, nil
+}
+
+func (r *memRepo) GetAppendByReviewId(_ context.Context, reviewId int64) (*model.ReviewAppendInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	v, ok := r.appends[reviewId]
+	if !ok {
+		return nil, nil
+	}
+	ret := *v
+	return &ret, nil
+}
+
+func (r *memRepo) AuditAppend(_ context.Context, param *biz.AuditParam) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	v, ok := r.appends[param.ReviewId]
+	if !ok || v.Status != int32(biz.PendingReview) {
+		return v1.ErrorNeedRetry("追评状态已变更,请刷新后重试")
+	}
+	v.Status, v.OpUser, v.OpReason = param.Status, param.OpUser, param.OpReason
+	return nil
+}
+
+func (r *memRepo) GetReplyByReviewId(context.Context, int64) (*model.ReviewReplyInfo, error) {
+	return nil, nil
+}
+
+func (r *memRepo) GetAppealByReviewId(context.Context, int64) (*model.ReviewAppealInfo, error) {
+	return nil, nil
+}
+
+// putReview 直接放一条评价,createAt是评价创建了多久
+func (env *testEnv) putReview(reviewId, userId int64, status biz.ReviewStatus, age time.Duration) {
+	env.repo.mu.Lock()
+	defer env.repo.mu.Unlock()
+	env.repo.reviews[reviewId] = &model.ReviewInfo{
+		ReviewID: reviewId, UserID: userId, StoreID: 3, Status: int32(status), CreateAt: time.Now().Add(-age),
+	}
+}
+
+func (env *testEnv) appendReview(userId, reviewId int64, content string) (*model.ReviewAppendInfo, error) {
+	return env.uc.AppendReview(userCtx(userId), &biz.AppendParam{ReviewId: reviewId, Content: content})
+}
+
+func TestAppendReview(t *testing.T) {
+	env := newTestEnv(t, &conf.Review{AppendWindow: durationpb.New(30 * 24 * time.Hour)})
+	env.putReview(1, 9, biz.Approved, 29*24*time.Hour)
+	env.putReview(2, 9, biz.Approved, 31*24*time.Hour)
+	env.putReview(3, 9, biz.PendingReview, time.Hour)
+	env.putReview(4, 9, biz.Hidden, time.Hour)
+
+	cases := []struct {
+		name     string
+		userId   int64
+		reviewId int64
+		check    func(error) bool
+	}{
+		{"not author", 8, 1, v1.IsForbiddenUser},
+		{"window expired", 9, 2, v1.IsAppendWindowExpired},
+		{"pending review", 9, 3, v1.IsStatusTransitionNotAllowed},
+		{"hidden review", 9, 4, v1.IsStatusTransitionNotAllowed},
+		{"not found", 9, 5, v1.IsReviewNotFound},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := env.appendReview(c.userId, c.reviewId, "用了一个月还不错"); !c.check(err) {
+				t.Fatalf("err = %v", err)
+			}
+		})
+	}
+	// 商家和运营不能追评
+	store := biz.NewCallerContext(context.Background(), &biz.Caller{Role: biz.RoleStore, StoreId: 3})
+	if _, err := env.uc.AppendReview(store, &biz.AppendParam{ReviewId: 1, Content: "追评"}); !v1.IsPermissionDenied(err) {
+		t.Fatalf("err = %v, want PERMISSION_DENIED", err)
+	}
+
+	info, err := env.appendReview(9, 1, "用了一个月还不错")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Status != int32(biz.PendingReview) || info.UserID != 9 || info.StoreID != 3 || info.AppendID == 0 {
+		t.Fatalf("append = %+v", info)
+	}
+	// 一条评价只能追评一次
+	if _, err := env.appendReview(9, 1, "再追一次"); !v1.IsAppendAlreadyExists(err) {
+		t.Fatalf("err = %v, want APPEND_ALREADY_EXISTS", err)
+	}
+}
+
+// 待审核和审核不通过的追评只有追评的用户和运营可见,审核通过后所有人可见
+func TestAppendVisibility(t *testing.T) {
+	env := newTestEnv(t, nil)
+	env.putReview(1, 9, biz.Approved, time.Hour)
+	if _, err := env.appendReview(9, 1, "用了一个月还不错"); err != nil {
+		t.Fatal(err)
+	}
+	callers := map[string]*biz.Caller{
+		"author":     {Role: biz.RoleUser, UserId: 9},
+		"other user": {Role: biz.RoleUser, UserId: 8},
+		"store":      {Role: biz.RoleStore, StoreId: 3},
+		"operator":   {Role: biz.RoleOperator, OpUser: "op"},
+		"public":     {Role: biz.RolePublic},
+	}
+	operator := biz.NewCallerContext(context.Background(), callers["operator"])
+	for _, step := range []struct {
+		status  biz.ReviewStatus
+		visible map[string]bool
+	}{
+		{biz.PendingReview, map[string]bool{"author": true, "operator": true}},
+		{biz.ReviewNotApproved, map[string]bool{"author": true, "operator": true}},
+		{biz.Approved, map[string]bool{"author": true, "other user": true, "store": true, "operator": true, "public": true}},
+	} {
+		if step.status != biz.PendingReview {
+			env.repo.appends[1].Status = int32(biz.PendingReview)
+			if _, err := env.uc.AuditAppendReview(operator, &biz.AuditParam{ReviewId: 1, Status: int32(step.status), OpReason: "广告"}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for name, caller := range callers {
+			ctx := biz.NewCallerContext(context.Background(), caller)
+			detail, err := env.uc.GetReview(ctx, 1)
+			if err != nil {
+				t.Fatalf("%s %s: %v", step.status, name, err)
+			}
+			if got := detail.Append != nil; got != step.visible[name] {
+				t.Fatalf("%s %s: append visible = %v", step.status, name, got)
+			}
+		}
+	}
+}
+
+// 只有待审核的追评能审核
+func TestAuditAppendReviewOnlyPending(t *testing.T) {
+	env := newTestEnv(t, nil)
+	env.putReview(1, 9, biz.Approved, time.Hour)
+	if _, err := env.appendReview(9, 1, "用了一个月还不错"); err != nil {
+		t.Fatal(err)
+	}
+	operator := biz.NewCallerContext(context.Background(), &biz.Caller{Role: biz.RoleOperator, OpUser: "op"})
+	audit := func(status biz.ReviewStatus, reason string) error {
+		_, err := env.uc.AuditAppendReview(operator, &biz.AuditParam{ReviewId: 1, Status: int32(status), OpReason: reason})
+		return err
+	}
+	if err := audit(biz.Hidden, ""); !v1.IsInvalidParam(err) {
+		t.Fatalf("err = %v, want INVALID_PARAM", err)
+	}
+	if err := audit(biz.ReviewNotApproved, ""); !v1.IsInvalidParam(err) {
+		t.Fatalf("err = %v, want INVALID_PARAM for missing reason", err)
+	}
+	if err := audit(biz.Approved, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := audit(biz.ReviewNotApproved, "广告"); !v1.IsStatusTransitionNotAllowed(err) {
+		t.Fatalf("err = %v, want STATUS_TRANSITION_NOT_ALLOWED", err)
+	}
+}
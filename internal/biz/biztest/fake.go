@@ -0,0 +1,136 @@
+// Package biztest 测试用的内存版订单服务、商品服务和事件投递,只在单元测试中使用
+package biztest
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"review-service/internal/biz"
+)
+
+var (
+	_ biz.OrderClient    = (*FakeOrderClient)(nil)
+	_ biz.OrderSource    = (*FakeOrderClient)(nil)
+	_ biz.ProductClient  = (*FakeProductClient)(nil)
+	_ biz.EventPublisher = (*MemoryEventPublisher)(nil)
+)
+
+// FakeOrderClient 内存版订单服务,同时实现OrderClient和OrderSource,本地调试和单元测试用
+type FakeOrderClient struct {
+	mu     sync.RWMutex
+	orders map[int64]*biz.Order
+}
+
+func NewFakeOrderClient(orders ...*biz.Order) *FakeOrderClient {
+	c := &FakeOrderClient{orders: make(map[int64]*biz.Order, len(orders))}
+	for _, o := range orders {
+		c.orders[o.OrderID] = o
+	}
+	return c
+}
+
+// Put 添加或者覆盖一个订单
+func (c *FakeOrderClient) Put(o *biz.Order) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.orders[o.OrderID] = o
+}
+
+func (c *FakeOrderClient) GetOrder(_ context.Context, orderId int64) (*biz.Order, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	o, ok := c.orders[orderId]
+	if !ok {
+		return nil, nil
+	}
+	ret := *o
+	return &ret, nil
+}
+
+func (c *FakeOrderClient) ListCompletedOrders(_ context.Context, start, end time.Time, afterOrderId int64, limit int) ([]*biz.Order, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var ret []*biz.Order
+	for _, o := range c.orders {
+		if o.Status != biz.OrderCompleted || o.OrderID <= afterOrderId ||
+			o.CompleteAt.Before(start) || !o.CompleteAt.Before(end) {
+			continue
+		}
+		v := *o
+		ret = append(ret, &v)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].OrderID < ret[j].OrderID })
+	if len(ret) > limit {
+		ret = ret[:limit]
+	}
+	return ret, nil
+}
+
+// FakeProductClient 内存版商品服务,本地调试和单元测试用
+type FakeProductClient struct {
+	mu   sync.RWMutex
+	skus map[int64]*biz.Sku
+}
+
+func NewFakeProductClient(skus ...*biz.Sku) *FakeProductClient {
+	c := &FakeProductClient{skus: make(map[int64]*biz.Sku, len(skus))}
+	for _, s := range skus {
+		c.skus[s.SkuID] = s
+	}
+	return c
+}
+
+// Put 添加或者覆盖一个sku
+func (c *FakeProductClient) Put(s *biz.Sku) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.skus[s.SkuID] = s
+}
+
+func (c *FakeProductClient) GetSku(_ context.Context, skuId int64) (*biz.Sku, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s, ok := c.skus[skuId]
+	if !ok {
+		return nil, nil
+	}
+	ret := *s
+	return &ret, nil
+}
+
+// MemoryEventPublisher 内存版事件投递,本地调试和单元测试用
+type MemoryEventPublisher struct {
+	mu     sync.Mutex
+	events []*biz.ReviewEvent
+	err    error
+}
+
+func NewMemoryEventPublisher() *MemoryEventPublisher {
+	return &MemoryEventPublisher{}
+}
+
+// SetError 设置之后Publish都返回这个错误,用来模拟投递失败,传nil恢复正常
+func (p *MemoryEventPublisher) SetError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+func (p *MemoryEventPublisher) Publish(_ context.Context, event *biz.ReviewEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.events = append(p.events, event)
+	return nil
+}
+
+// Events 返回已经投递的事件
+func (p *MemoryEventPublisher) Events() []*biz.ReviewEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*biz.ReviewEvent(nil), p.events...)
+}
@@ -0,0 +1,53 @@
+package biz
+
+import (
+	"context"
+	v1 "review-service/api/review/v1"
+)
+
+// Role 调用方角色
+type Role string
+
+const (
+	RolePublic   Role = ""         // 未登录的C端访客
+	RoleUser     Role = "user"     // C端用户
+	RoleStore    Role = "store"    // 商家
+	RoleOperator Role = "operator" // 运营
+)
+
+// Caller 调用方身份
+type Caller struct {
+	Role    Role
+	UserId  int64  // RoleUser时有效
+	StoreId int64  // RoleStore时有效
+	OpUser  string // RoleOperator时有效
+}
+
+type callerKey struct{}
+
+// NewCallerContext 把调用方身份放到ctx中
+func NewCallerContext(ctx context.Context, c *Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, c)
+}
+
+// CallerFromContext 从ctx中取调用方身份,没有时按未登录的C端访客处理
+func CallerFromContext(ctx context.Context) *Caller {
+	if c, ok := ctx.Value(callerKey{}).(*Caller); ok && c != nil {
+		return c
+	}
+	return &Caller{Role: RolePublic}
+}
+
+// requireRole 校验调用方是roles中的一种角色,未登录返回UNAUTHORIZED,角色不对返回PERMISSION_DENIED
+func requireRole(ctx context.Context, roles ...Role) (*Caller, error) {
+	c := CallerFromContext(ctx)
+	if c.Role == RolePublic {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	for _, r := range roles {
+		if c.Role == r {
+			return c, nil
+		}
+	}
+	return nil, v1.ErrorPermissionDenied("角色%s没有权限", c.Role)
+}
@@ -0,0 +1,66 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// OrderStatus 订单状态,和订单服务保持一致
+type OrderStatus int32
+
+const (
+	OrderPendingPayment OrderStatus = 10 // 待支付
+	OrderPaid           OrderStatus = 20 // 已支付
+	OrderShipped        OrderStatus = 30 // 已发货
+	OrderCompleted      OrderStatus = 40 // 已完成
+	OrderCanceled       OrderStatus = 50 // 已取消
+)
+
+// Order 评价服务关心的订单信息
+type Order struct {
+	OrderID    int64
+	UserID     int64
+	StoreID    int64
+	SkuID      int64
+	Status     OrderStatus
+	CompleteAt time.Time // 完成时间,未完成时为零值
+}
+
+// Sku 评价服务关心的商品信息
+type Sku struct {
+	SkuID   int64
+	SpuID   int64
+	StoreID int64
+	Title   string
+	Pic     string
+	Price   int64 // 单位:分
+	Attrs   string
+}
+
+// GoodsSnapshot 创建评价时保存的商品快照,序列化后存到goods_snapshoot字段
+type GoodsSnapshot struct {
+	SkuID int64  `json:"sku_id"`
+	SpuID int64  `json:"spu_id"`
+	Title string `json:"title"`
+	Pic   string `json:"pic"`
+	Price int64  `json:"price"`
+	Attrs string `json:"attrs"`
+}
+
+// OrderClient 订单服务客户端
+// 订单不存在时返回 (nil, nil)
+type OrderClient interface {
+	GetOrder(ctx context.Context, orderId int64) (*Order, error)
+}
+
+// OrderSource 已完成订单的来源,默认好评任务从这里扫描订单
+// 返回完成时间在[start, end)之间、订单id大于afterOrderId的订单,按订单id升序,最多limit条
+type OrderSource interface {
+	ListCompletedOrders(ctx context.Context, start, end time.Time, afterOrderId int64, limit int) ([]*Order, error)
+}
+
+// ProductClient 商品服务客户端
+// sku不存在时返回 (nil, nil)
+type ProductClient interface {
+	GetSku(ctx context.Context, skuId int64) (*Sku, error)
+}
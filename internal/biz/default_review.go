@@ -0,0 +1,193 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/pkg/snowflake"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const (
+	defaultReviewDays     = 15
+	defaultReviewLookback = 3
+	defaultReviewInterval = time.Hour
+	defaultReviewBatch    = 100
+	defaultReviewContent  = "评价方未及时做出评价,系统默认好评!"
+	defaultReviewScore    = 5
+	// defaultReviewJobName 默认好评任务的分布式锁名
+	defaultReviewJobName = "default_review"
+)
+
+// CreateDefaultReview 给订单创建一条默认好评
+// 订单已经评价过(包括评价后又删除)、订单不存在或者已经不是完成状态时跳过,返回nil, nil
+// 默认好评是系统生成的,不需要审核,直接审核通过
+func (uc *ReviewUsecase) CreateDefaultReview(ctx context.Context, order *Order, content string) (*model.ReviewInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CreateDefaultReview, order:%+v", order)
+	reviews, err := uc.repo.GetReviewByOrderId(ctx, order.OrderID)
+	if err != nil {
+		return nil, v1.ErrorDbFailed("查询数据库失败").WithCause(err)
+	}
+	if len(reviews) > 0 {
+		return nil, nil
+	}
+	review := &model.ReviewInfo{
+		ReviewID:     snowflake.GenerateID(),
+		UserID:       order.UserID,
+		OrderID:      order.OrderID,
+		Score:        defaultReviewScore,
+		ServiceScore: defaultReviewScore,
+		ExpressScore: defaultReviewScore,
+		Content:      content,
+		IsDefault:    1,
+		Status:       int32(Approved),
+		OpUser:       AutoAuditOpUser,
+	}
+	// 重新查一次订单,扫描之后订单可能已经退款
+	if err := uc.fillGoodsInfo(ctx, review); err != nil {
+		if v1.IsOrderNotFound(err) || v1.IsOrderNotCompleted(err) || v1.IsForbiddenUser(err) {
+			uc.log.WithContext(ctx).Infof("[biz] CreateDefaultReview skip, orderId:%d, err:%v", order.OrderID, err)
+			return nil, nil
+		}
+		return nil, err
+	}
+	created, err := uc.repo.SaveDefaultReview(ctx, review)
+	if err != nil || !created {
+		return nil, err
+	}
+	return review, nil
+}
+
+var _ transport.Server = (*DefaultReviewJob)(nil)
+
+// DefaultReviewJob 定时扫描完成了days天还没有评价的订单,创建默认好评
+// 每次扫描完成时间在[now-days-lookback, now-days)之间的订单,停机期间漏掉的订单下次扫描时补上
+// 多个实例同时运行时,每个扫描周期只有拿到锁的实例执行;同一个订单重复扫描时由CreateDefaultReview去重
+type DefaultReviewJob struct {
+	uc       *ReviewUsecase
+	source   OrderSource
+	log      *log.Helper
+	enable   bool
+	days     time.Duration
+	lookback time.Duration
+	interval time.Duration
+	batch    int
+	content  string
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+// NewDefaultReviewJob source为nil时任务不运行
+func NewDefaultReviewJob(c *conf.Review, uc *ReviewUsecase, source OrderSource, logger log.Logger) *DefaultReviewJob {
+	dc := c.GetDefaultReview()
+	j := &DefaultReviewJob{
+		uc:       uc,
+		source:   source,
+		log:      log.NewHelper(logger),
+		enable:   dc.GetEnable() && source != nil,
+		days:     defaultReviewDays * 24 * time.Hour,
+		lookback: defaultReviewLookback * 24 * time.Hour,
+		interval: defaultReviewInterval,
+		batch:    defaultReviewBatch,
+		content:  defaultReviewContent,
+	}
+	if dc.GetDays() > 0 {
+		j.days = time.Duration(dc.GetDays()) * 24 * time.Hour
+	}
+	if dc.GetLookbackDays() > 0 {
+		j.lookback = time.Duration(dc.GetLookbackDays()) * 24 * time.Hour
+	}
+	if dc.GetInterval() != nil {
+		j.interval = dc.GetInterval().AsDuration()
+	}
+	if dc.GetBatchSize() > 0 {
+		j.batch = int(dc.GetBatchSize())
+	}
+	if dc.GetContent() != "" {
+		j.content = dc.GetContent()
+	}
+	return j
+}
+
+func (j *DefaultReviewJob) Start(ctx context.Context) error {
+	if !j.enable {
+		j.log.Info("[biz] default review job disabled")
+		return nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	j.mu.Lock()
+	j.cancel = cancel
+	j.mu.Unlock()
+	defer cancel()
+
+	j.log.Info("[biz] default review job started")
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		// 锁不释放,到期后下一个扫描周期再竞争,保证每个周期只扫描一次
+		ok, err := j.uc.repo.AcquireJobLock(ctx, defaultReviewJobName, j.interval)
+		if err != nil && ctx.Err() == nil {
+			j.log.WithContext(ctx).Errorf("[biz] default review job lock fail, err:%v", err)
+		}
+		if ok {
+			created, err := j.RunOnce(ctx, time.Now())
+			if err != nil && ctx.Err() == nil {
+				j.log.WithContext(ctx).Errorf("[biz] default review job RunOnce fail, created:%d, err:%v", created, err)
+			} else {
+				j.log.WithContext(ctx).Infof("[biz] default review job done, created:%d", created)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *DefaultReviewJob) Stop(ctx context.Context) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.cancel != nil {
+		j.cancel()
+	}
+	return nil
+}
+
+// RunOnce 扫描一次,返回创建的默认好评数
+// 单个订单失败只记录日志,下次扫描时重试;查询订单失败时结束本次扫描
+func (j *DefaultReviewJob) RunOnce(ctx context.Context, now time.Time) (int, error) {
+	end := now.Add(-j.days)
+	start := end.Add(-j.lookback)
+	var (
+		lastOrderId int64
+		created     int
+	)
+	for {
+		orders, err := j.source.ListCompletedOrders(ctx, start, end, lastOrderId, j.batch)
+		if err != nil {
+			return created, v1.ErrorDependencyFailed("查询已完成订单失败").WithCause(err)
+		}
+		for _, o := range orders {
+			review, err := j.uc.CreateDefaultReview(ctx, o, j.content)
+			if err != nil {
+				j.log.WithContext(ctx).Errorf("[biz] CreateDefaultReview fail, orderId:%d, err:%v", o.OrderID, err)
+				continue
+			}
+			if review != nil {
+				created++
+			}
+		}
+		if len(orders) < j.batch {
+			return created, nil
+		}
+		lastOrderId = orders[len(orders)-1].OrderID
+	}
+}
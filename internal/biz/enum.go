@@ -1,8 +1,46 @@
 package biz
 
+// ReviewStatus 评价状态
+type ReviewStatus int32
+
 const (
-	PendingReview     int32 = 10
-	Approved          int32 = 20
-	ReviewNotApproved int32 = 30
-	Hidden            int32 = 40
+	PendingReview     ReviewStatus = 10 // 待审核
+	Approved          ReviewStatus = 20 // 审核通过
+	ReviewNotApproved ReviewStatus = 30 // 审核不通过
+	Hidden            ReviewStatus = 40 // 隐藏
 )
+
+func (s ReviewStatus) String() string {
+	switch s {
+	case PendingReview:
+		return "待审核"
+	case Approved:
+		return "审核通过"
+	case ReviewNotApproved:
+		return "审核不通过"
+	case Hidden:
+		return "隐藏"
+	}
+	return "未知状态"
+}
+
+// AppealStatus 商家申诉状态
+type AppealStatus int32
+
+const (
+	AppealPending  AppealStatus = 10 // 待审核
+	AppealApproved AppealStatus = 20 // 申诉通过
+	AppealRejected AppealStatus = 30 // 申诉驳回
+)
+
+func (s AppealStatus) String() string {
+	switch s {
+	case AppealPending:
+		return "待审核"
+	case AppealApproved:
+		return "申诉通过"
+	case AppealRejected:
+		return "申诉驳回"
+	}
+	return "未知状态"
+}
@@ -0,0 +1,36 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// 评价变更事件类型
+const (
+	EventReviewCreated       = "review.created"        // 创建评价
+	EventReviewUpdated       = "review.updated"        // 用户修改评价
+	EventReviewDeleted       = "review.deleted"        // 删除评价
+	EventReviewAudited       = "review.audited"        // 运营审核评价
+	EventReviewAutoAudited   = "review.auto_audited"   // 自动审核评价
+	EventReviewReplied       = "review.replied"        // 商家回复评价
+	EventReviewAppealed      = "review.appealed"       // 商家申诉评价
+	EventReviewAppealAudited = "review.appeal_audited" // 运营审核申诉
+	EventReviewAppended      = "review.appended"       // 用户追评
+	EventReviewAppendAudited = "review.append_audited" // 运营审核追评
+)
+
+// ReviewEvent 评价变更事件
+// 和业务数据在同一个事务里写入review_outbox,再由relay投递出去
+type ReviewEvent struct {
+	ID       int64 // outbox主键,消费方可以用来去重
+	ReviewID int64
+	Type     string
+	Payload  []byte // json
+	CreateAt time.Time
+}
+
+// EventPublisher 投递评价变更事件
+// 同一个ReviewID的事件需要按顺序投递,投递失败返回error,由relay重试
+type EventPublisher interface {
+	Publish(ctx context.Context, event *ReviewEvent) error
+}
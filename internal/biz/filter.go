@@ -0,0 +1,205 @@
+package biz
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"review-service/internal/conf"
+)
+
+// 命中敏感词时的处理方式
+const (
+	FilterActionMask   = "mask"   // 把敏感词替换成*
+	FilterActionReview = "review" // 保留原文,转人工审核
+)
+
+// 垃圾内容规则
+const (
+	SpamRepeat = "repeat" // 同一个字符连续重复
+	SpamURL    = "url"    // 包含网址
+	SpamPhone  = "phone"  // 包含手机号
+)
+
+const (
+	defaultReloadInterval = 30 * time.Second
+	// spamRepeatCount 同一个字符连续出现这么多次认为是灌水
+	spamRepeatCount = 6
+)
+
+var (
+	urlPattern   = regexp.MustCompile(`(?i)(https?://|www\.)\S+|[a-z0-9][a-z0-9-]*\.(com|cn|net|org|cc|top|xyz|vip|shop)\b`)
+	phonePattern = regexp.MustCompile(`(^|\D)1[3-9]\d{9}(\D|$)`)
+)
+
+// FilterResult 内容检查结果,会记录到ctrl_json的moderation字段中
+type FilterResult struct {
+	Words  []string `json:"words,omitempty"`  // 命中的敏感词
+	Spam   []string `json:"spam,omitempty"`   // 命中的垃圾内容规则
+	Action string   `json:"action,omitempty"` // 命中敏感词时的处理方式
+	Masked string   `json:"-"`                // 敏感词替换成*之后的内容
+}
+
+// Hit 是否命中了敏感词或者垃圾内容规则
+func (r *FilterResult) Hit() bool {
+	return len(r.Words) > 0 || len(r.Spam) > 0
+}
+
+// NeedReview 是否必须人工审核: 命中垃圾内容规则,或者命中敏感词并且配置为转人工审核
+func (r *FilterResult) NeedReview() bool {
+	return len(r.Spam) > 0 || (len(r.Words) > 0 && r.Action == FilterActionReview)
+}
+
+// ContentFilter 评价和回复内容的敏感词、垃圾内容检查
+// 敏感词来自配置和敏感词文件,文件修改后定时重新加载,加载失败时继续使用旧的词库
+type ContentFilter struct {
+	trie     atomic.Pointer[wordTrie]
+	words    []string
+	file     string
+	modTime  time.Time
+	action   string
+	interval time.Duration
+	log      *log.Helper
+	done     chan struct{}
+}
+
+// NewContentFilter 加载敏感词,配置了敏感词文件时启动定时重新加载
+func NewContentFilter(c *conf.Review, logger log.Logger) (*ContentFilter, func(), error) {
+	mc := c.GetModeration()
+	f := &ContentFilter{
+		words:    mc.GetWords(),
+		file:     mc.GetWordFile(),
+		action:   mc.GetAction(),
+		interval: defaultReloadInterval,
+		log:      log.NewHelper(logger),
+		done:     make(chan struct{}),
+	}
+	if f.action != FilterActionReview {
+		f.action = FilterActionMask
+	}
+	if mc.GetReloadInterval() != nil {
+		f.interval = mc.GetReloadInterval().AsDuration()
+	}
+	if err := f.reload(); err != nil {
+		return nil, nil, err
+	}
+	if f.file != "" {
+		go f.watch()
+	}
+	return f, func() { close(f.done) }, nil
+}
+
+// NewContentFilterWithWords 只使用给定敏感词的检查器,本地调试和单元测试用
+func NewContentFilterWithWords(action string, words ...string) *ContentFilter {
+	f := &ContentFilter{words: words, action: action, log: log.NewHelper(log.DefaultLogger)}
+	f.trie.Store(newWordTrie(words))
+	return f
+}
+
+// reload 重新加载敏感词文件,文件没有修改时不处理
+func (f *ContentFilter) reload() error {
+	words := f.words
+	if f.file != "" {
+		info, err := os.Stat(f.file)
+		if err != nil {
+			return err
+		}
+		if f.trie.Load() != nil && info.ModTime().Equal(f.modTime) {
+			return nil
+		}
+		fileWords, err := loadWordFile(f.file)
+		if err != nil {
+			return err
+		}
+		words = append(append([]string(nil), words...), fileWords...)
+		f.modTime = info.ModTime()
+	}
+	t := newWordTrie(words)
+	f.trie.Store(t)
+	f.log.Infof("[biz] sensitive words loaded, file:%s, count:%d", f.file, t.size)
+	return nil
+}
+
+func (f *ContentFilter) watch() {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-f.done:
+			return
+		case <-ticker.C:
+			if err := f.reload(); err != nil {
+				f.log.Errorf("[biz] reload sensitive words fail, file:%s, err:%v", f.file, err)
+			}
+		}
+	}
+}
+
+// Check 检查内容
+func (f *ContentFilter) Check(content string) *FilterResult {
+	ret := &FilterResult{Masked: content}
+	ret.Words, ret.Masked = f.trie.Load().Match(content)
+	if len(ret.Words) > 0 {
+		ret.Action = f.action
+	}
+	if hasRepeat(content, spamRepeatCount) {
+		ret.Spam = append(ret.Spam, SpamRepeat)
+	}
+	if urlPattern.MatchString(content) {
+		ret.Spam = append(ret.Spam, SpamURL)
+	}
+	if phonePattern.MatchString(content) {
+		ret.Spam = append(ret.Spam, SpamPhone)
+	}
+	return ret
+}
+
+// hasRepeat 是否有同一个字符(空白除外)连续出现n次
+func hasRepeat(s string, n int) bool {
+	var (
+		last  rune
+		count int
+	)
+	for _, r := range s {
+		if r == last && !unicode.IsSpace(r) {
+			count++
+			if count >= n {
+				return true
+			}
+			continue
+		}
+		last, count = r, 1
+	}
+	return false
+}
+
+// setCtrlJSON 把value写到ctrl_json的key字段中,保留其他字段,value为nil时删除key
+func setCtrlJSON(ctrlJSON, key string, value interface{}) (string, error) {
+	ctrl := make(map[string]json.RawMessage)
+	if ctrlJSON != "" {
+		if err := json.Unmarshal([]byte(ctrlJSON), &ctrl); err != nil {
+			return "", err
+		}
+	}
+	if value == nil {
+		delete(ctrl, key)
+	} else {
+		b, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		ctrl[key] = b
+	}
+	if len(ctrl) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(ctrl)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,182 @@
+package biz
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestWordTrieMatch(t *testing.T) {
+	trie := newWordTrie([]string{"加微信", "微信号", "vx", "刷单", "刷单返现", " ", "#"})
+	cases := []struct {
+		name   string
+		text   string
+		words  []string
+		masked string
+	}{
+		{"miss", "味道不错", nil, "味道不错"},
+		// 互相重叠的词都命中,替换范围取并集
+		{"overlap", "加微信号123", []string{"加微信", "微信号"}, "****123"},
+		// 同一位置优先匹配最长的词
+		{"longest", "刷单返现了", []string{"刷单返现"}, "****了"},
+		{"case", "加VX领红包", []string{"VX"}, "加**领红包"},
+		{"full width", "加ＶＸ领红包", []string{"ＶＸ"}, "加**领红包"},
+		{"full width upper", "加Ｖｘ", []string{"Ｖｘ"}, "加**"},
+		// 命中的词按原文写法去重
+		{"dedup", "vx vx VX", []string{"vx", "VX"}, "** ** **"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			words, masked := trie.Match(c.text)
+			if !reflect.DeepEqual(words, c.words) || masked != c.masked {
+				t.Fatalf("Match(%q) = %q, %q, want %q, %q", c.text, words, masked, c.words, c.masked)
+			}
+		})
+	}
+	// 空白的词不会加入词库
+	if trie.size != 6 {
+		t.Fatalf("size = %d, want 6", trie.size)
+	}
+}
+
+func TestContentFilterCheck(t *testing.T) {
+	cases := []struct {
+		name       string
+		action     string
+		content    string
+		words      []string
+		spam       []string
+		needReview bool
+	}{
+		{"clean", FilterActionMask, "味道不错,下次还来", nil, nil, false},
+		{"mask", FilterActionMask, "加微信领红包", []string{"加微信"}, nil, false},
+		{"review", FilterActionReview, "加微信领红包", []string{"加微信"}, nil, true},
+		{"repeat", FilterActionMask, "好好好好好好", nil, []string{SpamRepeat}, true},
+		{"repeat short", FilterActionMask, "好好好好好", nil, nil, false},
+		{"repeat space", FilterActionMask, "好吃      ", nil, nil, false},
+		{"url", FilterActionMask, "详情见 https://a.b/c", nil, []string{SpamURL}, true},
+		{"www", FilterActionMask, "详情见www.example", nil, []string{SpamURL}, true},
+		{"domain", FilterActionMask, "详情见shop.vip", nil, []string{SpamURL}, true},
+		{"phone", FilterActionMask, "电话13812345678", nil, []string{SpamPhone}, true},
+		{"long number", FilterActionMask, "订单号213812345678", nil, nil, false},
+		{"all", FilterActionMask, "加微信13812345678!!!!!!", []string{"加微信"}, []string{SpamRepeat, SpamPhone}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ret := NewContentFilterWithWords(c.action, "加微信").Check(c.content)
+			if !reflect.DeepEqual(ret.Words, c.words) || !reflect.DeepEqual(ret.Spam, c.spam) || ret.NeedReview() != c.needReview {
+				t.Fatalf("Check(%q) = %+v, needReview = %v", c.content, ret, ret.NeedReview())
+			}
+			// 只有mask时才替换敏感词,由调用方决定是否使用Masked
+			if len(c.words) > 0 && ret.Action != c.action {
+				t.Fatalf("action = %q, want %q", ret.Action, c.action)
+			}
+		})
+	}
+}
+
+func TestHasRepeat(t *testing.T) {
+	cases := []struct {
+		s    string
+		n    int
+		want bool
+	}{
+		{"", 3, false},
+		{"aaa", 3, true},
+		{"aab", 3, false},
+		{"abaaa", 3, true},
+		{"aabaa", 3, false},
+		{"哈哈哈", 3, true},
+		{"   ", 3, false},
+	}
+	for _, c := range cases {
+		if got := hasRepeat(c.s, c.n); got != c.want {
+			t.Fatalf("hasRepeat(%q, %d) = %v, want %v", c.s, c.n, got, c.want)
+		}
+	}
+}
+
+func TestSetCtrlJSON(t *testing.T) {
+	cases := []struct {
+		name  string
+		ctrl  string
+		key   string
+		value interface{}
+		want  string
+	}{
+		{"empty", "", "moderation", map[string]int{"a": 1}, `{"moderation":{"a":1}}`},
+		{"keep others", `{"tag":"x"}`, "moderation", 1, `{"moderation":1,"tag":"x"}`},
+		{"replace", `{"moderation":1,"tag":"x"}`, "moderation", 2, `{"moderation":2,"tag":"x"}`},
+		{"delete", `{"moderation":1,"tag":"x"}`, "moderation", nil, `{"tag":"x"}`},
+		{"delete last", `{"moderation":1}`, "moderation", nil, ""},
+		{"delete missing", "", "moderation", nil, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := setCtrlJSON(c.ctrl, c.key, c.value)
+			if err != nil || got != c.want {
+				t.Fatalf("got %q, err = %v, want %q", got, err, c.want)
+			}
+		})
+	}
+	if _, err := setCtrlJSON("not json", "moderation", 1); err == nil {
+		t.Fatal("want error for invalid ctrl_json")
+	}
+}
+
+func writeWords(t *testing.T, file, words string, modTime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(file, []byte(words), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(file, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// 敏感词文件修改后重新加载换成新词库,加载失败时继续使用旧词库
+func TestContentFilterReload(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "words.txt")
+	now := time.Now()
+	writeWords(t, file, "# 注释\n\n加微信\n", now.Add(-time.Hour))
+	f, cleanup, err := NewContentFilter(&conf.Review{Moderation: &conf.Moderation{Words: []string{"刷单"}, WordFile: file}}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	hit := func(content string) bool { return len(f.Check(content).Words) > 0 }
+	if !hit("加微信") || !hit("刷单") || hit("加QQ") {
+		t.Fatal("initial words not loaded")
+	}
+
+	// 文件没有修改时不重新加载
+	old := f.trie.Load()
+	if err := f.reload(); err != nil || f.trie.Load() != old {
+		t.Fatalf("reload without change, err = %v", err)
+	}
+
+	writeWords(t, file, "加QQ\n", now)
+	if err := f.reload(); err != nil {
+		t.Fatal(err)
+	}
+	// 配置中的敏感词一直保留,文件中的词换成新的
+	if hit("加微信") || !hit("刷单") || !hit("加QQ") {
+		t.Fatal("words not swapped after reload")
+	}
+
+	if err := os.Remove(file); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.reload(); err == nil {
+		t.Fatal("want error for missing word file")
+	}
+	if !hit("加QQ") {
+		t.Fatal("old words lost after failed reload")
+	}
+}
@@ -0,0 +1,190 @@
+package biz
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+)
+
+// MediaType 图片或视频
+type MediaType string
+
+const (
+	MediaImage MediaType = "image"
+	MediaVideo MediaType = "video"
+)
+
+const (
+	defaultMaxImages = 9
+	defaultMaxVideos = 1
+)
+
+// MediaItem 评价、回复、申诉中的一张图片或一个视频
+// 图片按JSON数组保存在pic_info中,视频按JSON数组保存在video_info中
+type MediaItem struct {
+	URL      string    `json:"url"`
+	Type     MediaType `json:"type"`
+	Width    int32     `json:"width,omitempty"`
+	Height   int32     `json:"height,omitempty"`
+	Duration int32     `json:"duration,omitempty"` // 视频时长,单位秒
+	Cover    string    `json:"cover,omitempty"`    // 视频封面
+}
+
+// MediaPolicy 图片视频的校验规则: 数量、https地址和允许的域名
+type MediaPolicy struct {
+	hosts     []string
+	maxImages int
+	maxVideos int
+}
+
+func NewMediaPolicy(c *conf.Media) *MediaPolicy {
+	p := &MediaPolicy{
+		hosts:     c.GetAllowedHosts(),
+		maxImages: int(c.GetMaxImages()),
+		maxVideos: int(c.GetMaxVideos()),
+	}
+	if p.maxImages <= 0 {
+		p.maxImages = defaultMaxImages
+	}
+	if p.maxVideos <= 0 {
+		p.maxVideos = defaultMaxVideos
+	}
+	return p
+}
+
+// Validate 校验图片视频,不合法时返回参数错误
+func (p *MediaPolicy) Validate(items []*MediaItem) error {
+	var images, videos int
+	for _, item := range items {
+		switch item.Type {
+		case MediaImage:
+			images++
+		case MediaVideo:
+			videos++
+		default:
+			return v1.ErrorInvalidParam("不支持的媒体类型: %s", item.Type)
+		}
+		if err := p.checkURL(item.URL); err != nil {
+			return err
+		}
+		if item.Cover != "" {
+			if err := p.checkURL(item.Cover); err != nil {
+				return err
+			}
+		}
+	}
+	if images > p.maxImages {
+		return v1.ErrorInvalidParam("最多上传%d张图片", p.maxImages)
+	}
+	if videos > p.maxVideos {
+		return v1.ErrorInvalidParam("最多上传%d个视频", p.maxVideos)
+	}
+	return nil
+}
+
+func (p *MediaPolicy) checkURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return v1.ErrorInvalidParam("图片视频地址不正确: %s", raw)
+	}
+	if u.Scheme != "https" {
+		return v1.ErrorInvalidParam("图片视频地址必须是https: %s", raw)
+	}
+	if !p.allowHost(u.Hostname()) {
+		return v1.ErrorInvalidParam("不允许的图片视频域名: %s", u.Hostname())
+	}
+	return nil
+}
+
+// allowHost 没有配置域名时不限制
+// 以.开头的配置匹配这个域名本身和所有子域名: .example.com 匹配 example.com 和 img.example.com,不匹配 badexample.com
+func (p *MediaPolicy) allowHost(host string) bool {
+	if len(p.hosts) == 0 {
+		return true
+	}
+	host = strings.ToLower(host)
+	for _, h := range p.hosts {
+		h = strings.ToLower(h)
+		if host == h || (strings.HasPrefix(h, ".") && (host == h[1:] || strings.HasSuffix(host, h))) {
+			return true
+		}
+	}
+	return false
+}
+
+// EncodeMedia 把图片和视频分别编码成pic_info和video_info,没有时为空字符串
+func EncodeMedia(items []*MediaItem) (picInfo, videoInfo string, err error) {
+	var images, videos []*MediaItem
+	for _, item := range items {
+		if item.Type == MediaVideo {
+			videos = append(videos, item)
+		} else {
+			images = append(images, item)
+		}
+	}
+	if picInfo, err = marshalMedia(images); err != nil {
+		return "", "", err
+	}
+	if videoInfo, err = marshalMedia(videos); err != nil {
+		return "", "", err
+	}
+	return picInfo, videoInfo, nil
+}
+
+func marshalMedia(items []*MediaItem) (string, error) {
+	if len(items) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(items)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// DecodeMedia 解析pic_info和video_info,图片在前视频在后
+func DecodeMedia(picInfo, videoInfo string) []*MediaItem {
+	items := parseMedia(picInfo, MediaImage)
+	return append(items, parseMedia(videoInfo, MediaVideo)...)
+}
+
+// parseMedia 解析一列的内容,兼容旧数据中的地址JSON数组和逗号分隔的地址
+func parseMedia(raw string, typ MediaType) []*MediaItem {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+	var items []*MediaItem
+	if strings.HasPrefix(raw, "[{") && json.Unmarshal([]byte(raw), &items) == nil {
+		for _, item := range items {
+			if item.Type == "" {
+				item.Type = typ
+			}
+		}
+		return items
+	}
+	var urls []string
+	if !strings.HasPrefix(raw, "[") || json.Unmarshal([]byte(raw), &urls) != nil {
+		urls = strings.Split(raw, ",")
+	}
+	for _, u := range urls {
+		if u = strings.TrimSpace(u); u != "" {
+			items = append(items, &MediaItem{URL: u, Type: typ})
+		}
+	}
+	return items
+}
+
+// mediaURLs 按类型取出图片视频地址
+func mediaURLs(items []*MediaItem, typ MediaType) []string {
+	var urls []string
+	for _, item := range items {
+		if item.Type == typ {
+			urls = append(urls, item.URL)
+		}
+	}
+	return urls
+}
@@ -0,0 +1,122 @@
+package biz_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+)
+
+func image(url string) *biz.MediaItem {
+	return &biz.MediaItem{URL: url, Type: biz.MediaImage}
+}
+
+func TestMediaPolicyValidate(t *testing.T) {
+	policy := biz.NewMediaPolicy(&conf.Media{AllowedHosts: []string{".example.com", "cdn.test.com"}, MaxImages: 2})
+	video := &biz.MediaItem{URL: "https://v.example.com/1.mp4", Type: biz.MediaVideo, Cover: "https://img.example.com/1.jpg"}
+	cases := []struct {
+		name  string
+		items []*biz.MediaItem
+		ok    bool
+	}{
+		{"empty", nil, true},
+		{"subdomain", []*biz.MediaItem{image("https://img.example.com/1.jpg")}, true},
+		{"nested subdomain", []*biz.MediaItem{image("https://a.img.example.com/1.jpg")}, true},
+		// 以.开头的配置也匹配域名本身
+		{"apex", []*biz.MediaItem{image("https://example.com/1.jpg")}, true},
+		{"host case", []*biz.MediaItem{image("https://IMG.Example.COM/1.jpg")}, true},
+		{"exact host", []*biz.MediaItem{image("https://cdn.test.com/1.jpg")}, true},
+		{"exact host no subdomain", []*biz.MediaItem{image("https://a.cdn.test.com/1.jpg")}, false},
+		{"suffix not subdomain", []*biz.MediaItem{image("https://badexample.com/1.jpg")}, false},
+		{"other host", []*biz.MediaItem{image("https://evil.com/1.jpg")}, false},
+		{"userinfo", []*biz.MediaItem{image("https://img.example.com@evil.com/1.jpg")}, false},
+		{"http", []*biz.MediaItem{image("http://img.example.com/1.jpg")}, false},
+		{"no host", []*biz.MediaItem{image("/1.jpg")}, false},
+		{"bad type", []*biz.MediaItem{{URL: "https://img.example.com/1.gif", Type: "gif"}}, false},
+		{"video with cover", []*biz.MediaItem{video}, true},
+		{"bad cover", []*biz.MediaItem{{URL: video.URL, Type: biz.MediaVideo, Cover: "https://evil.com/1.jpg"}}, false},
+		{"max images", []*biz.MediaItem{image("https://example.com/1.jpg"), image("https://example.com/2.jpg")}, true},
+		{"too many images", []*biz.MediaItem{image("https://example.com/1.jpg"), image("https://example.com/2.jpg"), image("https://example.com/3.jpg")}, false},
+		// 视频数量默认最多1个
+		{"too many videos", []*biz.MediaItem{video, video}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := policy.Validate(c.items)
+			if c.ok && err != nil {
+				t.Fatalf("err = %v, want ok", err)
+			}
+			if !c.ok && !v1.IsInvalidParam(err) {
+				t.Fatalf("err = %v, want INVALID_PARAM", err)
+			}
+		})
+	}
+	// 没有配置域名时只校验https
+	if err := biz.NewMediaPolicy(nil).Validate([]*biz.MediaItem{image("https://any.com/1.jpg")}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecodeMedia(t *testing.T) {
+	a, b := "https://img.example.com/a.jpg", "https://img.example.com/b.jpg"
+	v := "https://img.example.com/v.mp4"
+	cases := []struct {
+		name      string
+		picInfo   string
+		videoInfo string
+		want      []*biz.MediaItem
+	}{
+		{"empty", "", " ", nil},
+		{"items", `[{"url":"` + a + `","type":"image","width":100,"height":80}]`, `[{"url":"` + v + `","type":"video","duration":15,"cover":"` + b + `"}]`,
+			[]*biz.MediaItem{{URL: a, Type: biz.MediaImage, Width: 100, Height: 80}, {URL: v, Type: biz.MediaVideo, Duration: 15, Cover: b}}},
+		// 旧数据: 没有type的对象按所在的列补上类型
+		{"items without type", `[{"url":"` + a + `"}]`, `[{"url":"` + v + `"}]`, []*biz.MediaItem{image(a), {URL: v, Type: biz.MediaVideo}}},
+		// 旧数据: 地址JSON数组
+		{"url array", `["` + a + `","` + b + `"]`, `["` + v + `"]`, []*biz.MediaItem{image(a), image(b), {URL: v, Type: biz.MediaVideo}}},
+		// 旧数据: 逗号分隔的地址,忽略空项和空白
+		{"comma separated", a + ", ," + b, v, []*biz.MediaItem{image(a), image(b), {URL: v, Type: biz.MediaVideo}}},
+		{"single url", a, "", []*biz.MediaItem{image(a)}},
+		// 不是合法JSON的数组按逗号分隔处理,不会丢数据
+		{"broken json", `[` + a, "", []*biz.MediaItem{image("[" + a)}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := biz.DecodeMedia(c.picInfo, c.videoInfo)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("got %s, want %s", dumpMedia(got), dumpMedia(c.want))
+			}
+		})
+	}
+}
+
+func TestEncodeMediaRoundTrip(t *testing.T) {
+	items := []*biz.MediaItem{
+		{URL: "https://img.example.com/v.mp4", Type: biz.MediaVideo, Duration: 15},
+		{URL: "https://img.example.com/a.jpg", Type: biz.MediaImage, Width: 100},
+	}
+	picInfo, videoInfo, err := biz.EncodeMedia(items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(picInfo, "a.jpg") || !strings.Contains(videoInfo, "v.mp4") {
+		t.Fatalf("picInfo = %s, videoInfo = %s", picInfo, videoInfo)
+	}
+	// 解码后图片在前视频在后
+	if got := biz.DecodeMedia(picInfo, videoInfo); !reflect.DeepEqual(got, []*biz.MediaItem{items[1], items[0]}) {
+		t.Fatalf("got %s", dumpMedia(got))
+	}
+	if picInfo, videoInfo, err := biz.EncodeMedia(nil); err != nil || picInfo != "" || videoInfo != "" {
+		t.Fatalf("empty media: %q, %q, %v", picInfo, videoInfo, err)
+	}
+}
+
+func dumpMedia(items []*biz.MediaItem) string {
+	var s []string
+	for _, item := range items {
+		s = append(s, item.URL+"|"+string(item.Type))
+	}
+	return "[" + strings.Join(s, ", ") + "]"
+}
@@ -0,0 +1,207 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+)
+
+// Verdict 自动审核结论
+type Verdict string
+
+const (
+	VerdictApprove Verdict = "approve" // 通过
+	VerdictManual  Verdict = "manual"  // 转人工审核
+	VerdictReject  Verdict = "reject"  // 驳回
+)
+
+// severity 结论的严重程度,多个检查项取最严重的结论
+func (v Verdict) severity() int {
+	switch v {
+	case VerdictApprove:
+		return 0
+	case VerdictReject:
+		return 2
+	}
+	return 1
+}
+
+// AutoAuditOpUser 自动审核时记录的审核人
+const AutoAuditOpUser = "system"
+
+const (
+	defaultModerationTimeout = 10 * time.Second
+	defaultMaxRejected       = 3
+)
+
+// Checker 自动审核的一个检查项
+type Checker interface {
+	Name() string
+	Check(ctx context.Context, review *model.ReviewInfo) (Verdict, string, error)
+}
+
+// CheckResult 单个检查项的结果
+type CheckResult struct {
+	Checker string  `json:"checker"`
+	Verdict Verdict `json:"verdict"`
+	Reason  string  `json:"reason,omitempty"`
+}
+
+// ModerationOutcome 自动审核结果,会记录到ctrl_json的auto_audit字段中
+type ModerationOutcome struct {
+	Verdict Verdict       `json:"verdict"`
+	Results []CheckResult `json:"results"`
+}
+
+// Reason 汇总不是通过的检查项的原因
+func (o *ModerationOutcome) Reason() string {
+	reasons := make([]string, 0, len(o.Results))
+	for _, r := range o.Results {
+		if r.Verdict != VerdictApprove && r.Reason != "" {
+			reasons = append(reasons, r.Reason)
+		}
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// ModerationPipeline 按顺序执行检查项,结论取最严重的一个
+// 某一项驳回时不再执行后面的检查项;检查项出错时按转人工审核处理,不会因为依赖的服务故障误放或误杀
+type ModerationPipeline struct {
+	checkers []Checker
+	timeout  time.Duration
+	log      *log.Helper
+}
+
+func NewModerationPipeline(timeout time.Duration, logger log.Logger, checkers ...Checker) *ModerationPipeline {
+	if timeout <= 0 {
+		timeout = defaultModerationTimeout
+	}
+	return &ModerationPipeline{checkers: checkers, timeout: timeout, log: log.NewHelper(logger)}
+}
+
+// NewModerationPipelineFromConf 根据配置组装检查项: 文本检查 -> 图片视频检查 -> 用户信誉
+// 没有开启自动审核时返回nil,评价全部由运营人工审核;media为nil时跳过图片视频检查
+func NewModerationPipelineFromConf(c *conf.Review, filter *ContentFilter, media MediaModerator, repo ReviewRepo, logger log.Logger) *ModerationPipeline {
+	mc := c.GetModeration()
+	if !mc.GetAutoAudit() {
+		return nil
+	}
+	checkers := []Checker{&contentChecker{filter: filter}}
+	if media != nil {
+		checkers = append(checkers, &mediaChecker{media: media})
+	}
+	maxRejected := int64(mc.GetMaxRejected())
+	if maxRejected <= 0 {
+		maxRejected = defaultMaxRejected
+	}
+	checkers = append(checkers, &reputationChecker{repo: repo, maxRejected: maxRejected})
+	return NewModerationPipeline(mc.GetTimeout().AsDuration(), logger, checkers...)
+}
+
+// Run 执行所有检查项
+func (p *ModerationPipeline) Run(ctx context.Context, review *model.ReviewInfo) *ModerationOutcome {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	out := &ModerationOutcome{Verdict: VerdictApprove, Results: make([]CheckResult, 0, len(p.checkers))}
+	for _, c := range p.checkers {
+		verdict, reason, err := c.Check(ctx, review)
+		if err != nil {
+			p.log.WithContext(ctx).Errorf("[biz] moderation checker fail, checker:%s, reviewId:%d, err:%v", c.Name(), review.ReviewID, err)
+			verdict, reason = VerdictManual, fmt.Sprintf("%s检查失败", c.Name())
+		}
+		out.Results = append(out.Results, CheckResult{Checker: c.Name(), Verdict: verdict, Reason: reason})
+		if verdict.severity() > out.Verdict.severity() {
+			out.Verdict = verdict
+		}
+		if verdict == VerdictReject {
+			break
+		}
+	}
+	return out
+}
+
+// contentChecker 文本检查: 命中垃圾内容规则,或者敏感词配置为转人工审核时,转人工审核
+type contentChecker struct {
+	filter *ContentFilter
+}
+
+func (c *contentChecker) Name() string {
+	return "content"
+}
+
+func (c *contentChecker) Check(_ context.Context, review *model.ReviewInfo) (Verdict, string, error) {
+	ret := c.filter.Check(review.Content)
+	if !ret.NeedReview() {
+		return VerdictApprove, "", nil
+	}
+	reasons := make([]string, 0, 2)
+	if len(ret.Spam) > 0 {
+		reasons = append(reasons, "内容疑似垃圾信息: "+strings.Join(ret.Spam, ","))
+	}
+	if len(ret.Words) > 0 && ret.Action == FilterActionReview {
+		reasons = append(reasons, "内容包含敏感词: "+strings.Join(ret.Words, ","))
+	}
+	return VerdictManual, strings.Join(reasons, "; "), nil
+}
+
+// MediaModerator 外部的图片视频审核服务
+type MediaModerator interface {
+	CheckMedia(ctx context.Context, typ MediaType, urls []string) (Verdict, string, error)
+}
+
+// mediaChecker 图片视频检查
+type mediaChecker struct {
+	media MediaModerator
+}
+
+func (c *mediaChecker) Name() string {
+	return "media"
+}
+
+func (c *mediaChecker) Check(ctx context.Context, review *model.ReviewInfo) (Verdict, string, error) {
+	verdict, reasons := VerdictApprove, make([]string, 0, 2)
+	items := DecodeMedia(review.PicInfo, review.VideoInfo)
+	for _, typ := range []MediaType{MediaImage, MediaVideo} {
+		urls := mediaURLs(items, typ)
+		if len(urls) == 0 {
+			continue
+		}
+		v, reason, err := c.media.CheckMedia(ctx, typ, urls)
+		if err != nil {
+			return "", "", err
+		}
+		if v.severity() > verdict.severity() {
+			verdict = v
+		}
+		if v != VerdictApprove && reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+	return verdict, strings.Join(reasons, "; "), nil
+}
+
+// reputationChecker 用户信誉: 被驳回的评价太多时转人工审核
+type reputationChecker struct {
+	repo        ReviewRepo
+	maxRejected int64
+}
+
+func (c *reputationChecker) Name() string {
+	return "reputation"
+}
+
+func (c *reputationChecker) Check(ctx context.Context, review *model.ReviewInfo) (Verdict, string, error) {
+	n, err := c.repo.CountUserReviews(ctx, review.UserID, ReviewNotApproved)
+	if err != nil {
+		return "", "", err
+	}
+	if n >= c.maxRejected {
+		return VerdictManual, fmt.Sprintf("用户已有%d条评价审核不通过", n), nil
+	}
+	return VerdictApprove, "", nil
+}
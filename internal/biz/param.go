@@ -1,22 +1,150 @@
 package biz
 
+import "time"
+
 // ReplyParam 商家回复评价的参数
 type ReplyParam struct {
-	ReviewId  int64
-	StoreId   int64
-	Content   string
-	PicInfo   string
-	VideoInfo string
+	ReviewId int64
+	StoreId  int64
+	Content  string
+	Media    []*MediaItem
 }
 
 type AppealParam struct {
-	AppealId  int64
+	AppealId int64
+	ReviewId int64
+	StoreId  int64
+	Content  string
+	Status   int32
+	Media    []*MediaItem
+	OpUser   string
+	Reason   string
+}
+
+// AuditParam 运营审核评价的参数
+type AuditParam struct {
 	ReviewId  int64
-	StoreId   int64
-	Content   string
 	Status    int32
-	PicInfo   string
-	VideoInfo string
 	OpUser    string
-	Reason    string
+	OpReason  string
+	OpRemarks string
+}
+
+// AutoAuditParam 自动审核结果
+// Version是审核时看到的版本号,期间评价被修改或者已经被人工审核时不更新
+type AutoAuditParam struct {
+	ReviewId int64
+	Version  int32
+	Status   int32
+	OpReason string
+	CtrlJSON string
+}
+
+// UpdateReviewParam 用户修改评价的参数
+type UpdateReviewParam struct {
+	ReviewId     int64
+	UserId       int64
+	Version      int32
+	Score        int32
+	ServiceScore int32
+	ExpressScore int32
+	Content      string
+	Media        []*MediaItem
+	Tags         []string // 用户选择的标签
+}
+
+// AppendParam 用户追评的参数
+type AppendParam struct {
+	ReviewId int64
+	UserId   int64
+	Content  string
+	Media    []*MediaItem
+}
+
+// DeleteReviewParam 删除评价的参数
+// OpUser不为空时表示运营删除,否则只能删除UserId自己的评价
+type DeleteReviewParam struct {
+	ReviewId int64
+	UserId   int64
+	OpUser   string
+}
+
+// 评价列表的排序字段
+const (
+	ListSortByTime  = "time"
+	ListSortByScore = "score"
+)
+
+// ListReviewParam 评价列表的筛选条件,零值表示不限制
+type ListReviewParam struct {
+	UserId    int64
+	SpuId     int64
+	SkuId     int64
+	StoreId   int64
+	Status    *int32
+	MinScore  int32
+	MaxScore  int32
+	HasMedia  *bool
+	HasReply  *bool
+	StartTime time.Time
+	EndTime   time.Time
+	SortBy    string
+	Asc       bool
+	PageToken string // 游标,为空表示第一页
+	Size      int
+	// ExcludeAnonymous 排除匿名评价
+	ExcludeAnonymous bool
+	// Tags 带有所有这些标签的评价
+	Tags []string
+}
+
+// SearchReviewParam 关键词搜索评价的参数,筛选条件零值表示不限制
+type SearchReviewParam struct {
+	Keyword   string
+	StoreId   int64
+	SpuId     int64
+	MinScore  int32
+	MaxScore  int32
+	Status    *int32
+	PageToken string // 游标,为空表示第一页
+	Size      int
+	Tags      []string // 带有所有这些标签
+	// WithOpRemarks 同时搜索运营备注,只有运营可以
+	WithOpRemarks bool
+}
+
+// StoreRatingParam 店铺评分汇总的参数,时间为零值表示不限制
+type StoreRatingParam struct {
+	StoreId   int64
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// 商品评价列表的tab
+const (
+	SpuTabAll      = "all"      // 全部
+	SpuTabMedia    = "media"    // 有图/视频
+	SpuTabPositive = "positive" // 好评: 4-5星
+	SpuTabNeutral  = "neutral"  // 中评: 3星
+	SpuTabNegative = "negative" // 差评: 1-2星
+)
+
+// SpuTabs 所有tab,接口按这个顺序返回各tab的评价数
+var SpuTabs = []string{SpuTabAll, SpuTabMedia, SpuTabPositive, SpuTabNeutral, SpuTabNegative}
+
+// ListReviewBySpuParam 商品评价列表的参数
+type ListReviewBySpuParam struct {
+	SpuId     int64
+	SkuId     int64 // 为0表示spu下所有sku
+	Tab       string
+	Tags      []string // 带有所有这些标签的评价,tab的评价数也只统计这些评价
+	PageToken string   // 游标,为空表示第一页
+	Size      int
+}
+
+// TagCloudParam 标签云的参数,storeId和spuId至少有一个
+type TagCloudParam struct {
+	StoreId int64
+	SpuId   int64
+	Size    int // 返回评价数最多的前几个标签
 }
@@ -2,13 +2,15 @@ package biz
 
 import (
 	"context"
-	"errors"
+	"encoding/json"
 	"fmt"
+	v1 "review-service/api/review/v1"
 	"review-service/pkg/snowflake"
 	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"review-service/internal/conf"
 	"review-service/internal/data/model"
 )
 
@@ -18,34 +20,111 @@ type ReviewRepo interface {
 	SaveReply(ctx context.Context, info *model.ReviewReplyInfo) (*model.ReviewReplyInfo, error)
 	SaveAppeal(ctx context.Context, info *model.ReviewAppealInfo) (*model.ReviewAppealInfo, error)
 	UpdateAppeal(ctx context.Context, info *model.ReviewAppealInfo) error
-	ListReviewByStoreId(ctx context.Context, storeId int64, offset, limit int) ([]*MyReviewInfo, error)
+	// ListReviewByStoreId 分页查询店铺的评价,status为nil时不限制状态,tags不为空时只返回带有所有这些标签的评价
+	ListReviewByStoreId(ctx context.Context, storeId int64, status *int32, tags []string, offset, limit int) ([]*MyReviewInfo, error)
+	GetReview(ctx context.Context, reviewId int64) (*model.ReviewInfo, error)
+	GetReplyByReviewId(ctx context.Context, reviewId int64) (*model.ReviewReplyInfo, error)
+	GetAppealByReviewId(ctx context.Context, reviewId int64) (*model.ReviewAppealInfo, error)
+	UpdateReview(ctx context.Context, review *model.ReviewInfo) error
+	DeleteReview(ctx context.Context, reviewId int64) error
+	ListReview(ctx context.Context, param *ListReviewParam) (*ListReviewResult, error)
+	AuditReview(ctx context.Context, param *AuditParam) error
+	SearchReviews(ctx context.Context, param *SearchReviewParam) (*SearchReviewResult, error)
+	GetStoreRatingSummary(ctx context.Context, param *StoreRatingParam) (*StoreRatingSummary, error)
+	ListReviewBySpu(ctx context.Context, param *ListReviewBySpuParam) (*ListReviewBySpuResult, error)
+	ListReviewByUser(ctx context.Context, userId int64, offset, limit int) ([]*ReviewDetail, int64, error)
+	CountUserReviews(ctx context.Context, userId int64, status ReviewStatus) (int64, error)
+	SaveAutoAudit(ctx context.Context, param *AutoAuditParam) error
+	GetTagCloud(ctx context.Context, param *TagCloudParam) ([]*TagCount, error)
+	// SaveAppend 保存追评,评价已有追评时返回APPEND_ALREADY_EXISTS
+	SaveAppend(ctx context.Context, info *model.ReviewAppendInfo) (*model.ReviewAppendInfo, error)
+	// GetAppendByReviewId 查询评价的追评,没有追评时返回nil
+	GetAppendByReviewId(ctx context.Context, reviewId int64) (*model.ReviewAppendInfo, error)
+	// ListAppendByReviewIds 批量查询评价的追评,reviewId -> 追评
+	ListAppendByReviewIds(ctx context.Context, reviewIds []int64) (map[int64]*model.ReviewAppendInfo, error)
+	AuditAppend(ctx context.Context, param *AuditParam) error
+	// SaveDefaultReview 保存默认好评,订单已经有评价(包括已删除的)时不保存,返回false
+	SaveDefaultReview(ctx context.Context, review *model.ReviewInfo) (bool, error)
+	// AcquireJobLock 定时任务的分布式锁,ttl到期自动释放,拿到锁返回true
+	AcquireJobLock(ctx context.Context, name string, ttl time.Duration) (bool, error)
+	// TakeUploadQuota 占用上传者当天的n个上传配额,超出quota时不占用并返回false
+	TakeUploadQuota(ctx context.Context, owner string, n, quota int64) (bool, error)
 }
 
+// defaultUpdateWindow 没有配置时评价允许修改的时间窗口
+const defaultUpdateWindow = 7 * 24 * time.Hour
+
 type ReviewUsecase struct {
-	repo ReviewRepo
-	log  *log.Helper
+	repo         ReviewRepo
+	order        OrderClient
+	product      ProductClient
+	filter       *ContentFilter
+	moderation   *ModerationPipeline
+	media        *MediaPolicy
+	storage      ObjectStorage
+	upload       *uploadPolicy
+	tags         *TagDict
+	log          *log.Helper
+	updateWindow time.Duration
+	appendWindow time.Duration
 }
 
-func NewReviewUsecase(repo ReviewRepo, logger log.Logger) *ReviewUsecase {
-	return &ReviewUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewReviewUsecase(c *conf.Review, repo ReviewRepo, order OrderClient, product ProductClient, filter *ContentFilter, moderation *ModerationPipeline, storage ObjectStorage, tags *TagDict, logger log.Logger) *ReviewUsecase {
+	uc := &ReviewUsecase{
+		repo:         repo,
+		order:        order,
+		product:      product,
+		filter:       filter,
+		moderation:   moderation,
+		media:        NewMediaPolicy(c.GetMedia()),
+		storage:      storage,
+		upload:       newUploadPolicy(c.GetUpload()),
+		tags:         tags,
+		log:          log.NewHelper(logger),
+		updateWindow: defaultUpdateWindow,
+		appendWindow: defaultAppendWindow,
+	}
+	if c.GetUpdateWindow() != nil {
+		uc.updateWindow = c.GetUpdateWindow().AsDuration()
+	}
+	if c.GetAppendWindow() != nil {
+		uc.appendWindow = c.GetAppendWindow().AsDuration()
+	}
+	return uc
 }
 
 // CreateReview 创建评价
 // 实现业务逻辑的地方
 // service层调用该方法
-func (uc *ReviewUsecase) CreateReview(ctx context.Context, review *model.ReviewInfo) (*model.ReviewInfo, error) {
+// 只有C端用户能创建评价,评价的用户以登录身份为准
+func (uc *ReviewUsecase) CreateReview(ctx context.Context, review *model.ReviewInfo, media []*MediaItem, tags []string) (*model.ReviewInfo, error) {
 	uc.log.WithContext(ctx).Debugf("create review, data:%+v", review)
+	caller, err := requireRole(ctx, RoleUser)
+	if err != nil {
+		return nil, err
+	}
+	review.UserID = caller.UserId
 	// 1. 数据校验
 	// 1.1 参数基础校验: 正常来说不应该放在这一层，你在上一层或者框架层都应该能拦住(validate参数校验)
+	// 图片视频的数量和域名跟配置有关,在这里校验
+	if err := uc.setReviewMedia(ctx, review, media); err != nil {
+		return nil, err
+	}
+	// 用户选择的标签必须在标签词典中,再根据内容自动补充标签
+	tags, err = uc.tags.Resolve(tags, review.Content)
+	if err != nil {
+		return nil, err
+	}
+	review.Tags = EncodeTags(tags)
 
 	// 1.2 参数业务校验: 带业务逻辑的参数校验，比如已经评价过的订单不能再创建评价
 	reviews, err := uc.repo.GetReviewByOrderId(ctx, review.OrderID)
 	if err != nil {
-		return nil, errors.New("查询数据库失败")
+		return nil, v1.ErrorDbFailed("查询数据库失败").WithCause(err)
 	}
 	if len(reviews) > 0 {
 		// 已经评价过
-		return nil, errors.New(fmt.Sprintf("订单:%d已评价", review.OrderID))
+		return nil, v1.ErrorReviewAlreadyExists("订单:%d已评价", review.OrderID)
 	}
 	// 2. 生成review Id
 	// 这里可以使用雪花算法自己生成
@@ -53,38 +132,219 @@ func (uc *ReviewUsecase) CreateReview(ctx context.Context, review *model.ReviewI
 	review.ReviewID = snowflake.GenerateID()
 
 	// 3. 查询订单和商品快照信息
-	// 实际业务场景下就需要查询订单服务和商家服务(比如说通过RPC调用订单服务和商家服务)
+	// 通过RPC调用订单服务和商品服务,校验订单归属并填充店铺、商品信息
+	if err := uc.fillGoodsInfo(ctx, review); err != nil {
+		return nil, err
+	}
+
+	// 4. 敏感词和垃圾内容检查,结果记录到ctrl_json
+	filtered, err := uc.filterContent(ctx, &review.Content, &review.CtrlJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. 拼装数据入库
+	// 新创建的评价都需要先审核
+	review.Status = int32(PendingReview)
+	saved, err := uc.repo.SaveReview(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+
+	// 6. 异步自动审核,不阻塞用户提交
+	// 内容检查已经要求人工审核时不再自动审核,保持待审核状态等运营处理
+	if uc.moderation != nil && !filtered.NeedReview() {
+		r := *saved
+		go uc.autoAudit(context.WithoutCancel(ctx), &r)
+	}
+	return saved, nil
+}
+
+// encodeMedia 校验图片视频并编码成pic_info和video_info,owner是上传者
+func (uc *ReviewUsecase) encodeMedia(ctx context.Context, owner string, items []*MediaItem) (string, string, error) {
+	if err := uc.media.Validate(items); err != nil {
+		return "", "", err
+	}
+	if err := uc.verifyMedia(ctx, owner, items); err != nil {
+		return "", "", err
+	}
+	picInfo, videoInfo, err := EncodeMedia(items)
+	if err != nil {
+		return "", "", v1.ErrorInvalidParam("图片视频格式错误").WithCause(err)
+	}
+	return picInfo, videoInfo, nil
+}
+
+// setReviewMedia 设置评价的图片视频,同时更新has_media
+func (uc *ReviewUsecase) setReviewMedia(ctx context.Context, review *model.ReviewInfo, items []*MediaItem) error {
+	owner := uploadOwner(&Caller{Role: RoleUser, UserId: review.UserID})
+	picInfo, videoInfo, err := uc.encodeMedia(ctx, owner, items)
+	if err != nil {
+		return err
+	}
+	review.PicInfo, review.VideoInfo = picInfo, videoInfo
+	review.HasMedia = 0
+	if len(items) > 0 {
+		review.HasMedia = 1
+	}
+	return nil
+}
+
+// ctrlKeyAutoAudit 自动审核结果在ctrl_json中的字段名
+const ctrlKeyAutoAudit = "auto_audit"
+
+// autoAudit 自动审核新评价,结论是通过或驳回时直接修改评价状态,转人工时保持待审核
+// 审核过程中出错或者进程退出时评价保持待审核,由运营人工审核,不需要补偿
+func (uc *ReviewUsecase) autoAudit(ctx context.Context, review *model.ReviewInfo) {
+	out := uc.moderation.Run(ctx, review)
+	param := &AutoAuditParam{
+		ReviewId: review.ReviewID,
+		Version:  review.Version,
+		Status:   int32(PendingReview),
+	}
+	switch out.Verdict {
+	case VerdictApprove:
+		param.Status = int32(Approved)
+	case VerdictReject:
+		param.Status = int32(ReviewNotApproved)
+		param.OpReason = out.Reason()
+	}
+	ctrl, err := setCtrlJSON(review.CtrlJSON, ctrlKeyAutoAudit, out)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("[biz] autoAudit set ctrl_json fail, reviewId:%d, err:%v", review.ReviewID, err)
+		return
+	}
+	param.CtrlJSON = ctrl
+	if err := uc.repo.SaveAutoAudit(ctx, param); err != nil {
+		uc.log.WithContext(ctx).Errorf("[biz] autoAudit save fail, reviewId:%d, err:%v", review.ReviewID, err)
+		return
+	}
+	uc.log.WithContext(ctx).Infof("[biz] autoAudit done, reviewId:%d, verdict:%s, reason:%s", review.ReviewID, out.Verdict, out.Reason())
+}
 
-	// 4. 拼装数据入库
+// ctrlKeyModeration 内容检查结果在ctrl_json中的字段名
+const ctrlKeyModeration = "moderation"
+
+// filterContent 检查评价内容,按配置替换敏感词,检查结果写到ctrl_json中
+// 没有命中时清掉之前的检查结果(修改评价的场景)
+func (uc *ReviewUsecase) filterContent(ctx context.Context, content, ctrlJSON *string) (*FilterResult, error) {
+	ret := uc.filter.Check(*content)
+	var (
+		value interface{}
+		err   error
+	)
+	if ret.Hit() {
+		if ret.Action == FilterActionMask {
+			*content = ret.Masked
+		}
+		value = ret
+		uc.log.WithContext(ctx).Infof("[biz] review content hit, result:%+v", ret)
+	}
+	if *ctrlJSON, err = setCtrlJSON(*ctrlJSON, ctrlKeyModeration, value); err != nil {
+		return nil, v1.ErrorInvalidParam("ctrl_json格式错误").WithCause(err)
+	}
+	return ret, nil
+}
 
-	return uc.repo.SaveReview(ctx, review)
+// fillGoodsInfo 校验订单属于当前用户并且已完成,然后填充store_id/sku_id/spu_id和商品快照
+func (uc *ReviewUsecase) fillGoodsInfo(ctx context.Context, review *model.ReviewInfo) error {
+	order, err := uc.order.GetOrder(ctx, review.OrderID)
+	if err != nil {
+		return v1.ErrorDependencyFailed("查询订单失败").WithCause(err)
+	}
+	if order == nil {
+		return v1.ErrorOrderNotFound("订单:%d不存在", review.OrderID)
+	}
+	if order.UserID != review.UserID {
+		return v1.ErrorForbiddenUser("水平越权")
+	}
+	if order.Status != OrderCompleted {
+		return v1.ErrorOrderNotCompleted("订单:%d未完成,不能评价", review.OrderID)
+	}
+	sku, err := uc.product.GetSku(ctx, order.SkuID)
+	if err != nil {
+		return v1.ErrorDependencyFailed("查询商品失败").WithCause(err)
+	}
+	if sku == nil {
+		return v1.ErrorDependencyFailed("商品:%d不存在", order.SkuID)
+	}
+	// 商品和订单不属于同一个店铺说明两边数据不一致,不能生成快照
+	if sku.StoreID != order.StoreID {
+		return v1.ErrorDependencyFailed("商品:%d不属于订单:%d的店铺", sku.SkuID, order.OrderID)
+	}
+	snapshot, err := json.Marshal(&GoodsSnapshot{
+		SkuID: sku.SkuID,
+		SpuID: sku.SpuID,
+		Title: sku.Title,
+		Pic:   sku.Pic,
+		Price: sku.Price,
+		Attrs: sku.Attrs,
+	})
+	if err != nil {
+		return err
+	}
+	review.StoreID = order.StoreID
+	review.SkuID = sku.SkuID
+	review.SpuID = sku.SpuID
+	review.GoodsSnapshoot = string(snapshot)
+	return nil
 }
 
 func (uc *ReviewUsecase) CreateReply(ctx context.Context, param *ReplyParam) (*model.ReviewReplyInfo, error) {
 	// 调用data层创建一个评价的回复
 	uc.log.WithContext(ctx).Debugf("[biz] CreateReply, param:%+v", param)
+	// 只有商家能回复,回复的店铺以登录身份为准,data层再校验评价是否属于该店铺
+	caller, err := requireRole(ctx, RoleStore)
+	if err != nil {
+		return nil, err
+	}
+	param.StoreId = caller.StoreId
+	picInfo, videoInfo, err := uc.encodeMedia(ctx, uploadOwner(caller), param.Media)
+	if err != nil {
+		return nil, err
+	}
 	reply := &model.ReviewReplyInfo{
 		ReplyID:   snowflake.GenerateID(),
 		ReviewID:  param.ReviewId,
 		StoreID:   param.StoreId,
 		Content:   param.Content,
-		PicInfo:   param.PicInfo,
-		VideoInfo: param.VideoInfo,
+		PicInfo:   picInfo,
+		VideoInfo: videoInfo,
+	}
+	// 回复没有审核流程,命中的敏感词直接替换掉
+	ret := uc.filter.Check(reply.Content)
+	if ret.Hit() {
+		if len(ret.Words) > 0 {
+			ret.Action = FilterActionMask
+			reply.Content = ret.Masked
+		}
+		if reply.CtrlJSON, err = setCtrlJSON(reply.CtrlJSON, ctrlKeyModeration, ret); err != nil {
+			return nil, v1.ErrorInvalidParam("ctrl_json格式错误").WithCause(err)
+		}
+		uc.log.WithContext(ctx).Infof("[biz] CreateReply content hit, reviewId:%d, result:%+v", param.ReviewId, ret)
 	}
 	return uc.repo.SaveReply(ctx, reply)
 }
 
 func (uc *ReviewUsecase) CreateAppeal(ctx context.Context, param *AppealParam) (*model.ReviewAppealInfo, error) {
 	uc.log.WithContext(ctx).Debugf("[biz] CreateAppeal, param:%+v", param)
+	caller, err := requireRole(ctx, RoleStore)
+	if err != nil {
+		return nil, err
+	}
+	param.StoreId = caller.StoreId
+	picInfo, videoInfo, err := uc.encodeMedia(ctx, uploadOwner(caller), param.Media)
+	if err != nil {
+		return nil, err
+	}
 	appeal := &model.ReviewAppealInfo{
 		ReviewID:  param.ReviewId,
 		StoreID:   param.StoreId,
 		Content:   param.Content,
-		PicInfo:   param.PicInfo,
-		VideoInfo: param.VideoInfo,
-		OpUser:    param.OpUser,
+		PicInfo:   picInfo,
+		VideoInfo: videoInfo,
 		Reason:    param.Reason,
-		Status:    PendingReview,
+		Status:    int32(AppealPending),
 	}
 
 	return uc.repo.SaveAppeal(ctx, appeal)
@@ -93,6 +353,35 @@ func (uc *ReviewUsecase) CreateAppeal(ctx context.Context, param *AppealParam) (
 
 func (uc *ReviewUsecase) UpdateAppeal(ctx context.Context, param *AppealParam) error {
 	uc.log.WithContext(ctx).Debugf("[biz] UpdateAppeal, param:%+v", param)
+	caller, err := requireRole(ctx, RoleOperator)
+	if err != nil {
+		return err
+	}
+	param.OpUser = caller.OpUser
+	to := AppealStatus(param.Status)
+	if to != AppealApproved && to != AppealRejected {
+		return v1.ErrorInvalidParam("申诉审核状态不合法: %d", param.Status)
+	}
+	current, err := uc.repo.GetAppealByReviewId(ctx, param.ReviewId)
+	if err != nil {
+		return err
+	}
+	if current == nil || current.AppealID != param.AppealId {
+		return v1.ErrorAppealNotFound("申诉不存在")
+	}
+	if !AppealStatus(current.Status).CanTransitTo(to) {
+		return v1.ErrorAppealAlreadyAudited("申诉已被审核")
+	}
+	// 申诉通过后评价需要隐藏,先确认评价当前状态允许隐藏
+	if to == AppealApproved {
+		review, err := uc.repo.GetReview(ctx, param.ReviewId)
+		if err != nil {
+			return err
+		}
+		if _, err := ReviewStatus(review.Status).TransitTo(Hidden); err != nil {
+			return err
+		}
+	}
 	appeal := &model.ReviewAppealInfo{
 		AppealID: param.AppealId,
 		ReviewID: param.ReviewId,
@@ -103,7 +392,37 @@ func (uc *ReviewUsecase) UpdateAppeal(ctx context.Context, param *AppealParam) e
 	return uc.repo.UpdateAppeal(ctx, appeal)
 }
 
-func (uc *ReviewUsecase) ListReviewByStoreId(ctx context.Context, storeId int64, page, size int) ([]*MyReviewInfo, error) {
+// AuditReview 运营审核评价
+// 只有待审核的评价才能审核,审核结果只能是通过或者不通过
+func (uc *ReviewUsecase) AuditReview(ctx context.Context, param *AuditParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] AuditReview, param:%+v", param)
+	caller, err := requireRole(ctx, RoleOperator)
+	if err != nil {
+		return err
+	}
+	param.OpUser = caller.OpUser
+	to := ReviewStatus(param.Status)
+	if to != Approved && to != ReviewNotApproved {
+		return v1.ErrorInvalidParam("审核状态不合法: %d", param.Status)
+	}
+	if to == ReviewNotApproved && param.OpReason == "" {
+		return v1.ErrorInvalidParam("审核不通过时需要填写原因")
+	}
+	review, err := uc.repo.GetReview(ctx, param.ReviewId)
+	if err != nil {
+		return err
+	}
+	// 审核只能从待审核状态发起
+	if ReviewStatus(review.Status) != PendingReview {
+		return newTransitionError("评价", ReviewStatus(review.Status).String(), to.String())
+	}
+	if _, err := ReviewStatus(review.Status).TransitTo(to); err != nil {
+		return err
+	}
+	return uc.repo.AuditReview(ctx, param)
+}
+
+func (uc *ReviewUsecase) ListReviewByStoreId(ctx context.Context, storeId int64, tags []string, page, size int) ([]*MyReviewInfo, error) {
 	uc.log.WithContext(ctx).Debugf("[biz] ListReviewByStoreId")
 	if page <= 0 {
 		page = 1
@@ -113,9 +432,356 @@ func (uc *ReviewUsecase) ListReviewByStoreId(ctx context.Context, storeId int64,
 	}
 	offset := (page - 1) * size
 	limit := size
+	// 店铺评价页和其他列表一样按调用方限制范围,除运营以外只能看到审核通过的评价
+	storeId, status := scopeByCaller(CallerFromContext(ctx), storeId, nil)
 	uc.log.WithContext(ctx).Debugf("[biz] ListReviewByStoreId:%v", storeId)
-	return uc.repo.ListReviewByStoreId(ctx, storeId, offset, limit)
+	list, err := uc.repo.ListReviewByStoreId(ctx, storeId, status, tags, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillAppends(ctx, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+
+}
+
+// ListReviewByUser 用户查询自己的评价
+// 直接查MySQL(idx_user_id),刚提交还在审核中的评价也要能马上看到,不能等ES同步
+// 用户看自己的评价,匿名评价也不需要隐藏用户信息
+// 用户只能查自己的评价,传入的userId被忽略;运营可以查任意用户
+func (uc *ReviewUsecase) ListReviewByUser(ctx context.Context, userId int64, page, size int) ([]*ReviewDetail, int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListReviewByUser, userId:%d, page:%d, size:%d", userId, page, size)
+	caller, err := requireRole(ctx, RoleUser, RoleOperator)
+	if err != nil {
+		return nil, 0, err
+	}
+	if caller.Role == RoleUser {
+		userId = caller.UserId
+	}
+	if userId <= 0 {
+		return nil, 0, v1.ErrorInvalidParam("userId不能为空")
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 || size > 50 {
+		size = 10
+	}
+	list, total, err := uc.repo.ListReviewByUser(ctx, userId, (page-1)*size, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := uc.fillDetailAppends(ctx, list); err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+// GetReview 根据评价Id查询评价详情
+// 直接查MySQL,同时带出商家回复、申诉记录和追评
+// 没有审核通过的评价只有作者、评价所属的店铺和运营能看到,其他人按评价不存在处理
+// 申诉记录只有评价所属的店铺和运营能看到
+func (uc *ReviewUsecase) GetReview(ctx context.Context, reviewId int64) (*ReviewDetail, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetReview, reviewId:%d", reviewId)
+	review, err := uc.repo.GetReview(ctx, reviewId)
+	if err != nil {
+		return nil, err
+	}
+	caller := CallerFromContext(ctx)
+	if ReviewStatus(review.Status) != Approved && !isReviewParty(caller, review) {
+		return nil, v1.ErrorReviewNotFound("评价:%d不存在", reviewId)
+	}
+	reply, err := uc.repo.GetReplyByReviewId(ctx, reviewId)
+	if err != nil {
+		return nil, err
+	}
+	var appeal *model.ReviewAppealInfo
+	if caller.Role == RoleOperator || (caller.Role == RoleStore && caller.StoreId == review.StoreID) {
+		if appeal, err = uc.repo.GetAppealByReviewId(ctx, reviewId); err != nil {
+			return nil, err
+		}
+	}
+	info, err := uc.repo.GetAppendByReviewId(ctx, reviewId)
+	if err != nil {
+		return nil, err
+	}
+	return &ReviewDetail{
+		Review: review,
+		Reply:  reply,
+		Appeal: appeal,
+		Append: visibleAppend(caller, info),
+	}, nil
+}
+
+// isReviewParty 调用方是评价的作者、评价所属的店铺或者运营
+func isReviewParty(caller *Caller, review *model.ReviewInfo) bool {
+	switch caller.Role {
+	case RoleOperator:
+		return true
+	case RoleUser:
+		return caller.UserId == review.UserID
+	case RoleStore:
+		return caller.StoreId == review.StoreID
+	}
+	return false
+}
+
+// UpdateReview 用户修改评价
+// 只能修改自己的评价,且只能在创建后的一段时间内修改
+// 通过version字段做乐观锁,并发修改时只有一个能成功
+func (uc *ReviewUsecase) UpdateReview(ctx context.Context, param *UpdateReviewParam) (*model.ReviewInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] UpdateReview, param:%+v", param)
+	caller, err := requireRole(ctx, RoleUser)
+	if err != nil {
+		return nil, err
+	}
+	param.UserId = caller.UserId
+	review, err := uc.repo.GetReview(ctx, param.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+	// 水平越权校验(用户只能修改自己的评价)
+	if review.UserID != param.UserId {
+		return nil, v1.ErrorForbiddenUser("水平越权")
+	}
+	// 修改后的评价需要重新审核
+	status, err := ReviewStatus(review.Status).TransitTo(PendingReview)
+	if err != nil {
+		return nil, err
+	}
+	if time.Since(review.CreateAt) > uc.updateWindow {
+		return nil, v1.ErrorUpdateWindowExpired("评价已超过可修改期限")
+	}
+	review.Version = param.Version
+	review.Score = param.Score
+	review.ServiceScore = param.ServiceScore
+	review.ExpressScore = param.ExpressScore
+	review.Content = param.Content
+	if err := uc.setReviewMedia(ctx, review, param.Media); err != nil {
+		return nil, err
+	}
+	// 内容变了,按修改后的内容重新打标签
+	tags, err := uc.tags.Resolve(param.Tags, review.Content)
+	if err != nil {
+		return nil, err
+	}
+	review.Tags = EncodeTags(tags)
+	review.Status = int32(status)
+	filtered, err := uc.filterContent(ctx, &review.Content, &review.CtrlJSON)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateReview(ctx, review); err != nil {
+		return nil, err
+	}
+	review.Version++
+	// 和创建评价一样异步自动审核修改后的内容,按修改后的版本号写回审核结果
+	if uc.moderation != nil && !filtered.NeedReview() {
+		r := *review
+		go uc.autoAudit(context.WithoutCancel(ctx), &r)
+	}
+	return review, nil
+}
+
+// DeleteReview 删除评价(逻辑删除)
+// 用户只能删除自己的评价,运营可以删除任意评价
+func (uc *ReviewUsecase) DeleteReview(ctx context.Context, param *DeleteReviewParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] DeleteReview, param:%+v", param)
+	caller, err := requireRole(ctx, RoleUser, RoleOperator)
+	if err != nil {
+		return err
+	}
+	param.UserId, param.OpUser = caller.UserId, caller.OpUser
+	review, err := uc.repo.GetReview(ctx, param.ReviewId)
+	if err != nil {
+		return err
+	}
+	if param.OpUser == "" && review.UserID != param.UserId {
+		return v1.ErrorForbiddenUser("水平越权")
+	}
+	return uc.repo.DeleteReview(ctx, param.ReviewId)
+}
+
+// ListReview 多条件筛选评价列表
+// 走ES查询,使用search_after游标分页,避免深分页超过ES的max_result_window
+func (uc *ReviewUsecase) ListReview(ctx context.Context, param *ListReviewParam) (*ListReviewResult, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListReview, param:%+v", param)
+	if param.Size <= 0 || param.Size > 50 {
+		param.Size = 10
+	}
+	if param.SortBy == "" {
+		param.SortBy = ListSortByTime
+	}
+	if param.MinScore > 0 && param.MaxScore > 0 && param.MinScore > param.MaxScore {
+		return nil, v1.ErrorInvalidParam("评分范围不合法")
+	}
+	if !param.StartTime.IsZero() && !param.EndTime.IsZero() && param.StartTime.After(param.EndTime) {
+		return nil, v1.ErrorInvalidParam("时间范围不合法")
+	}
+	// 用户按自己的userId筛选时可以看到自己所有状态的评价
+	caller := CallerFromContext(ctx)
+	if !(caller.Role == RoleUser && caller.UserId == param.UserId) {
+		param.StoreId, param.Status = scopeByCaller(caller, param.StoreId, param.Status)
+	}
+	ret, err := uc.repo.ListReview(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillAppends(ctx, ret.List); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SearchReviews 关键词搜索评价
+// 运营备注只有运营能搜索
+func (uc *ReviewUsecase) SearchReviews(ctx context.Context, param *SearchReviewParam) (*SearchReviewResult, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] SearchReviews, param:%+v", param)
+	param.Keyword = strings.TrimSpace(param.Keyword)
+	if param.Keyword == "" {
+		return nil, v1.ErrorInvalidParam("搜索关键词不能为空")
+	}
+	if param.Size <= 0 || param.Size > 50 {
+		param.Size = 10
+	}
+	if param.MinScore > 0 && param.MaxScore > 0 && param.MinScore > param.MaxScore {
+		return nil, v1.ErrorInvalidParam("评分范围不合法")
+	}
+	caller := CallerFromContext(ctx)
+	param.StoreId, param.Status = scopeByCaller(caller, param.StoreId, param.Status)
+	param.WithOpRemarks = caller.Role == RoleOperator
+	ret, err := uc.repo.SearchReviews(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*MyReviewInfo, 0, len(ret.List))
+	for _, v := range ret.List {
+		list = append(list, v.Review)
+	}
+	if err := uc.fillAppends(ctx, list); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// scopeByCaller 按调用方角色限制ES查询的范围,返回实际使用的storeId和status
+// 运营不限制;商家只能查自己店铺的评价;除运营以外都只能查审核通过的评价(隐藏、待审核、审核不通过的都查不到)
+func scopeByCaller(caller *Caller, storeId int64, status *int32) (int64, *int32) {
+	if caller.Role == RoleOperator {
+		return storeId, status
+	}
+	if caller.Role == RoleStore {
+		storeId = caller.StoreId
+	}
+	approved := int32(Approved)
+	return storeId, &approved
+}
+
+// GetStoreRatingSummary 店铺评分汇总
+// 优先用ES聚合,ES不可用时repo会降级到MySQL
+func (uc *ReviewUsecase) GetStoreRatingSummary(ctx context.Context, param *StoreRatingParam) (*StoreRatingSummary, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetStoreRatingSummary, param:%+v", param)
+	if !param.StartTime.IsZero() && !param.EndTime.IsZero() && param.StartTime.After(param.EndTime) {
+		return nil, v1.ErrorInvalidParam("时间范围不合法")
+	}
+	return uc.repo.GetStoreRatingSummary(ctx, param)
+}
+
+// ListReviewBySpu 商品详情页的评价列表,只展示审核通过的评价
+func (uc *ReviewUsecase) ListReviewBySpu(ctx context.Context, param *ListReviewBySpuParam) (*ListReviewBySpuResult, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListReviewBySpu, param:%+v", param)
+	if param.Tab == "" {
+		param.Tab = SpuTabAll
+	}
+	if param.Size <= 0 || param.Size > 50 {
+		param.Size = 10
+	}
+	ret, err := uc.repo.ListReviewBySpu(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillAppends(ctx, ret.List); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetTagCloud 店铺或者商品的标签云,只统计审核通过的评价
+func (uc *ReviewUsecase) GetTagCloud(ctx context.Context, param *TagCloudParam) ([]*TagCount, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetTagCloud, param:%+v", param)
+	if param.StoreId <= 0 && param.SpuId <= 0 {
+		return nil, v1.ErrorInvalidParam("storeId和spuId不能都为空")
+	}
+	if param.Size <= 0 || param.Size > 50 {
+		param.Size = 20
+	}
+	return uc.repo.GetTagCloud(ctx, param)
+}
+
+// SearchReviewHit 搜索命中的评价
+// Highlight 字段名 -> 高亮片段,只包含命中了关键词的字段
+type SearchReviewHit struct {
+	Review    *MyReviewInfo
+	Highlight map[string][]string
+}
+
+// SearchReviewResult 搜索评价的结果
+type SearchReviewResult struct {
+	List          []*SearchReviewHit
+	Total         int64
+	NextPageToken string // 为空表示没有下一页
+}
+
+// StoreRatingSummary 店铺评分汇总,平均分在没有评价时为0
+// ScoreCount 星级(1-5) -> 评价数
+type StoreRatingSummary struct {
+	StoreId         int64
+	Total           int64
+	AvgScore        float64
+	AvgServiceScore float64
+	AvgExpressScore float64
+	ScoreCount      map[int32]int64
+	MediaCount      int64
+	ReplyCount      int64
+}
+
+// ReplyRate 回复率
+func (s *StoreRatingSummary) ReplyRate() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.ReplyCount) / float64(s.Total)
+}
+
+// TagCount 标签和带这个标签的评价数
+type TagCount struct {
+	Tag   string
+	Count int64
+}
+
+// ListReviewBySpuResult 商品评价列表的查询结果
+// TabCounts tab -> 评价数,不受当前选中的tab影响
+type ListReviewBySpuResult struct {
+	List          []*MyReviewInfo
+	TabCounts     map[string]int64
+	NextPageToken string // 为空表示没有下一页
+}
+
+// ListReviewResult 评价列表的查询结果
+type ListReviewResult struct {
+	List          []*MyReviewInfo
+	Total         int64
+	NextPageToken string // 为空表示没有下一页
+}
 
+// ReviewDetail 评价详情: 评价本身 + 商家回复 + 商家申诉 + 追评
+// 没有回复、申诉、追评或者追评对调用方不可见时对应字段为nil
+type ReviewDetail struct {
+	Review *model.ReviewInfo
+	Reply  *model.ReviewReplyInfo
+	Appeal *model.ReviewAppealInfo
+	Append *model.ReviewAppendInfo
 }
 
 type MyReviewInfo struct {
@@ -138,6 +804,9 @@ type MyReviewInfo struct {
 	SpuID        int64  `json:"spu_id,string"`
 	StoreID      int64  `json:"store_id,string"`
 	UserID       int64  `json:"user_id,string"`
+	Tags         Tags   `json:"tags"`
+	// Append 追评,不在ES文档中,查询后从MySQL补上
+	Append *model.ReviewAppendInfo `json:"-"`
 }
 
 type MyTime time.Time
@@ -0,0 +1,265 @@
+package biz_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/biz/biztest"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/pkg/snowflake"
+)
+
+func init() {
+	if err := snowflake.InitSnowflake(1); err != nil {
+		panic(err)
+	}
+}
+
+// memRepo 内存版ReviewRepo,只实现用例测试用到的方法,其他方法调用时panic
+type memRepo struct {
+	biz.ReviewRepo
+
+	mu      sync.Mutex
+	reviews map[int64]*model.ReviewInfo
+	appends map[int64]*model.ReviewAppendInfo // reviewId -> 追评
+	// 最近一次ES查询的参数
+	listParam   *biz.ListReviewParam
+	searchParam *biz.SearchReviewParam
+	storeParam  *biz.ListReviewParam
+}
+
+func (r *memRepo) ListReviewByStoreId(_ context.Context, storeId int64, status *int32, tags []string, _, _ int) ([]*biz.MyReviewInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.storeParam = &biz.ListReviewParam{StoreId: storeId, Status: status, Tags: tags}
+	return nil, nil
+}
+
+func (r *memRepo) ListReview(_ context.Context, param *biz.ListReviewParam) (*biz.ListReviewResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.listParam = param
+	return &biz.ListReviewResult{}, nil
+}
+
+func (r *memRepo) ListReviewByUser(_ context.Context, userId int64, _, _ int) ([]*biz.ReviewDetail, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ret []*biz.ReviewDetail
+	for _, v := range r.reviews {
+		if v.UserID == userId {
+			review := *v
+			ret = append(ret, &biz.ReviewDetail{Review: &review})
+		}
+	}
+	return ret, int64(len(ret)), nil
+}
+
+func (r *memRepo) ListAppendByReviewIds(_ context.Context, reviewIds []int64) (map[int64]*model.ReviewAppendInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret := make(map[int64]*model.ReviewAppendInfo)
+	for _, id := range reviewIds {
+		if v, ok := r.appends[id]; ok {
+			info := *v
+			ret[id] = &info
+		}
+	}
+	return ret, nil
+}
+
+func (r *memRepo) SearchReviews(_ context.Context, param *biz.SearchReviewParam) (*biz.SearchReviewResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.searchParam = param
+	return &biz.SearchReviewResult{}, nil
+}
+
+func newMemRepo() *memRepo {
+	return &memRepo{reviews: make(map[int64]*model.ReviewInfo), appends: make(map[int64]*model.ReviewAppendInfo)}
+}
+
+func (r *memRepo) SaveReview(_ context.Context, review *model.ReviewInfo) (*model.ReviewInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if review.CreateAt.IsZero() {
+		review.CreateAt = time.Now()
+	}
+	v := *review
+	r.reviews[review.ReviewID] = &v
+	return review, nil
+}
+
+func (r *memRepo) UpdateReview(_ context.Context, review *model.ReviewInfo) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	v := *review
+	v.Version++
+	r.reviews[review.ReviewID] = &v
+	return nil
+}
+
+func (r *memRepo) GetReviewByOrderId(_ context.Context, orderId int64) ([]*model.ReviewInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ret []*model.ReviewInfo
+	for _, v := range r.reviews {
+		if v.OrderID == orderId {
+			ret = append(ret, v)
+		}
+	}
+	return ret, nil
+}
+
+func (r *memRepo) GetReview(_ context.Context, reviewId int64) (*model.ReviewInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	v, ok := r.reviews[reviewId]
+	if !ok {
+		return nil, v1.ErrorReviewNotFound("评价:%d不存在", reviewId)
+	}
+	ret := *v
+	return &ret, nil
+}
+
+type testEnv struct {
+	uc      *biz.ReviewUsecase
+	repo    *memRepo
+	orders  *biztest.FakeOrderClient
+	product *biztest.FakeProductClient
+}
+
+func newTestEnv(t *testing.T, c *conf.Review) *testEnv {
+	t.Helper()
+	if c == nil {
+		c = &conf.Review{}
+	}
+	tags, err := biz.NewTagDict(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := &testEnv{
+		repo:    newMemRepo(),
+		orders:  biztest.NewFakeOrderClient(),
+		product: biztest.NewFakeProductClient(),
+	}
+	env.uc = biz.NewReviewUsecase(c, env.repo, env.orders, env.product,
+		biz.NewContentFilterWithWords(biz.FilterActionMask), nil, nil, tags, log.DefaultLogger)
+	return env
+}
+
+func userCtx(userId int64) context.Context {
+	return biz.NewCallerContext(context.Background(), &biz.Caller{Role: biz.RoleUser, UserId: userId})
+}
+
+func TestCreateReviewFillsGoodsInfo(t *testing.T) {
+	env := newTestEnv(t, nil)
+	env.orders.Put(&biz.Order{OrderID: 1, UserID: 9, StoreID: 3, SkuID: 20, Status: biz.OrderCompleted})
+	env.product.Put(&biz.Sku{SkuID: 20, SpuID: 200, StoreID: 3, Title: "机械键盘", Pic: "https://img/k.jpg", Price: 29900, Attrs: "红轴"})
+
+	review, err := env.uc.CreateReview(userCtx(9), &model.ReviewInfo{UserID: 9, OrderID: 1, Score: 5, Content: "手感很好,物流也快"}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if review.StoreID != 3 || review.SkuID != 20 || review.SpuID != 200 {
+		t.Fatalf("goods info = store:%d sku:%d spu:%d", review.StoreID, review.SkuID, review.SpuID)
+	}
+	if review.Status != int32(biz.PendingReview) {
+		t.Fatalf("status = %d, want pending", review.Status)
+	}
+	var snapshot biz.GoodsSnapshot
+	if err := json.Unmarshal([]byte(review.GoodsSnapshoot), &snapshot); err != nil {
+		t.Fatal(err)
+	}
+	want := biz.GoodsSnapshot{SkuID: 20, SpuID: 200, Title: "机械键盘", Pic: "https://img/k.jpg", Price: 29900, Attrs: "红轴"}
+	if snapshot != want {
+		t.Fatalf("snapshot = %+v, want %+v", snapshot, want)
+	}
+	if _, err := env.repo.GetReview(context.Background(), review.ReviewID); err != nil {
+		t.Fatalf("review not saved: %v", err)
+	}
+}
+
+func TestCreateReviewRejectsInvalidOrder(t *testing.T) {
+	env := newTestEnv(t, nil)
+	env.orders.Put(&biz.Order{OrderID: 1, UserID: 9, StoreID: 3, SkuID: 20, Status: biz.OrderCompleted})
+	env.orders.Put(&biz.Order{OrderID: 2, UserID: 9, StoreID: 3, SkuID: 20, Status: biz.OrderShipped})
+	env.orders.Put(&biz.Order{OrderID: 3, UserID: 9, StoreID: 3, SkuID: 21, Status: biz.OrderCompleted})
+	env.orders.Put(&biz.Order{OrderID: 4, UserID: 9, StoreID: 3, SkuID: 22, Status: biz.OrderCompleted})
+	env.product.Put(&biz.Sku{SkuID: 20, SpuID: 200, StoreID: 3})
+	// 商品服务里sku属于另一个店铺,和订单对不上
+	env.product.Put(&biz.Sku{SkuID: 21, SpuID: 210, StoreID: 4})
+
+	cases := []struct {
+		name    string
+		userId  int64
+		orderId int64
+		is      func(error) bool
+	}{
+		{"other user's order", 8, 1, v1.IsForbiddenUser},
+		{"order not found", 9, 99, v1.IsOrderNotFound},
+		{"order not completed", 9, 2, v1.IsOrderNotCompleted},
+		{"sku store mismatch", 9, 3, v1.IsDependencyFailed},
+		{"sku not found", 9, 4, v1.IsDependencyFailed},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := env.uc.CreateReview(userCtx(c.userId), &model.ReviewInfo{UserID: c.userId, OrderID: c.orderId, Score: 5, Content: "手感很好,物流也快"}, nil, nil)
+			if !c.is(err) {
+				t.Fatalf("err = %v", err)
+			}
+		})
+	}
+	if n := len(env.repo.reviews); n != 0 {
+		t.Fatalf("saved %d reviews, want 0", n)
+	}
+}
+
+func TestCreateReviewOnlyOncePerOrder(t *testing.T) {
+	env := newTestEnv(t, nil)
+	env.orders.Put(&biz.Order{OrderID: 1, UserID: 9, StoreID: 3, SkuID: 20, Status: biz.OrderCompleted})
+	env.product.Put(&biz.Sku{SkuID: 20, SpuID: 200, StoreID: 3})
+	review := func() error {
+		_, err := env.uc.CreateReview(userCtx(9), &model.ReviewInfo{UserID: 9, OrderID: 1, Score: 5, Content: "手感很好,物流也快"}, nil, nil)
+		return err
+	}
+	if err := review(); err != nil {
+		t.Fatal(err)
+	}
+	if err := review(); !v1.IsReviewAlreadyExists(err) {
+		t.Fatalf("err = %v, want REVIEW_ALREADY_EXISTS", err)
+	}
+}
+
+func TestCreateReviewUsesCaller(t *testing.T) {
+	env := newTestEnv(t, nil)
+	env.orders.Put(&biz.Order{OrderID: 1, UserID: 9, StoreID: 3, SkuID: 20, Status: biz.OrderCompleted})
+	env.product.Put(&biz.Sku{SkuID: 20, SpuID: 200, StoreID: 3})
+	newReview := func() *model.ReviewInfo {
+		// 请求里带的userId不可信
+		return &model.ReviewInfo{UserID: 8, OrderID: 1, Score: 5, Content: "手感很好,物流也快"}
+	}
+
+	ctx := biz.NewCallerContext(context.Background(), &biz.Caller{Role: biz.RoleStore, StoreId: 3})
+	if _, err := env.uc.CreateReview(ctx, newReview(), nil, nil); !v1.IsPermissionDenied(err) {
+		t.Fatalf("store: err = %v, want PERMISSION_DENIED", err)
+	}
+	if _, err := env.uc.CreateReview(context.Background(), newReview(), nil, nil); !v1.IsUnauthorized(err) {
+		t.Fatalf("public: err = %v, want UNAUTHORIZED", err)
+	}
+	review, err := env.uc.CreateReview(userCtx(9), newReview(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if review.UserID != 9 {
+		t.Fatalf("userId = %d, want caller 9", review.UserID)
+	}
+}
@@ -0,0 +1,159 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+)
+
+func callerCtx(c *biz.Caller) context.Context {
+	return biz.NewCallerContext(context.Background(), c)
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func statusOf(p *int32) int32 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+func TestSearchReviewsScopedByCaller(t *testing.T) {
+	pending := int32(biz.PendingReview)
+	cases := []struct {
+		name          string
+		caller        *biz.Caller
+		wantStore     int64
+		wantStatus    int32
+		withOpRemarks bool
+	}{
+		{"public", &biz.Caller{Role: biz.RolePublic}, 7, int32(biz.Approved), false},
+		{"user", &biz.Caller{Role: biz.RoleUser, UserId: 9}, 7, int32(biz.Approved), false},
+		{"store", &biz.Caller{Role: biz.RoleStore, StoreId: 3}, 3, int32(biz.Approved), false},
+		{"operator", &biz.Caller{Role: biz.RoleOperator, OpUser: "op"}, 7, pending, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			env := newTestEnv(t, nil)
+			_, err := env.uc.SearchReviews(callerCtx(c.caller), &biz.SearchReviewParam{
+				Keyword: "物流",
+				StoreId: 7,
+				Status:  int32Ptr(pending),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			p := env.repo.searchParam
+			if p.StoreId != c.wantStore || statusOf(p.Status) != c.wantStatus || p.WithOpRemarks != c.withOpRemarks {
+				t.Fatalf("param = store:%d status:%d withOpRemarks:%v", p.StoreId, statusOf(p.Status), p.WithOpRemarks)
+			}
+		})
+	}
+}
+
+func TestListReviewScopedByCaller(t *testing.T) {
+	hidden := int32(biz.Hidden)
+	cases := []struct {
+		name       string
+		caller     *biz.Caller
+		userId     int64
+		wantStore  int64
+		wantStatus int32
+	}{
+		{"public", &biz.Caller{Role: biz.RolePublic}, 0, 7, int32(biz.Approved)},
+		{"other user", &biz.Caller{Role: biz.RoleUser, UserId: 9}, 8, 7, int32(biz.Approved)},
+		{"own reviews", &biz.Caller{Role: biz.RoleUser, UserId: 9}, 9, 7, hidden},
+		{"store", &biz.Caller{Role: biz.RoleStore, StoreId: 3}, 0, 3, int32(biz.Approved)},
+		{"operator", &biz.Caller{Role: biz.RoleOperator, OpUser: "op"}, 0, 7, hidden},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			env := newTestEnv(t, nil)
+			_, err := env.uc.ListReview(callerCtx(c.caller), &biz.ListReviewParam{
+				UserId:  c.userId,
+				StoreId: 7,
+				Status:  int32Ptr(hidden),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			p := env.repo.listParam
+			if p.StoreId != c.wantStore || statusOf(p.Status) != c.wantStatus {
+				t.Fatalf("param = store:%d status:%d", p.StoreId, statusOf(p.Status))
+			}
+		})
+	}
+}
+
+func TestListReviewByStoreIdScopedByCaller(t *testing.T) {
+	cases := []struct {
+		name       string
+		caller     *biz.Caller
+		wantStore  int64
+		wantStatus int32 // 0表示不限制状态
+	}{
+		{"public", &biz.Caller{Role: biz.RolePublic}, 7, int32(biz.Approved)},
+		{"user", &biz.Caller{Role: biz.RoleUser, UserId: 9}, 7, int32(biz.Approved)},
+		{"store", &biz.Caller{Role: biz.RoleStore, StoreId: 3}, 3, int32(biz.Approved)},
+		{"operator", &biz.Caller{Role: biz.RoleOperator, OpUser: "op"}, 7, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			env := newTestEnv(t, nil)
+			if _, err := env.uc.ListReviewByStoreId(callerCtx(c.caller), 7, nil, 1, 10); err != nil {
+				t.Fatal(err)
+			}
+			p := env.repo.storeParam
+			if p.StoreId != c.wantStore || statusOf(p.Status) != c.wantStatus {
+				t.Fatalf("param = store:%d status:%d", p.StoreId, statusOf(p.Status))
+			}
+		})
+	}
+}
+
+func TestListReviewByUserUsesCaller(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	for id, userId := range map[int64]int64{1: 9, 2: 9, 3: 8} {
+		if _, err := env.repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: id, UserID: userId}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cases := []struct {
+		name   string
+		caller *biz.Caller
+		userId int64
+		want   int
+		is     func(error) bool
+	}{
+		{"user lists own reviews", &biz.Caller{Role: biz.RoleUser, UserId: 9}, 0, 2, nil},
+		{"user passes other userId", &biz.Caller{Role: biz.RoleUser, UserId: 9}, 8, 2, nil},
+		{"operator lists any user", &biz.Caller{Role: biz.RoleOperator, OpUser: "op"}, 8, 1, nil},
+		{"operator without userId", &biz.Caller{Role: biz.RoleOperator, OpUser: "op"}, 0, 0, v1.IsInvalidParam},
+		{"store", &biz.Caller{Role: biz.RoleStore, StoreId: 3}, 9, 0, v1.IsPermissionDenied},
+		{"public", &biz.Caller{Role: biz.RolePublic}, 9, 0, v1.IsUnauthorized},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			list, _, err := env.uc.ListReviewByUser(callerCtx(c.caller), c.userId, 1, 10)
+			if c.is != nil {
+				if !c.is(err) {
+					t.Fatalf("err = %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(list) != c.want {
+				t.Fatalf("got %d reviews, want %d", len(list), c.want)
+			}
+		})
+	}
+}
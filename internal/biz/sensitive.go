@@ -0,0 +1,123 @@
+package biz
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// wordTrie 敏感词前缀树,按rune匹配,不区分大小写和全角半角
+type wordTrie struct {
+	root *trieNode
+	size int
+}
+
+type trieNode struct {
+	children map[rune]*trieNode
+	end      bool
+}
+
+func newWordTrie(words []string) *wordTrie {
+	t := &wordTrie{root: &trieNode{}}
+	for _, w := range words {
+		t.add(w)
+	}
+	return t
+}
+
+func (t *wordTrie) add(word string) {
+	word = strings.TrimSpace(word)
+	if word == "" {
+		return
+	}
+	node := t.root
+	for _, r := range word {
+		r = foldRune(r)
+		if node.children == nil {
+			node.children = make(map[rune]*trieNode)
+		}
+		next, ok := node.children[r]
+		if !ok {
+			next = &trieNode{}
+			node.children[r] = next
+		}
+		node = next
+	}
+	if !node.end {
+		node.end = true
+		t.size++
+	}
+}
+
+// Match 从每个位置开始查找最长的敏感词,互相重叠的敏感词都会命中
+// 返回命中的敏感词(去重,保持原文中的写法)和把敏感词替换成*之后的文本
+func (t *wordTrie) Match(text string) ([]string, string) {
+	runes := []rune(text)
+	var (
+		words  []string
+		seen   map[string]struct{}
+		masked []rune
+	)
+	for i := range runes {
+		node, end := t.root, -1
+		for j := i; j < len(runes); j++ {
+			node = node.children[foldRune(runes[j])]
+			if node == nil {
+				break
+			}
+			if node.end {
+				end = j
+			}
+		}
+		if end < 0 {
+			continue
+		}
+		if masked == nil {
+			masked = append([]rune(nil), runes...)
+			seen = make(map[string]struct{})
+		}
+		word := string(runes[i : end+1])
+		if _, ok := seen[word]; !ok {
+			seen[word] = struct{}{}
+			words = append(words, word)
+		}
+		for k := i; k <= end; k++ {
+			masked[k] = '*'
+		}
+	}
+	if masked == nil {
+		return nil, text
+	}
+	return words, string(masked)
+}
+
+// foldRune 全角字符转成半角再转小写,"ＶＸ"和"vx"按同一个词匹配
+func foldRune(r rune) rune {
+	switch {
+	case r == '\u3000':
+		r = ' '
+	case r >= '\uff01' && r <= '\uff5e':
+		r -= 0xfee0
+	}
+	return unicode.ToLower(r)
+}
+
+// loadWordFile 读取敏感词文件,一行一个,忽略空行和#开头的注释
+func loadWordFile(path string) ([]string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var words []string
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	return words, sc.Err()
+}
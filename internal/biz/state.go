@@ -0,0 +1,81 @@
+package biz
+
+import (
+	"fmt"
+
+	v1 "review-service/api/review/v1"
+)
+
+// 评价状态机
+// 待审核 -> 审核通过/审核不通过: 运营审核
+// 待审核/审核通过/审核不通过 -> 待审核: 用户修改评价后重新审核
+// 待审核/审核通过/审核不通过 -> 隐藏: 商家申诉通过
+// 隐藏是终态,不能再流转
+var reviewTransitions = map[ReviewStatus][]ReviewStatus{
+	PendingReview:     {PendingReview, Approved, ReviewNotApproved, Hidden},
+	Approved:          {PendingReview, Hidden},
+	ReviewNotApproved: {PendingReview, Hidden},
+	Hidden:            {},
+}
+
+// 申诉状态机
+// 待审核 -> 待审核: 商家修改申诉内容
+// 待审核 -> 申诉通过/申诉驳回: 运营审核,审核后不能再修改
+var appealTransitions = map[AppealStatus][]AppealStatus{
+	AppealPending:  {AppealPending, AppealApproved, AppealRejected},
+	AppealApproved: {},
+	AppealRejected: {},
+}
+
+// TransitionError 非法的状态流转
+type TransitionError struct {
+	Kind string // 评价 或 申诉
+	From string
+	To   string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("%s状态不允许从[%s]变更为[%s]", e.Kind, e.From, e.To)
+}
+
+// newTransitionError 生成STATUS_TRANSITION_NOT_ALLOWED错误,cause里保留*TransitionError
+func newTransitionError(kind, from, to string) error {
+	e := &TransitionError{Kind: kind, From: from, To: to}
+	return v1.ErrorStatusTransitionNotAllowed("%s", e.Error()).WithCause(e)
+}
+
+// CanTransitTo 判断评价能否从当前状态流转到to状态
+func (s ReviewStatus) CanTransitTo(to ReviewStatus) bool {
+	for _, next := range reviewTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitTo 评价状态流转,非法流转时返回STATUS_TRANSITION_NOT_ALLOWED,可以用errors.As取出*TransitionError
+func (s ReviewStatus) TransitTo(to ReviewStatus) (ReviewStatus, error) {
+	if !s.CanTransitTo(to) {
+		return s, newTransitionError("评价", s.String(), to.String())
+	}
+	return to, nil
+}
+
+// CanTransitTo 判断申诉能否从当前状态流转到to状态
+func (s AppealStatus) CanTransitTo(to AppealStatus) bool {
+	for _, next := range appealTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitTo 申诉状态流转,非法流转时返回STATUS_TRANSITION_NOT_ALLOWED,可以用errors.As取出*TransitionError
+func (s AppealStatus) TransitTo(to AppealStatus) (AppealStatus, error) {
+	if !s.CanTransitTo(to) {
+		return s, newTransitionError("申诉", s.String(), to.String())
+	}
+	return to, nil
+}
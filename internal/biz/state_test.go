@@ -0,0 +1,79 @@
+package biz_test
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+)
+
+// checkTransition 校验一次状态流转的结果,不允许时要返回STATUS_TRANSITION_NOT_ALLOWED并且带上*TransitionError
+func checkTransition(t *testing.T, kind, from, to string, allowed bool, err error) {
+	t.Helper()
+	if allowed {
+		if err != nil {
+			t.Fatalf("%s %s -> %s: err = %v, want allowed", kind, from, to, err)
+		}
+		return
+	}
+	if e := errors.FromError(err); e == nil || e.Reason != v1.ErrorReason_STATUS_TRANSITION_NOT_ALLOWED.String() {
+		t.Fatalf("%s %s -> %s: err = %v, want STATUS_TRANSITION_NOT_ALLOWED", kind, from, to, err)
+	}
+	var te *biz.TransitionError
+	if !stderrors.As(err, &te) || te.Kind != kind || te.From != from || te.To != to {
+		t.Fatalf("%s %s -> %s: transition error = %+v", kind, from, to, te)
+	}
+}
+
+func TestReviewTransitions(t *testing.T) {
+	statuses := []biz.ReviewStatus{biz.PendingReview, biz.Approved, biz.ReviewNotApproved, biz.Hidden}
+	allowed := map[[2]biz.ReviewStatus]bool{
+		{biz.PendingReview, biz.PendingReview}:     true,
+		{biz.PendingReview, biz.Approved}:          true,
+		{biz.PendingReview, biz.ReviewNotApproved}: true,
+		{biz.PendingReview, biz.Hidden}:            true,
+		{biz.Approved, biz.PendingReview}:          true,
+		{biz.Approved, biz.Hidden}:                 true,
+		{biz.ReviewNotApproved, biz.PendingReview}: true,
+		{biz.ReviewNotApproved, biz.Hidden}:        true,
+		// 隐藏是终态,不在表里的都不允许
+	}
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := allowed[[2]biz.ReviewStatus{from, to}]
+			if got := from.CanTransitTo(to); got != want {
+				t.Fatalf("%s -> %s: CanTransitTo = %v, want %v", from, to, got, want)
+			}
+			got, err := from.TransitTo(to)
+			checkTransition(t, "评价", from.String(), to.String(), want, err)
+			if want && got != to || !want && got != from {
+				t.Fatalf("%s -> %s: TransitTo = %s", from, to, got)
+			}
+		}
+	}
+}
+
+func TestAppealTransitions(t *testing.T) {
+	statuses := []biz.AppealStatus{biz.AppealPending, biz.AppealApproved, biz.AppealRejected}
+	allowed := map[[2]biz.AppealStatus]bool{
+		{biz.AppealPending, biz.AppealPending}:  true,
+		{biz.AppealPending, biz.AppealApproved}: true,
+		{biz.AppealPending, biz.AppealRejected}: true,
+	}
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := allowed[[2]biz.AppealStatus{from, to}]
+			if got := from.CanTransitTo(to); got != want {
+				t.Fatalf("%s -> %s: CanTransitTo = %v, want %v", from, to, got, want)
+			}
+			got, err := from.TransitTo(to)
+			checkTransition(t, "申诉", from.String(), to.String(), want, err)
+			if want && got != to || !want && got != from {
+				t.Fatalf("%s -> %s: TransitTo = %s", from, to, got)
+			}
+		}
+	}
+}
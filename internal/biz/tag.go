@@ -0,0 +1,223 @@
+package biz
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+)
+
+const defaultMaxTags = 5
+
+// TagDict 评价标签词典,用户只能选择词典中的标签
+// 开启自动打标签时,评价内容包含标签的关键词就自动加上这个标签
+type TagDict struct {
+	tags     []string
+	keywords map[string][]string
+	autoTag  bool
+	maxTags  int
+}
+
+// NewTagDict 加载标签词典,没有配置词典文件时不支持标签
+func NewTagDict(c *conf.Review) (*TagDict, error) {
+	tc := c.GetTag()
+	d := &TagDict{
+		keywords: make(map[string][]string),
+		autoTag:  tc.GetAutoTag(),
+		maxTags:  int(tc.GetMaxTags()),
+	}
+	if d.maxTags <= 0 {
+		d.maxTags = defaultMaxTags
+	}
+	if tc.GetDictFile() == "" {
+		return d, nil
+	}
+	b, err := os.ReadFile(tc.GetDictFile())
+	if err != nil {
+		return nil, err
+	}
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		tag, words, _ := strings.Cut(line, ":")
+		d.Add(strings.TrimSpace(tag), strings.Split(words, ",")...)
+	}
+	return d, sc.Err()
+}
+
+// Add 添加一个标签和它的自动打标签关键词,本地调试和单元测试用
+func (d *TagDict) Add(tag string, keywords ...string) {
+	if tag == "" {
+		return
+	}
+	if _, ok := d.keywords[tag]; !ok {
+		d.tags = append(d.tags, tag)
+		d.keywords[tag] = nil
+	}
+	for _, w := range keywords {
+		if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+			d.keywords[tag] = append(d.keywords[tag], w)
+		}
+	}
+}
+
+// Resolve 校验用户选择的标签,再按词典顺序补上自动打的标签,总数不超过maxTags
+// 用户选择的标签不在词典中或者超过maxTags时返回参数错误
+func (d *TagDict) Resolve(chosen []string, content string) ([]string, error) {
+	tags := make([]string, 0, d.maxTags)
+	seen := make(map[string]struct{}, d.maxTags)
+	for _, t := range chosen {
+		t = strings.TrimSpace(t)
+		if _, ok := d.keywords[t]; !ok {
+			return nil, v1.ErrorInvalidParam("不支持的标签: %s", t)
+		}
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		tags = append(tags, t)
+	}
+	if len(tags) > d.maxTags {
+		return nil, v1.ErrorInvalidParam("最多选择%d个标签", d.maxTags)
+	}
+	if !d.autoTag {
+		return tags, nil
+	}
+	content = strings.ToLower(content)
+	for _, t := range d.tags {
+		if len(tags) >= d.maxTags {
+			break
+		}
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		for _, w := range d.keywords[t] {
+			if containsKeyword(content, w) {
+				seen[t] = struct{}{}
+				tags = append(tags, t)
+				break
+			}
+		}
+	}
+	return tags, nil
+}
+
+// negations 否定词,关键词前面紧挨着否定词时不算命中,比如"不划算"、"没有色差"
+var negations = []string{"不", "没", "无", "未", "别", "非"}
+
+// notNegations 以否定词开头但不是否定意思的词,比如"非常划算"、"不仅物流快"
+var notNegations = []string{"非常", "特别", "不仅", "不但", "不光", "不错", "无论", "无比"}
+
+// negationWindow 关键词前面检查否定词的字数,"不太划算"、"并没有色差"也算否定
+const negationWindow = 3
+
+// clauseSeparators 分句的标点,否定词只影响同一个分句里的关键词
+const clauseSeparators = ",，。.!！?？;；、~ \n"
+
+// containsKeyword 内容中有一处关键词没有被否定,并且英文和数字关键词前后不是英文和数字时才算命中
+func containsKeyword(content, w string) bool {
+	for i := 0; i < len(content); {
+		j := strings.Index(content[i:], w)
+		if j < 0 {
+			return false
+		}
+		start, end := i+j, i+j+len(w)
+		if isWordBoundary(content, start, end) && !negated(content[:start]) {
+			return true
+		}
+		i = start + 1
+	}
+	return false
+}
+
+// isWordBoundary 英文和数字关键词不能是更长的单词的一部分,比如"nice"不能命中"nicely"
+func isWordBoundary(content string, start, end int) bool {
+	first, _ := utf8.DecodeRuneInString(content[start:])
+	if isAlnum(first) && start > 0 {
+		if r, _ := utf8.DecodeLastRuneInString(content[:start]); isAlnum(r) {
+			return false
+		}
+	}
+	last, _ := utf8.DecodeLastRuneInString(content[:end])
+	if isAlnum(last) && end < len(content) {
+		if r, _ := utf8.DecodeRuneInString(content[end:]); isAlnum(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlnum(r rune) bool {
+	return r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// negated 关键词前面同一个分句的negationWindow个字里有否定词
+func negated(before string) bool {
+	if i := strings.LastIndexFunc(before, isClauseSeparator); i >= 0 {
+		_, size := utf8.DecodeRuneInString(before[i:])
+		before = before[i+size:]
+	}
+	for _, w := range notNegations {
+		before = strings.ReplaceAll(before, w, "")
+	}
+	runes := []rune(before)
+	if len(runes) > negationWindow {
+		runes = runes[len(runes)-negationWindow:]
+	}
+	window := string(runes)
+	for _, n := range negations {
+		if strings.Contains(window, n) {
+			return true
+		}
+	}
+	return false
+}
+
+func isClauseSeparator(r rune) bool {
+	return strings.ContainsRune(clauseSeparators, r)
+}
+
+// EncodeTags 标签按JSON数组保存在tags列,没有标签时为空字符串
+func EncodeTags(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(tags)
+	return string(b)
+}
+
+// DecodeTags 解析tags列,格式不对时当作没有标签
+func DecodeTags(raw string) []string {
+	var tags []string
+	if raw == "" || json.Unmarshal([]byte(raw), &tags) != nil {
+		return nil
+	}
+	return tags
+}
+
+// Tags ES文档中的标签,索引中是keyword数组
+// 兼容重建索引前tags还是JSON字符串的文档
+type Tags []string
+
+func (t *Tags) UnmarshalJSON(b []byte) error {
+	var raw string
+	if json.Unmarshal(b, &raw) == nil {
+		*t = DecodeTags(raw)
+		return nil
+	}
+	var tags []string
+	if err := json.Unmarshal(b, &tags); err != nil {
+		return err
+	}
+	*t = tags
+	return nil
+}
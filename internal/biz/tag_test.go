@@ -0,0 +1,105 @@
+package biz_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+)
+
+func newTagDict(t *testing.T) *biz.TagDict {
+	t.Helper()
+	d, err := biz.NewTagDict(&conf.Review{Tag: &conf.Tag{AutoTag: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Add("物流快", "物流快", "发货快")
+	d.Add("性价比高", "性价比高", "划算", "实惠")
+	d.Add("与描述不符", "与描述不符", "色差")
+	d.Add("好用", "nice")
+	return d
+}
+
+func TestTagDictResolve(t *testing.T) {
+	d := newTagDict(t)
+	cases := []struct {
+		content string
+		want    []string
+	}{
+		{"物流快,很划算", []string{"物流快", "性价比高"}},
+		{"不划算", nil},
+		{"一点也不划算", nil},
+		{"不太划算,物流快", []string{"物流快"}},
+		{"物流没有发货快说的那么好,但是很实惠", []string{"性价比高"}},
+		{"没有色差", nil},
+		{"有色差", []string{"与描述不符"}},
+		{"非常划算", []string{"性价比高"}},
+		{"不仅物流快,还很实惠", []string{"物流快", "性价比高"}},
+		{"不划算,不过也算实惠", []string{"性价比高"}},
+		{"NICE!", []string{"好用"}},
+		{"nicely packed", nil},
+	}
+	for _, c := range cases {
+		got, err := d.Resolve(nil, c.content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) == 0 {
+			got = nil
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Resolve(%q) = %v, want %v", c.content, got, c.want)
+		}
+	}
+}
+
+func TestUpdateReviewRetags(t *testing.T) {
+	dict := filepath.Join(t.TempDir(), "review_tags.txt")
+	if err := os.WriteFile(dict, []byte("物流快: 物流快\n性价比高: 划算,实惠\n回购:\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	env := newTestEnv(t, &conf.Review{
+		UpdateWindow: durationpb.New(time.Hour),
+		Tag:          &conf.Tag{DictFile: dict, AutoTag: true},
+	})
+	env.orders.Put(&biz.Order{OrderID: 1, UserID: 9, StoreID: 3, SkuID: 20, Status: biz.OrderCompleted})
+	env.product.Put(&biz.Sku{SkuID: 20, SpuID: 200, StoreID: 3})
+	review, err := env.uc.CreateReview(userCtx(9), &model.ReviewInfo{OrderID: 1, Score: 5, Content: "物流快,很划算"}, nil, []string{"回购"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := biz.DecodeTags(review.Tags); !reflect.DeepEqual(got, []string{"回购", "物流快", "性价比高"}) {
+		t.Fatalf("tags = %v", got)
+	}
+
+	updated, err := env.uc.UpdateReview(userCtx(9), &biz.UpdateReviewParam{
+		ReviewId: review.ReviewID,
+		Version:  review.Version,
+		Score:    2,
+		Content:  "物流快,但是一点也不划算",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := biz.DecodeTags(updated.Tags); !reflect.DeepEqual(got, []string{"物流快"}) {
+		t.Fatalf("tags after update = %v", got)
+	}
+
+	// 用户选择的标签也要在词典中
+	if _, err := env.uc.UpdateReview(userCtx(9), &biz.UpdateReviewParam{
+		ReviewId: review.ReviewID,
+		Version:  updated.Version,
+		Content:  "物流快",
+		Tags:     []string{"不存在的标签"},
+	}); !v1.IsInvalidParam(err) {
+		t.Fatalf("err = %v, want INVALID_PARAM", err)
+	}
+}
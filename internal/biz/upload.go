@@ -0,0 +1,261 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"review-service/pkg/snowflake"
+)
+
+const (
+	defaultUploadExpire = 15 * time.Minute
+	defaultMaxImageSize = 10 << 20
+	defaultMaxVideoSize = 100 << 20
+	defaultUploadQuota  = 100
+)
+
+var (
+	defaultImageTypes = []string{"image/jpeg", "image/png", "image/webp"}
+	defaultVideoTypes = []string{"video/mp4", "video/quicktime"}
+)
+
+// ObjectStorage S3兼容的对象存储
+type ObjectStorage interface {
+	// PresignPut 生成预签名的上传地址,上传时的Content-Type和Content-Length必须和签名时一致
+	PresignPut(ctx context.Context, key, contentType string, size int64, expire time.Duration) (string, error)
+	// StatObject 查询对象信息,对象不存在时返回nil, nil
+	StatObject(ctx context.Context, key string) (*ObjectInfo, error)
+}
+
+// ObjectInfo 对象存储中的对象信息
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+}
+
+// UploadFile 要上传的一个文件
+type UploadFile struct {
+	Type        MediaType
+	ContentType string
+	Size        int64
+}
+
+// UploadToken 一个文件的上传地址
+type UploadToken struct {
+	Key       string
+	UploadURL string
+	Headers   map[string]string
+	URL       string // 上传完成后的访问地址
+	ExpireAt  time.Time
+}
+
+// uploadPolicy 上传的限制: 文件类型、大小、每天的配额
+type uploadPolicy struct {
+	publicURL string
+	expire    time.Duration
+	maxSize   map[MediaType]int64
+	types     map[MediaType][]string
+	quota     int64
+}
+
+func newUploadPolicy(c *conf.Upload) *uploadPolicy {
+	p := &uploadPolicy{
+		publicURL: strings.TrimRight(c.GetPublicUrl(), "/"),
+		expire:    defaultUploadExpire,
+		maxSize: map[MediaType]int64{
+			MediaImage: defaultMaxImageSize,
+			MediaVideo: defaultMaxVideoSize,
+		},
+		types: map[MediaType][]string{
+			MediaImage: defaultImageTypes,
+			MediaVideo: defaultVideoTypes,
+		},
+		quota: defaultUploadQuota,
+	}
+	if c.GetExpire() != nil {
+		p.expire = c.GetExpire().AsDuration()
+	}
+	if c.GetMaxImageSize() > 0 {
+		p.maxSize[MediaImage] = c.GetMaxImageSize()
+	}
+	if c.GetMaxVideoSize() > 0 {
+		p.maxSize[MediaVideo] = c.GetMaxVideoSize()
+	}
+	if len(c.GetImageTypes()) > 0 {
+		p.types[MediaImage] = c.GetImageTypes()
+	}
+	if len(c.GetVideoTypes()) > 0 {
+		p.types[MediaVideo] = c.GetVideoTypes()
+	}
+	if c.GetDailyQuota() > 0 {
+		p.quota = int64(c.GetDailyQuota())
+	}
+	return p
+}
+
+// check 校验文件类型和大小
+func (p *uploadPolicy) check(typ MediaType, contentType string, size int64) error {
+	types, ok := p.types[typ]
+	if !ok {
+		return v1.ErrorInvalidParam("不支持的媒体类型: %s", typ)
+	}
+	if !containsFold(types, contentType) {
+		return v1.ErrorInvalidParam("不支持的文件类型: %s", contentType)
+	}
+	if size <= 0 || size > p.maxSize[typ] {
+		return v1.ErrorInvalidParam("文件大小不能超过%d字节", p.maxSize[typ])
+	}
+	return nil
+}
+
+// objectURL 对象的访问地址
+func (p *uploadPolicy) objectURL(key string) string {
+	return p.publicURL + "/" + key
+}
+
+// objectKey 从访问地址中取出对象的key,不是本服务上传的地址返回false
+// scheme和域名不区分大小写,默认端口写不写都一样,key按path.Clean规范化,不能跳出publicURL的路径
+func (p *uploadPolicy) objectKey(raw string) (string, bool) {
+	base, err := url.Parse(p.publicURL)
+	if p.publicURL == "" || err != nil {
+		return "", false
+	}
+	u, err := url.Parse(raw)
+	if err != nil || !strings.EqualFold(u.Scheme, base.Scheme) ||
+		!strings.EqualFold(u.Hostname(), base.Hostname()) || urlPort(u) != urlPort(base) {
+		return "", false
+	}
+	prefix := strings.TrimRight(base.Path, "/") + "/"
+	if !strings.HasPrefix(u.Path, prefix) {
+		return "", false
+	}
+	key := path.Clean(strings.TrimPrefix(u.Path, prefix))
+	if key == "." || key == ".." || strings.HasPrefix(key, "../") || strings.HasPrefix(key, "/") {
+		return "", false
+	}
+	return key, true
+}
+
+// urlPort 地址的端口,没有写端口时取scheme的默认端口
+func urlPort(u *url.URL) string {
+	if port := u.Port(); port != "" {
+		return port
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "https":
+		return "443"
+	case "http":
+		return "80"
+	}
+	return ""
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// uploadOwner 上传者,用户和商家分开计算配额,对象key以上传者开头
+// 引用图片视频时只能引用自己上传的对象
+func uploadOwner(c *Caller) string {
+	if c.Role == RoleStore {
+		return fmt.Sprintf("s%d", c.StoreId)
+	}
+	return fmt.Sprintf("u%d", c.UserId)
+}
+
+// GetUploadToken 获取图片视频的上传地址
+// 用户上传评价的图片视频,商家上传回复和申诉的图片视频,获取上传地址就计入当天的配额
+func (uc *ReviewUsecase) GetUploadToken(ctx context.Context, files []*UploadFile) ([]*UploadToken, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetUploadToken, files:%d", len(files))
+	caller, err := requireRole(ctx, RoleUser, RoleStore)
+	if err != nil {
+		return nil, err
+	}
+	if uc.storage == nil {
+		return nil, v1.ErrorDependencyFailed("未开启图片视频上传")
+	}
+	for _, f := range files {
+		if err := uc.upload.check(f.Type, f.ContentType, f.Size); err != nil {
+			return nil, err
+		}
+	}
+	owner := uploadOwner(caller)
+	now := time.Now()
+	tokens := make([]*UploadToken, 0, len(files))
+	for _, f := range files {
+		key := fmt.Sprintf("review/%s/%s/%d", owner, now.Format("20060102"), snowflake.GenerateID())
+		uploadURL, err := uc.storage.PresignPut(ctx, key, f.ContentType, f.Size, uc.upload.expire)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("[biz] GetUploadToken presign fail, key:%s, err:%v", key, err)
+			return nil, v1.ErrorDependencyFailed("生成上传地址失败").WithCause(err)
+		}
+		tokens = append(tokens, &UploadToken{
+			Key:       key,
+			UploadURL: uploadURL,
+			Headers:   map[string]string{"Content-Type": f.ContentType},
+			URL:       uc.upload.objectURL(key),
+			ExpireAt:  now.Add(uc.upload.expire),
+		})
+	}
+	// 所有上传地址都生成成功后才占用配额,生成失败不消耗配额
+	ok, err := uc.repo.TakeUploadQuota(ctx, owner, int64(len(files)), uc.upload.quota)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, v1.ErrorUploadQuotaExceeded("今天最多上传%d个文件", uc.upload.quota)
+	}
+	return tokens, nil
+}
+
+// verifyMedia 校验引用的图片视频: 必须是本服务上传的、owner上传的,已经上传完成,类型和大小符合要求
+// 没有配置对象存储时不校验,只受MediaPolicy的域名限制
+func (uc *ReviewUsecase) verifyMedia(ctx context.Context, owner string, items []*MediaItem) error {
+	if uc.storage == nil {
+		return nil
+	}
+	for _, item := range items {
+		if err := uc.verifyObject(ctx, owner, item.Type, item.URL); err != nil {
+			return err
+		}
+		if item.Cover != "" {
+			if err := uc.verifyObject(ctx, owner, MediaImage, item.Cover); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (uc *ReviewUsecase) verifyObject(ctx context.Context, owner string, typ MediaType, raw string) error {
+	key, ok := uc.upload.objectKey(raw)
+	if !ok {
+		return v1.ErrorMediaNotFound("只能使用本服务上传的图片视频: %s", raw)
+	}
+	if !strings.HasPrefix(key, "review/"+owner+"/") {
+		return v1.ErrorMediaNotFound("只能使用自己上传的图片视频: %s", raw)
+	}
+	info, err := uc.storage.StatObject(ctx, key)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("[biz] verifyObject stat fail, key:%s, err:%v", key, err)
+		return v1.ErrorDependencyFailed("查询图片视频失败").WithCause(err)
+	}
+	if info == nil {
+		return v1.ErrorMediaNotFound("图片视频不存在或者还没有上传完成: %s", raw)
+	}
+	if err := uc.upload.check(typ, info.ContentType, info.Size); err != nil {
+		return v1.ErrorMediaNotFound("图片视频不合法: %s", raw).WithCause(err)
+	}
+	return nil
+}
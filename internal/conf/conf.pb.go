@@ -28,6 +28,10 @@ type Bootstrap struct {
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Snowflake     *Snowflake             `protobuf:"bytes,3,opt,name=snowflake,proto3" json:"snowflake,omitempty"`
 	Elasticsearch *Elasticsearch         `protobuf:"bytes,4,opt,name=elasticsearch,proto3" json:"elasticsearch,omitempty"`
+	Review        *Review                `protobuf:"bytes,5,opt,name=review,proto3" json:"review,omitempty"`
+	Client        *Client                `protobuf:"bytes,6,opt,name=client,proto3" json:"client,omitempty"`
+	Kafka         *Kafka                 `protobuf:"bytes,7,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	Event         *Event                 `protobuf:"bytes,8,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,29 +94,691 @@ func (x *Bootstrap) GetElasticsearch() *Elasticsearch {
 	return nil
 }
 
+func (x *Bootstrap) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetClient() *Client {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetKafka() *Kafka {
+	if x != nil {
+		return x.Kafka
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
-	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Auth          *Server_Auth           `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_conf_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Server) GetHttp() *Server_HTTP {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Server) GetGrpc() *Server_GRPC {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *Server) GetAuth() *Server_Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type Data struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data) Reset() {
+	*x = Data{}
+	mi := &file_conf_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data) ProtoMessage() {}
+
+func (x *Data) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data.ProtoReflect.Descriptor instead.
+func (*Data) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Data) GetDatabase() *Data_Database {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *Data) GetRedis() *Data_Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+type Snowflake struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineId     int64                  `protobuf:"varint,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Snowflake) Reset() {
+	*x = Snowflake{}
+	mi := &file_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Snowflake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snowflake) ProtoMessage() {}
+
+func (x *Snowflake) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snowflake.ProtoReflect.Descriptor instead.
+func (*Snowflake) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Snowflake) GetMachineId() int64 {
+	if x != nil {
+		return x.MachineId
+	}
+	return 0
+}
+
+type Registry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consul        *Registry_Consul       `protobuf:"bytes,1,opt,name=consul,proto3" json:"consul,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Registry) Reset() {
+	*x = Registry{}
+	mi := &file_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registry) ProtoMessage() {}
+
+func (x *Registry) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registry.ProtoReflect.Descriptor instead.
+func (*Registry) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Registry) GetConsul() *Registry_Consul {
+	if x != nil {
+		return x.Consul
+	}
+	return nil
+}
+
+type Elasticsearch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Elasticsearch) Reset() {
+	*x = Elasticsearch{}
+	mi := &file_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Elasticsearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Elasticsearch) ProtoMessage() {}
+
+func (x *Elasticsearch) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Elasticsearch.ProtoReflect.Descriptor instead.
+func (*Elasticsearch) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Elasticsearch) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// 评价业务相关配置
+type Review struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UpdateWindow    *durationpb.Duration   `protobuf:"bytes,1,opt,name=update_window,json=updateWindow,proto3" json:"update_window,omitempty"`          // 评价创建后允许修改的时间窗口
+	AnonymousSecret string                 `protobuf:"bytes,2,opt,name=anonymous_secret,json=anonymousSecret,proto3" json:"anonymous_secret,omitempty"` // 生成匿名用户代号的密钥,必须配置,通过环境变量REVIEW_ANONYMOUS_SECRET注入
+	Moderation      *Moderation            `protobuf:"bytes,3,opt,name=moderation,proto3" json:"moderation,omitempty"`
+	Media           *Media                 `protobuf:"bytes,4,opt,name=media,proto3" json:"media,omitempty"`
+	Upload          *Upload                `protobuf:"bytes,5,opt,name=upload,proto3" json:"upload,omitempty"`
+	Tag             *Tag                   `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
+	AppendWindow    *durationpb.Duration   `protobuf:"bytes,7,opt,name=append_window,json=appendWindow,proto3" json:"append_window,omitempty"` // 评价创建后允许追评的时间窗口,默认180天
+	DefaultReview   *DefaultReview         `protobuf:"bytes,8,opt,name=default_review,json=defaultReview,proto3" json:"default_review,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Review) GetUpdateWindow() *durationpb.Duration {
+	if x != nil {
+		return x.UpdateWindow
+	}
+	return nil
+}
+
+func (x *Review) GetAnonymousSecret() string {
+	if x != nil {
+		return x.AnonymousSecret
+	}
+	return ""
+}
+
+func (x *Review) GetModeration() *Moderation {
+	if x != nil {
+		return x.Moderation
+	}
+	return nil
+}
+
+func (x *Review) GetMedia() *Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *Review) GetUpload() *Upload {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+func (x *Review) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *Review) GetAppendWindow() *durationpb.Duration {
+	if x != nil {
+		return x.AppendWindow
+	}
+	return nil
+}
+
+func (x *Review) GetDefaultReview() *DefaultReview {
+	if x != nil {
+		return x.DefaultReview
+	}
+	return nil
+}
+
+// 默认好评: 订单完成后一段时间内没有评价,系统自动创建一条5星评价
+type DefaultReview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enable        bool                   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`                                     // 订单完成多少天后没有评价就默认好评,默认15
+	LookbackDays  int32                  `protobuf:"varint,3,opt,name=lookback_days,json=lookbackDays,proto3" json:"lookback_days,omitempty"` // 每次扫描往前多看几天完成的订单,服务停机期间漏掉的订单下次补上,默认3
+	Interval      *durationpb.Duration   `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`                              // 扫描间隔,默认1小时
+	BatchSize     int32                  `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`          // 每次查询的订单数,默认100
+	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`                                // 默认评价的内容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefaultReview) Reset() {
+	*x = DefaultReview{}
+	mi := &file_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefaultReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultReview) ProtoMessage() {}
+
+func (x *DefaultReview) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultReview.ProtoReflect.Descriptor instead.
+func (*DefaultReview) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DefaultReview) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *DefaultReview) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *DefaultReview) GetLookbackDays() int32 {
+	if x != nil {
+		return x.LookbackDays
+	}
+	return 0
+}
+
+func (x *DefaultReview) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *DefaultReview) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *DefaultReview) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// 评价标签
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DictFile      string                 `protobuf:"bytes,1,opt,name=dict_file,json=dictFile,proto3" json:"dict_file,omitempty"` // 标签词典文件,格式见dict/review_tags.txt,为空时不支持标签
+	AutoTag       bool                   `protobuf:"varint,2,opt,name=auto_tag,json=autoTag,proto3" json:"auto_tag,omitempty"`   // 是否根据评价内容中的关键词自动打标签
+	MaxTags       int32                  `protobuf:"varint,3,opt,name=max_tags,json=maxTags,proto3" json:"max_tags,omitempty"`   // 一条评价最多几个标签,默认5
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Tag) GetDictFile() string {
+	if x != nil {
+		return x.DictFile
+	}
+	return ""
+}
+
+func (x *Tag) GetAutoTag() bool {
+	if x != nil {
+		return x.AutoTag
+	}
+	return false
+}
+
+func (x *Tag) GetMaxTags() int32 {
+	if x != nil {
+		return x.MaxTags
+	}
+	return 0
+}
+
+// 图片视频上传用的S3兼容对象存储,endpoint为空时不提供上传地址,也不校验评价中的图片视频是否存在
+type Upload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"` // 对象存储地址,比如 127.0.0.1:9000,不带协议
+	UseSsl        bool                   `protobuf:"varint,2,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"` // 默认us-east-1
+	Bucket        string                 `protobuf:"bytes,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	AccessKey     string                 `protobuf:"bytes,5,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`              // 通过环境变量REVIEW_UPLOAD_ACCESS_KEY注入
+	SecretKey     string                 `protobuf:"bytes,6,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`              // 通过环境变量REVIEW_UPLOAD_SECRET_KEY注入
+	PublicUrl     string                 `protobuf:"bytes,7,opt,name=public_url,json=publicUrl,proto3" json:"public_url,omitempty"`              // 上传后的访问地址前缀,比如 https://img.review-media.example.com,域名需要在media.allowed_hosts中
+	Expire        *durationpb.Duration   `protobuf:"bytes,8,opt,name=expire,proto3" json:"expire,omitempty"`                                     // 上传地址的有效期,默认15m
+	MaxImageSize  int64                  `protobuf:"varint,9,opt,name=max_image_size,json=maxImageSize,proto3" json:"max_image_size,omitempty"`  // 单张图片最大字节数,默认10MB
+	MaxVideoSize  int64                  `protobuf:"varint,10,opt,name=max_video_size,json=maxVideoSize,proto3" json:"max_video_size,omitempty"` // 单个视频最大字节数,默认100MB
+	ImageTypes    []string               `protobuf:"bytes,11,rep,name=image_types,json=imageTypes,proto3" json:"image_types,omitempty"`          // 允许的图片Content-Type,默认image/jpeg、image/png、image/webp
+	VideoTypes    []string               `protobuf:"bytes,12,rep,name=video_types,json=videoTypes,proto3" json:"video_types,omitempty"`          // 允许的视频Content-Type,默认video/mp4、video/quicktime
+	DailyQuota    int32                  `protobuf:"varint,13,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"`         // 每个用户(商家)每天最多获取几个上传地址,默认100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Upload) Reset() {
+	*x = Upload{}
+	mi := &file_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Upload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Upload) ProtoMessage() {}
+
+func (x *Upload) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Upload.ProtoReflect.Descriptor instead.
+func (*Upload) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Upload) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Upload) GetUseSsl() bool {
+	if x != nil {
+		return x.UseSsl
+	}
+	return false
+}
+
+func (x *Upload) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Upload) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *Upload) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *Upload) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *Upload) GetPublicUrl() string {
+	if x != nil {
+		return x.PublicUrl
+	}
+	return ""
+}
+
+func (x *Upload) GetExpire() *durationpb.Duration {
+	if x != nil {
+		return x.Expire
+	}
+	return nil
+}
+
+func (x *Upload) GetMaxImageSize() int64 {
+	if x != nil {
+		return x.MaxImageSize
+	}
+	return 0
+}
+
+func (x *Upload) GetMaxVideoSize() int64 {
+	if x != nil {
+		return x.MaxVideoSize
+	}
+	return 0
+}
+
+func (x *Upload) GetImageTypes() []string {
+	if x != nil {
+		return x.ImageTypes
+	}
+	return nil
+}
+
+func (x *Upload) GetVideoTypes() []string {
+	if x != nil {
+		return x.VideoTypes
+	}
+	return nil
+}
+
+func (x *Upload) GetDailyQuota() int32 {
+	if x != nil {
+		return x.DailyQuota
+	}
+	return 0
+}
+
+// 评价、回复、申诉中的图片和视频
+type Media struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllowedHosts  []string               `protobuf:"bytes,1,rep,name=allowed_hosts,json=allowedHosts,proto3" json:"allowed_hosts,omitempty"` // 允许的图片视频域名,以.开头时匹配这个域名本身和所有子域名,为空时不限制
+	MaxImages     int32                  `protobuf:"varint,2,opt,name=max_images,json=maxImages,proto3" json:"max_images,omitempty"`         // 最多几张图片,默认9
+	MaxVideos     int32                  `protobuf:"varint,3,opt,name=max_videos,json=maxVideos,proto3" json:"max_videos,omitempty"`         // 最多几个视频,默认1
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Server) Reset() {
-	*x = Server{}
-	mi := &file_conf_proto_msgTypes[1]
+func (x *Media) Reset() {
+	*x = Media{}
+	mi := &file_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server) String() string {
+func (x *Media) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server) ProtoMessage() {}
+func (*Media) ProtoMessage() {}
 
-func (x *Server) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[1]
+func (x *Media) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -123,48 +789,62 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server.ProtoReflect.Descriptor instead.
-func (*Server) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Media.ProtoReflect.Descriptor instead.
+func (*Media) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Server) GetHttp() *Server_HTTP {
+func (x *Media) GetAllowedHosts() []string {
 	if x != nil {
-		return x.Http
+		return x.AllowedHosts
 	}
 	return nil
 }
 
-func (x *Server) GetGrpc() *Server_GRPC {
+func (x *Media) GetMaxImages() int32 {
 	if x != nil {
-		return x.Grpc
+		return x.MaxImages
 	}
-	return nil
+	return 0
 }
 
-type Data struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Media) GetMaxVideos() int32 {
+	if x != nil {
+		return x.MaxVideos
+	}
+	return 0
 }
 
-func (x *Data) Reset() {
-	*x = Data{}
-	mi := &file_conf_proto_msgTypes[2]
+// 评价内容的敏感词和垃圾内容检查
+type Moderation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WordFile       string                 `protobuf:"bytes,1,opt,name=word_file,json=wordFile,proto3" json:"word_file,omitempty"`                   // 敏感词文件,一行一个,#开头的行是注释,文件修改后自动重新加载
+	Words          []string               `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`                                         // 配置中的敏感词,和文件中的合并
+	ReloadInterval *durationpb.Duration   `protobuf:"bytes,3,opt,name=reload_interval,json=reloadInterval,proto3" json:"reload_interval,omitempty"` // 检查敏感词文件是否修改的间隔,默认30s
+	Action         string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`                                       // 评价命中敏感词的处理: mask 替换成*; review 保留原文转人工审核。默认mask
+	AutoAudit      bool                   `protobuf:"varint,5,opt,name=auto_audit,json=autoAudit,proto3" json:"auto_audit,omitempty"`               // 是否开启新评价的自动审核,关闭时所有评价都由运营人工审核
+	MediaEndpoint  string                 `protobuf:"bytes,6,opt,name=media_endpoint,json=mediaEndpoint,proto3" json:"media_endpoint,omitempty"`    // 图片视频审核服务地址,为空时不检查图片视频
+	Timeout        *durationpb.Duration   `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`                                     // 单条评价自动审核的超时时间,默认10s
+	MaxRejected    int32                  `protobuf:"varint,8,opt,name=max_rejected,json=maxRejected,proto3" json:"max_rejected,omitempty"`         // 用户被驳回的评价数达到这个值时转人工审核,默认3
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Moderation) Reset() {
+	*x = Moderation{}
+	mi := &file_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data) String() string {
+func (x *Moderation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data) ProtoMessage() {}
+func (*Moderation) ProtoMessage() {}
 
-func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[2]
+func (x *Moderation) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,47 +855,92 @@ func (x *Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data.ProtoReflect.Descriptor instead.
-func (*Data) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Moderation.ProtoReflect.Descriptor instead.
+func (*Moderation) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Data) GetDatabase() *Data_Database {
+func (x *Moderation) GetWordFile() string {
 	if x != nil {
-		return x.Database
+		return x.WordFile
+	}
+	return ""
+}
+
+func (x *Moderation) GetWords() []string {
+	if x != nil {
+		return x.Words
 	}
 	return nil
 }
 
-func (x *Data) GetRedis() *Data_Redis {
+func (x *Moderation) GetReloadInterval() *durationpb.Duration {
 	if x != nil {
-		return x.Redis
+		return x.ReloadInterval
 	}
 	return nil
 }
 
-type Snowflake struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MachineId     int64                  `protobuf:"varint,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Moderation) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
 }
 
-func (x *Snowflake) Reset() {
-	*x = Snowflake{}
-	mi := &file_conf_proto_msgTypes[3]
+func (x *Moderation) GetAutoAudit() bool {
+	if x != nil {
+		return x.AutoAudit
+	}
+	return false
+}
+
+func (x *Moderation) GetMediaEndpoint() string {
+	if x != nil {
+		return x.MediaEndpoint
+	}
+	return ""
+}
+
+func (x *Moderation) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Moderation) GetMaxRejected() int32 {
+	if x != nil {
+		return x.MaxRejected
+	}
+	return 0
+}
+
+// 依赖的下游服务
+type Client struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrderEndpoint   string                 `protobuf:"bytes,1,opt,name=order_endpoint,json=orderEndpoint,proto3" json:"order_endpoint,omitempty"`       // 订单服务地址,比如 discovery:///order.service
+	ProductEndpoint string                 `protobuf:"bytes,2,opt,name=product_endpoint,json=productEndpoint,proto3" json:"product_endpoint,omitempty"` // 商品服务地址,比如 discovery:///product.service
+	Timeout         *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Client) Reset() {
+	*x = Client{}
+	mi := &file_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Snowflake) String() string {
+func (x *Client) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Snowflake) ProtoMessage() {}
+func (*Client) ProtoMessage() {}
 
-func (x *Snowflake) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[3]
+func (x *Client) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,40 +951,57 @@ func (x *Snowflake) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Snowflake.ProtoReflect.Descriptor instead.
-func (*Snowflake) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Client.ProtoReflect.Descriptor instead.
+func (*Client) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Snowflake) GetMachineId() int64 {
+func (x *Client) GetOrderEndpoint() string {
 	if x != nil {
-		return x.MachineId
+		return x.OrderEndpoint
 	}
-	return 0
+	return ""
 }
 
-type Registry struct {
+func (x *Client) GetProductEndpoint() string {
+	if x != nil {
+		return x.ProductEndpoint
+	}
+	return ""
+}
+
+func (x *Client) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// review-task 消费canal binlog消息用的kafka配置
+type Kafka struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Consul        *Registry_Consul       `protobuf:"bytes,1,opt,name=consul,proto3" json:"consul,omitempty"`
+	Brokers       []string               `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	GroupId       string                 `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Registry) Reset() {
-	*x = Registry{}
-	mi := &file_conf_proto_msgTypes[4]
+func (x *Kafka) Reset() {
+	*x = Kafka{}
+	mi := &file_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Registry) String() string {
+func (x *Kafka) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Registry) ProtoMessage() {}
+func (*Kafka) ProtoMessage() {}
 
-func (x *Registry) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[4]
+func (x *Kafka) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -270,40 +1012,58 @@ func (x *Registry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Registry.ProtoReflect.Descriptor instead.
-func (*Registry) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Kafka.ProtoReflect.Descriptor instead.
+func (*Kafka) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Registry) GetConsul() *Registry_Consul {
+func (x *Kafka) GetBrokers() []string {
 	if x != nil {
-		return x.Consul
+		return x.Brokers
 	}
 	return nil
 }
 
-type Elasticsearch struct {
+func (x *Kafka) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *Kafka) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+// 评价变更事件投递配置
+type Event struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Addresses     []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Brokers       []string               `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	RelayInterval *durationpb.Duration   `protobuf:"bytes,3,opt,name=relay_interval,json=relayInterval,proto3" json:"relay_interval,omitempty"` // outbox轮询间隔
+	MaxRetry      int32                  `protobuf:"varint,4,opt,name=max_retry,json=maxRetry,proto3" json:"max_retry,omitempty"`               // 投递失败多少次之后标记为死信,默认10
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Elasticsearch) Reset() {
-	*x = Elasticsearch{}
-	mi := &file_conf_proto_msgTypes[5]
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Elasticsearch) String() string {
+func (x *Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Elasticsearch) ProtoMessage() {}
+func (*Event) ProtoMessage() {}
 
-func (x *Elasticsearch) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[5]
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -314,18 +1074,39 @@ func (x *Elasticsearch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Elasticsearch.ProtoReflect.Descriptor instead.
-func (*Elasticsearch) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Elasticsearch) GetAddresses() []string {
+func (x *Event) GetBrokers() []string {
 	if x != nil {
-		return x.Addresses
+		return x.Brokers
+	}
+	return nil
+}
+
+func (x *Event) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *Event) GetRelayInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RelayInterval
 	}
 	return nil
 }
 
+func (x *Event) GetMaxRetry() int32 {
+	if x != nil {
+		return x.MaxRetry
+	}
+	return 0
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -337,7 +1118,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_proto_msgTypes[6]
+	mi := &file_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -349,7 +1130,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[6]
+	mi := &file_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +1178,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -409,7 +1190,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,6 +1227,75 @@ func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+// 调用方身份认证
+type Server_Auth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JwtSecret     string                 `protobuf:"bytes,1,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`           // HS256签名密钥,必须配置,通过环境变量REVIEW_JWT_SECRET注入
+	TrustGateway  bool                   `protobuf:"varint,2,opt,name=trust_gateway,json=trustGateway,proto3" json:"trust_gateway,omitempty"` // 是否信任网关透传的X-Caller-*请求头,只有部署在网关后面时才能打开
+	Issuer        string                 `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`                                  // 配置后校验token的iss
+	Audience      string                 `protobuf:"bytes,4,opt,name=audience,proto3" json:"audience,omitempty"`                              // 配置后校验token的aud
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Auth) Reset() {
+	*x = Server_Auth{}
+	mi := &file_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Auth) ProtoMessage() {}
+
+func (x *Server_Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Auth.ProtoReflect.Descriptor instead.
+func (*Server_Auth) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *Server_Auth) GetJwtSecret() string {
+	if x != nil {
+		return x.JwtSecret
+	}
+	return ""
+}
+
+func (x *Server_Auth) GetTrustGateway() bool {
+	if x != nil {
+		return x.TrustGateway
+	}
+	return false
+}
+
+func (x *Server_Auth) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *Server_Auth) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
 type Data_Database struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
@@ -456,7 +1306,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -468,7 +1318,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -510,7 +1360,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_proto_msgTypes[9]
+	mi := &file_conf_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -522,7 +1372,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[9]
+	mi := &file_conf_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -576,7 +1426,7 @@ type Registry_Consul struct {
 
 func (x *Registry_Consul) Reset() {
 	*x = Registry_Consul{}
-	mi := &file_conf_proto_msgTypes[10]
+	mi := &file_conf_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -588,7 +1438,7 @@ func (x *Registry_Consul) String() string {
 func (*Registry_Consul) ProtoMessage() {}
 
 func (x *Registry_Consul) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[10]
+	mi := &file_conf_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,15 +1474,20 @@ const file_conf_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
 	"conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xd3\x01\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xfd\x02\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x123\n" +
 	"\tsnowflake\x18\x03 \x01(\v2\x15.kratos.api.SnowflakeR\tsnowflake\x12?\n" +
-	"\relasticsearch\x18\x04 \x01(\v2\x19.kratos.api.ElasticsearchR\relasticsearch\"\xb8\x02\n" +
+	"\relasticsearch\x18\x04 \x01(\v2\x19.kratos.api.ElasticsearchR\relasticsearch\x12*\n" +
+	"\x06review\x18\x05 \x01(\v2\x12.kratos.api.ReviewR\x06review\x12*\n" +
+	"\x06client\x18\x06 \x01(\v2\x12.kratos.api.ClientR\x06client\x12'\n" +
+	"\x05kafka\x18\a \x01(\v2\x11.kratos.api.KafkaR\x05kafka\x12'\n" +
+	"\x05event\x18\b \x01(\v2\x11.kratos.api.EventR\x05event\"\xe5\x03\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12+\n" +
+	"\x04auth\x18\x03 \x01(\v2\x17.kratos.api.Server.AuthR\x04auth\x1ai\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
@@ -640,7 +1495,13 @@ const file_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xdd\x02\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a~\n" +
+	"\x04Auth\x12\x1d\n" +
+	"\n" +
+	"jwt_secret\x18\x01 \x01(\tR\tjwtSecret\x12#\n" +
+	"\rtrust_gateway\x18\x02 \x01(\bR\ftrustGateway\x12\x16\n" +
+	"\x06issuer\x18\x03 \x01(\tR\x06issuer\x12\x1a\n" +
+	"\baudience\x18\x04 \x01(\tR\baudience\"\xdd\x02\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x1a:\n" +
@@ -661,7 +1522,81 @@ const file_conf_proto_rawDesc = "" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
 	"\x06scheme\x18\x02 \x01(\tR\x06scheme\"-\n" +
 	"\rElasticsearch\x12\x1c\n" +
-	"\taddresses\x18\x01 \x03(\tR\taddressesB#Z!review-service/internal/conf;confb\x06proto3"
+	"\taddresses\x18\x01 \x03(\tR\taddresses\"\xa5\x03\n" +
+	"\x06Review\x12>\n" +
+	"\rupdate_window\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\fupdateWindow\x12)\n" +
+	"\x10anonymous_secret\x18\x02 \x01(\tR\x0fanonymousSecret\x126\n" +
+	"\n" +
+	"moderation\x18\x03 \x01(\v2\x16.kratos.api.ModerationR\n" +
+	"moderation\x12'\n" +
+	"\x05media\x18\x04 \x01(\v2\x11.kratos.api.MediaR\x05media\x12*\n" +
+	"\x06upload\x18\x05 \x01(\v2\x12.kratos.api.UploadR\x06upload\x12!\n" +
+	"\x03tag\x18\x06 \x01(\v2\x0f.kratos.api.TagR\x03tag\x12>\n" +
+	"\rappend_window\x18\a \x01(\v2\x19.google.protobuf.DurationR\fappendWindow\x12@\n" +
+	"\x0edefault_review\x18\b \x01(\v2\x19.kratos.api.DefaultReviewR\rdefaultReview\"\xd0\x01\n" +
+	"\rDefaultReview\x12\x16\n" +
+	"\x06enable\x18\x01 \x01(\bR\x06enable\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12#\n" +
+	"\rlookback_days\x18\x03 \x01(\x05R\flookbackDays\x125\n" +
+	"\binterval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x05 \x01(\x05R\tbatchSize\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\tR\acontent\"X\n" +
+	"\x03Tag\x12\x1b\n" +
+	"\tdict_file\x18\x01 \x01(\tR\bdictFile\x12\x19\n" +
+	"\bauto_tag\x18\x02 \x01(\bR\aautoTag\x12\x19\n" +
+	"\bmax_tags\x18\x03 \x01(\x05R\amaxTags\"\xac\x03\n" +
+	"\x06Upload\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x17\n" +
+	"\ause_ssl\x18\x02 \x01(\bR\x06useSsl\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x16\n" +
+	"\x06bucket\x18\x04 \x01(\tR\x06bucket\x12\x1d\n" +
+	"\n" +
+	"access_key\x18\x05 \x01(\tR\taccessKey\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x06 \x01(\tR\tsecretKey\x12\x1d\n" +
+	"\n" +
+	"public_url\x18\a \x01(\tR\tpublicUrl\x121\n" +
+	"\x06expire\x18\b \x01(\v2\x19.google.protobuf.DurationR\x06expire\x12$\n" +
+	"\x0emax_image_size\x18\t \x01(\x03R\fmaxImageSize\x12$\n" +
+	"\x0emax_video_size\x18\n" +
+	" \x01(\x03R\fmaxVideoSize\x12\x1f\n" +
+	"\vimage_types\x18\v \x03(\tR\n" +
+	"imageTypes\x12\x1f\n" +
+	"\vvideo_types\x18\f \x03(\tR\n" +
+	"videoTypes\x12\x1f\n" +
+	"\vdaily_quota\x18\r \x01(\x05R\n" +
+	"dailyQuota\"j\n" +
+	"\x05Media\x12#\n" +
+	"\rallowed_hosts\x18\x01 \x03(\tR\fallowedHosts\x12\x1d\n" +
+	"\n" +
+	"max_images\x18\x02 \x01(\x05R\tmaxImages\x12\x1d\n" +
+	"\n" +
+	"max_videos\x18\x03 \x01(\x05R\tmaxVideos\"\xb9\x02\n" +
+	"\n" +
+	"Moderation\x12\x1b\n" +
+	"\tword_file\x18\x01 \x01(\tR\bwordFile\x12\x14\n" +
+	"\x05words\x18\x02 \x03(\tR\x05words\x12B\n" +
+	"\x0freload_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0ereloadInterval\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1d\n" +
+	"\n" +
+	"auto_audit\x18\x05 \x01(\bR\tautoAudit\x12%\n" +
+	"\x0emedia_endpoint\x18\x06 \x01(\tR\rmediaEndpoint\x123\n" +
+	"\atimeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12!\n" +
+	"\fmax_rejected\x18\b \x01(\x05R\vmaxRejected\"\x8f\x01\n" +
+	"\x06Client\x12%\n" +
+	"\x0eorder_endpoint\x18\x01 \x01(\tR\rorderEndpoint\x12)\n" +
+	"\x10product_endpoint\x18\x02 \x01(\tR\x0fproductEndpoint\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"R\n" +
+	"\x05Kafka\x12\x18\n" +
+	"\abrokers\x18\x01 \x03(\tR\abrokers\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\x19\n" +
+	"\bgroup_id\x18\x03 \x01(\tR\agroupId\"\x96\x01\n" +
+	"\x05Event\x12\x18\n" +
+	"\abrokers\x18\x01 \x03(\tR\abrokers\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12@\n" +
+	"\x0erelay_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rrelayInterval\x12\x1b\n" +
+	"\tmax_retry\x18\x04 \x01(\x05R\bmaxRetryB#Z!review-service/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_proto_rawDescOnce sync.Once
@@ -675,7 +1610,7 @@ func file_conf_proto_rawDescGZIP() []byte {
 	return file_conf_proto_rawDescData
 }
 
-var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
@@ -683,32 +1618,60 @@ var file_conf_proto_goTypes = []any{
 	(*Snowflake)(nil),           // 3: kratos.api.Snowflake
 	(*Registry)(nil),            // 4: kratos.api.Registry
 	(*Elasticsearch)(nil),       // 5: kratos.api.Elasticsearch
-	(*Server_HTTP)(nil),         // 6: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 7: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 8: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 9: kratos.api.Data.Redis
-	(*Registry_Consul)(nil),     // 10: kratos.api.Registry.Consul
-	(*durationpb.Duration)(nil), // 11: google.protobuf.Duration
+	(*Review)(nil),              // 6: kratos.api.Review
+	(*DefaultReview)(nil),       // 7: kratos.api.DefaultReview
+	(*Tag)(nil),                 // 8: kratos.api.Tag
+	(*Upload)(nil),              // 9: kratos.api.Upload
+	(*Media)(nil),               // 10: kratos.api.Media
+	(*Moderation)(nil),          // 11: kratos.api.Moderation
+	(*Client)(nil),              // 12: kratos.api.Client
+	(*Kafka)(nil),               // 13: kratos.api.Kafka
+	(*Event)(nil),               // 14: kratos.api.Event
+	(*Server_HTTP)(nil),         // 15: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),         // 16: kratos.api.Server.GRPC
+	(*Server_Auth)(nil),         // 17: kratos.api.Server.Auth
+	(*Data_Database)(nil),       // 18: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 19: kratos.api.Data.Redis
+	(*Registry_Consul)(nil),     // 20: kratos.api.Registry.Consul
+	(*durationpb.Duration)(nil), // 21: google.protobuf.Duration
 }
 var file_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	3,  // 2: kratos.api.Bootstrap.snowflake:type_name -> kratos.api.Snowflake
 	5,  // 3: kratos.api.Bootstrap.elasticsearch:type_name -> kratos.api.Elasticsearch
-	6,  // 4: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	7,  // 5: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	8,  // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	9,  // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	10, // 8: kratos.api.Registry.consul:type_name -> kratos.api.Registry.Consul
-	11, // 9: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	11, // 10: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	11, // 11: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	11, // 12: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	6,  // 4: kratos.api.Bootstrap.review:type_name -> kratos.api.Review
+	12, // 5: kratos.api.Bootstrap.client:type_name -> kratos.api.Client
+	13, // 6: kratos.api.Bootstrap.kafka:type_name -> kratos.api.Kafka
+	14, // 7: kratos.api.Bootstrap.event:type_name -> kratos.api.Event
+	15, // 8: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	16, // 9: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	17, // 10: kratos.api.Server.auth:type_name -> kratos.api.Server.Auth
+	18, // 11: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	19, // 12: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	20, // 13: kratos.api.Registry.consul:type_name -> kratos.api.Registry.Consul
+	21, // 14: kratos.api.Review.update_window:type_name -> google.protobuf.Duration
+	11, // 15: kratos.api.Review.moderation:type_name -> kratos.api.Moderation
+	10, // 16: kratos.api.Review.media:type_name -> kratos.api.Media
+	9,  // 17: kratos.api.Review.upload:type_name -> kratos.api.Upload
+	8,  // 18: kratos.api.Review.tag:type_name -> kratos.api.Tag
+	21, // 19: kratos.api.Review.append_window:type_name -> google.protobuf.Duration
+	7,  // 20: kratos.api.Review.default_review:type_name -> kratos.api.DefaultReview
+	21, // 21: kratos.api.DefaultReview.interval:type_name -> google.protobuf.Duration
+	21, // 22: kratos.api.Upload.expire:type_name -> google.protobuf.Duration
+	21, // 23: kratos.api.Moderation.reload_interval:type_name -> google.protobuf.Duration
+	21, // 24: kratos.api.Moderation.timeout:type_name -> google.protobuf.Duration
+	21, // 25: kratos.api.Client.timeout:type_name -> google.protobuf.Duration
+	21, // 26: kratos.api.Event.relay_interval:type_name -> google.protobuf.Duration
+	21, // 27: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	21, // 28: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	21, // 29: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	21, // 30: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_conf_proto_init() }
@@ -722,7 +1685,7 @@ func file_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_proto_rawDesc), len(file_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,40 @@
+package conf
+
+import "fmt"
+
+// defaultSecrets 曾经提交在配置文件里的默认密钥,已经公开,不能用于任何环境
+var defaultSecrets = map[string]bool{
+	"review-jwt-secret":       true,
+	"review-anonymous-secret": true,
+	"minioadmin":              true,
+}
+
+type secret struct {
+	name  string
+	value string
+}
+
+// CheckSecrets 校验密钥已经配置且不是公开过的默认值,启动时调用,不满足时拒绝启动
+// 密钥不写在配置文件里,通过REVIEW_前缀的环境变量注入
+func CheckSecrets(bc *Bootstrap) error {
+	secrets := []secret{
+		{"server.auth.jwt_secret", bc.GetServer().GetAuth().GetJwtSecret()},
+		{"review.anonymous_secret", bc.GetReview().GetAnonymousSecret()},
+	}
+	// 没有配置对象存储时不提供上传功能,不需要密钥
+	if uc := bc.GetReview().GetUpload(); uc.GetEndpoint() != "" {
+		secrets = append(secrets,
+			secret{"review.upload.access_key", uc.GetAccessKey()},
+			secret{"review.upload.secret_key", uc.GetSecretKey()},
+		)
+	}
+	for _, s := range secrets {
+		if s.value == "" {
+			return fmt.Errorf("%s未配置", s.name)
+		}
+		if defaultSecrets[s.value] {
+			return fmt.Errorf("%s使用了公开的默认值,请更换", s.name)
+		}
+	}
+	return nil
+}
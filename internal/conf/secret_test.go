@@ -0,0 +1,43 @@
+package conf
+
+import "testing"
+
+func TestCheckSecrets(t *testing.T) {
+	valid := func() *Bootstrap {
+		return &Bootstrap{
+			Server: &Server{Auth: &Server_Auth{JwtSecret: "jwt-from-env"}},
+			Review: &Review{
+				AnonymousSecret: "anonymous-from-env",
+				Upload:          &Upload{Endpoint: "127.0.0.1:9000", AccessKey: "ak-from-env", SecretKey: "sk-from-env"},
+			},
+		}
+	}
+	if err := CheckSecrets(valid()); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]func(bc *Bootstrap){
+		"empty jwt secret":        func(bc *Bootstrap) { bc.Server.Auth.JwtSecret = "" },
+		"default jwt secret":      func(bc *Bootstrap) { bc.Server.Auth.JwtSecret = "review-jwt-secret" },
+		"empty anonymous secret":  func(bc *Bootstrap) { bc.Review.AnonymousSecret = "" },
+		"default anonymous":       func(bc *Bootstrap) { bc.Review.AnonymousSecret = "review-anonymous-secret" },
+		"empty upload secret key": func(bc *Bootstrap) { bc.Review.Upload.SecretKey = "" },
+		"default upload key":      func(bc *Bootstrap) { bc.Review.Upload.AccessKey = "minioadmin" },
+	}
+	for name, mutate := range cases {
+		t.Run(name, func(t *testing.T) {
+			bc := valid()
+			mutate(bc)
+			if err := CheckSecrets(bc); err == nil {
+				t.Fatal("want error")
+			}
+		})
+	}
+
+	// 没有配置对象存储时不需要上传密钥
+	bc := valid()
+	bc.Review.Upload = nil
+	if err := CheckSecrets(bc); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,96 @@
+package data
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+
+	v1 "review-service/api/review/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// SaveAppend 保存追评,追评和追评事件在同一个事务里写入
+// review_id上有唯一索引,并发追评时只有一个能成功
+func (r *reviewRepo) SaveAppend(ctx context.Context, info *model.ReviewAppendInfo) (*model.ReviewAppendInfo, error) {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		if err := tx.ReviewAppendInfo.WithContext(ctx).Create(info); err != nil {
+			return err
+		}
+		return saveOutbox(ctx, tx, info.ReviewID, biz.EventReviewAppended, info)
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, v1.ErrorAppendAlreadyExists("评价:%d已追评", info.ReviewID)
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("SaveAppend|Create fail, reviewId:%d, err:%v", info.ReviewID, err)
+		return nil, dbError(err)
+	}
+	return info, nil
+}
+
+// GetAppendByReviewId 查询评价的追评,没有追评时返回nil
+func (r *reviewRepo) GetAppendByReviewId(ctx context.Context, reviewId int64) (*model.ReviewAppendInfo, error) {
+	ra := r.data.query.ReviewAppendInfo
+	info, err := ra.WithContext(ctx).
+		Where(ra.ReviewID.Eq(reviewId), ra.DeleteAt.IsNull()).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("GetAppendByReviewId|First fail, reviewId:%d, err:%v", reviewId, err)
+		return nil, dbError(err)
+	}
+	return info, nil
+}
+
+// ListAppendByReviewIds 一次查出一页评价的追评,避免N+1查询
+func (r *reviewRepo) ListAppendByReviewIds(ctx context.Context, reviewIds []int64) (map[int64]*model.ReviewAppendInfo, error) {
+	ra := r.data.query.ReviewAppendInfo
+	list, err := ra.WithContext(ctx).
+		Where(ra.ReviewID.In(reviewIds...), ra.DeleteAt.IsNull()).
+		Find()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListAppendByReviewIds|Find fail, reviewIds:%v, err:%v", reviewIds, err)
+		return nil, dbError(err)
+	}
+	ret := make(map[int64]*model.ReviewAppendInfo, len(list))
+	for _, v := range list {
+		ret[v.ReviewID] = v
+	}
+	return ret, nil
+}
+
+// AuditAppend 运营审核追评
+// 带上status条件更新,防止两个运营同时审核同一条追评
+func (r *reviewRepo) AuditAppend(ctx context.Context, param *biz.AuditParam) error {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		ra := tx.ReviewAppendInfo
+		ret, err := ra.WithContext(ctx).
+			Where(
+				ra.ReviewID.Eq(param.ReviewId),
+				ra.Status.Eq(int32(biz.PendingReview)),
+				ra.DeleteAt.IsNull(),
+			).
+			UpdateColumns(map[string]interface{}{
+				"status":     param.Status,
+				"op_user":    param.OpUser,
+				"op_reason":  param.OpReason,
+				"op_remarks": param.OpRemarks,
+				"version":    gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			r.log.WithContext(ctx).Errorf("AuditAppend|UpdateColumns fail, reviewId:%d, err:%v", param.ReviewId, err)
+			return err
+		}
+		if ret.RowsAffected == 0 {
+			return v1.ErrorNeedRetry("追评状态已变更,请刷新后重试")
+		}
+		return saveOutbox(ctx, tx, param.ReviewId, biz.EventReviewAppendAudited, param)
+	})
+	return dbError(err)
+}
@@ -0,0 +1,106 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+)
+
+// 两个请求都通过了"还没有追评"的检查时,由uk_append_review_id挡住后写入的一个
+func TestSaveAppendUniqueKey(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	if _, err := env.repo.SaveAppend(ctx, &model.ReviewAppendInfo{AppendID: 1, ReviewID: 10, UserID: 9, StoreID: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := env.repo.SaveAppend(ctx, &model.ReviewAppendInfo{AppendID: 2, ReviewID: 10, UserID: 9, StoreID: 3}); !v1.IsAppendAlreadyExists(err) {
+		t.Fatalf("err = %v, want APPEND_ALREADY_EXISTS", err)
+	}
+	// 插入失败的事务不会留下事件
+	if n := len(outboxRows(t, env)); n != 1 {
+		t.Fatalf("outbox rows = %d, want 1", n)
+	}
+	info, err := env.repo.GetAppendByReviewId(ctx, 10)
+	if err != nil || info.AppendID != 1 {
+		t.Fatalf("append = %+v, err = %v", info, err)
+	}
+	if info, err := env.repo.GetAppendByReviewId(ctx, 11); err != nil || info != nil {
+		t.Fatalf("append = %+v, err = %v, want nil", info, err)
+	}
+}
+
+func TestAppendReviewWindow(t *testing.T) {
+	env := newTestEnv(t, nil)
+	uc, _ := newTestUsecase(t, env, &conf.Review{}, nil, nil)
+	now := time.Now()
+	for _, row := range []*model.ReviewInfo{
+		{ReviewID: 1, UserID: 9, OrderID: 1, StoreID: 3, Status: int32(biz.Approved), CreateAt: now.Add(-179 * 24 * time.Hour)},
+		{ReviewID: 2, UserID: 9, OrderID: 2, StoreID: 3, Status: int32(biz.Approved), CreateAt: now.Add(-181 * 24 * time.Hour)},
+	} {
+		if err := env.db.Create(row).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx := biz.NewCallerContext(context.Background(), &biz.Caller{Role: biz.RoleUser, UserId: 9})
+	// 没有配置时默认180天内可以追评
+	if _, err := uc.AppendReview(ctx, &biz.AppendParam{ReviewId: 2, Content: "追评"}); !v1.IsAppendWindowExpired(err) {
+		t.Fatalf("err = %v, want APPEND_WINDOW_EXPIRED", err)
+	}
+	if _, err := uc.AppendReview(ctx, &biz.AppendParam{ReviewId: 1, Content: "用了半年还不错"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.AppendReview(ctx, &biz.AppendParam{ReviewId: 1, Content: "再追一次"}); !v1.IsAppendAlreadyExists(err) {
+		t.Fatalf("err = %v, want APPEND_ALREADY_EXISTS", err)
+	}
+	other := biz.NewCallerContext(context.Background(), &biz.Caller{Role: biz.RoleUser, UserId: 8})
+	if _, err := uc.AppendReview(other, &biz.AppendParam{ReviewId: 2, Content: "追评"}); !v1.IsForbiddenUser(err) {
+		t.Fatalf("err = %v, want FORBIDDEN_USER", err)
+	}
+}
+
+// 审核带上status条件,已经审核过的追评再审核返回NEED_RETRY
+func TestAuditAppendOnce(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	if _, err := env.repo.SaveAppend(ctx, &model.ReviewAppendInfo{AppendID: 1, ReviewID: 10, UserID: 9, StoreID: 3, Status: int32(biz.PendingReview)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := env.repo.AuditAppend(ctx, &biz.AuditParam{ReviewId: 10, Status: int32(biz.Approved), OpUser: "op1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := env.repo.AuditAppend(ctx, &biz.AuditParam{ReviewId: 10, Status: int32(biz.ReviewNotApproved), OpUser: "op2", OpReason: "广告"}); !v1.IsNeedRetry(err) {
+		t.Fatalf("err = %v, want NEED_RETRY", err)
+	}
+	info, err := env.repo.GetAppendByReviewId(ctx, 10)
+	if err != nil || info.Status != int32(biz.Approved) || info.OpUser != "op1" {
+		t.Fatalf("append = %+v, err = %v", info, err)
+	}
+}
+
+// 批量查询追评按review_id返回,不返回已经删除的追评
+func TestListAppendByReviewIds(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	deleteAt := time.Now()
+	for _, row := range []*model.ReviewAppendInfo{
+		{AppendID: 1, ReviewID: 10, UserID: 9, StoreID: 3},
+		{AppendID: 2, ReviewID: 11, UserID: 9, StoreID: 3, DeleteAt: &deleteAt},
+		{AppendID: 3, ReviewID: 12, UserID: 9, StoreID: 3},
+	} {
+		if err := env.db.Create(row).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	appends, err := env.repo.ListAppendByReviewIds(ctx, []int64{10, 11, 13})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(appends) != 1 || appends[10] == nil || appends[10].AppendID != 1 {
+		t.Fatalf("appends = %+v", appends)
+	}
+}
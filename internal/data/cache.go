@@ -0,0 +1,108 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// 评价缓存
+// 单条评价: review:info:{reviewId} -> 评价json,查不到的评价缓存占位符,防止缓存穿透
+// 商家评价列表: review:store:{storeId} hash, field是 {offset}:{limit},只缓存前几页
+// 写操作(回复、申诉审核、运营审核、修改、删除)之后直接删除缓存
+const (
+	reviewCacheTTL      = 10 * time.Minute
+	storeListCacheTTL   = time.Minute
+	negativeCacheTTL    = 30 * time.Second
+	storeListCachePages = 3
+	cacheNotFound       = "-"
+)
+
+func reviewCacheKey(reviewId int64) string {
+	return fmt.Sprintf("review:info:%d", reviewId)
+}
+
+func storeListCacheKey(storeId int64) string {
+	return fmt.Sprintf("review:store:%d", storeId)
+}
+
+// withJitter 过期时间加上随机抖动,避免大量key同时过期导致缓存雪崩
+func withJitter(ttl time.Duration) time.Duration {
+	return ttl + time.Duration(rand.Int63n(int64(ttl/10)+1))
+}
+
+// getCache 读取缓存,redis出错时当作未命中处理,保证缓存不可用时还能查库
+func (r *reviewRepo) getCache(ctx context.Context, key string) (string, bool) {
+	val, err := r.data.rdb.Get(ctx, key).Result()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			r.log.WithContext(ctx).Warnf("getCache fail, key:%s, err:%v", key, err)
+		}
+		return "", false
+	}
+	return val, true
+}
+
+func (r *reviewRepo) setCache(ctx context.Context, key string, val interface{}, ttl time.Duration) {
+	if err := r.data.rdb.Set(ctx, key, val, withJitter(ttl)).Err(); err != nil {
+		r.log.WithContext(ctx).Warnf("setCache fail, key:%s, err:%v", key, err)
+	}
+}
+
+func (r *reviewRepo) getStoreListCache(ctx context.Context, storeId int64, field string) ([]json.RawMessage, bool) {
+	val, err := r.data.rdb.HGet(ctx, storeListCacheKey(storeId), field).Result()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			r.log.WithContext(ctx).Warnf("getStoreListCache fail, storeId:%d, err:%v", storeId, err)
+		}
+		return nil, false
+	}
+	var list []json.RawMessage
+	if err := json.Unmarshal([]byte(val), &list); err != nil {
+		return nil, false
+	}
+	return list, true
+}
+
+func (r *reviewRepo) setStoreListCache(ctx context.Context, storeId int64, field string, list []json.RawMessage) {
+	b, err := json.Marshal(list)
+	if err != nil {
+		return
+	}
+	key := storeListCacheKey(storeId)
+	_, err = r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, field, b)
+		pipe.Expire(ctx, key, withJitter(storeListCacheTTL))
+		return nil
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("setStoreListCache fail, storeId:%d, err:%v", storeId, err)
+	}
+}
+
+// invalidateReviewCache 删除评价和所属商家评价列表的缓存
+// storeId为0时从数据库里查一下评价所属的商家
+func (r *reviewRepo) invalidateReviewCache(ctx context.Context, reviewId, storeId int64) {
+	keys := []string{reviewCacheKey(reviewId)}
+	if storeId == 0 {
+		review, err := r.data.query.ReviewInfo.
+			WithContext(ctx).
+			Select(r.data.query.ReviewInfo.StoreID).
+			Where(r.data.query.ReviewInfo.ReviewID.Eq(reviewId)).
+			First()
+		if err == nil {
+			storeId = review.StoreID
+		}
+	}
+	if storeId != 0 {
+		keys = append(keys, storeListCacheKey(storeId))
+	}
+	if err := r.data.rdb.Del(ctx, keys...).Err(); err != nil {
+		r.log.WithContext(ctx).Errorf("invalidateReviewCache fail, reviewId:%d, err:%v", reviewId, err)
+	}
+}
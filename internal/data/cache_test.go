@@ -0,0 +1,298 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+)
+
+// countQueries 统计review_info表的查询次数,每次查询sleep一会儿,让并发请求在回源时重叠
+func countQueries(t *testing.T, db *gorm.DB, delay time.Duration) *int64 {
+	t.Helper()
+	var n int64
+	err := db.Callback().Query().Before("gorm:query").Register("test:count", func(tx *gorm.DB) {
+		if tx.Statement.Table == model.TableNameReviewInfo {
+			atomic.AddInt64(&n, 1)
+			time.Sleep(delay)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &n
+}
+
+func TestGetReviewCacheAside(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	queries := countQueries(t, env.db, 0)
+	review := &model.ReviewInfo{ReviewID: 100, StoreID: 3, Content: "手感很好", Status: int32(biz.PendingReview)}
+	if err := env.db.Create(review).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := env.repo.GetReview(ctx, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Content != "手感很好" {
+		t.Fatalf("content = %q", got.Content)
+	}
+	if !env.mr.Exists(reviewCacheKey(100)) {
+		t.Fatal("review not cached")
+	}
+	if ttl := env.mr.TTL(reviewCacheKey(100)); ttl < reviewCacheTTL || ttl > reviewCacheTTL+reviewCacheTTL/10 {
+		t.Fatalf("ttl = %v", ttl)
+	}
+
+	// 绕过repo直接改库,缓存命中时读到的还是旧值
+	env.db.Model(&model.ReviewInfo{}).Where("review_id = ?", 100).Update("content", "改过了")
+	if got, _ = env.repo.GetReview(ctx, 100); got.Content != "手感很好" {
+		t.Fatalf("content = %q, want cached value", got.Content)
+	}
+	if n := atomic.LoadInt64(queries); n != 1 {
+		t.Fatalf("db queries = %d, want 1", n)
+	}
+
+	// 调用方修改返回值不影响缓存
+	got.Content = "调用方改的"
+	if got, _ = env.repo.GetReview(ctx, 100); got.Content != "手感很好" {
+		t.Fatalf("content = %q, want cached value", got.Content)
+	}
+}
+
+func TestGetReviewNegativeCache(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	queries := countQueries(t, env.db, 0)
+
+	for i := 0; i < 3; i++ {
+		if _, err := env.repo.GetReview(ctx, 200); !v1.IsReviewNotFound(err) {
+			t.Fatalf("err = %v, want REVIEW_NOT_FOUND", err)
+		}
+	}
+	if n := atomic.LoadInt64(queries); n != 1 {
+		t.Fatalf("db queries = %d, want 1", n)
+	}
+	if val, _ := env.mr.Get(reviewCacheKey(200)); val != cacheNotFound {
+		t.Fatalf("cache = %q, want placeholder", val)
+	}
+
+	// 占位符过期之前新写入的评价查不到,过期之后回源
+	if err := env.db.Create(&model.ReviewInfo{ReviewID: 200, StoreID: 3}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := env.repo.GetReview(ctx, 200); !v1.IsReviewNotFound(err) {
+		t.Fatalf("err = %v, want REVIEW_NOT_FOUND before placeholder expires", err)
+	}
+	env.mr.FastForward(negativeCacheTTL + negativeCacheTTL/10 + time.Second)
+	if _, err := env.repo.GetReview(ctx, 200); err != nil {
+		t.Fatalf("err = %v after placeholder expired", err)
+	}
+}
+
+func TestGetReviewSingleflight(t *testing.T) {
+	env := newTestEnv(t, nil)
+	queries := countQueries(t, env.db, 50*time.Millisecond)
+	if err := env.db.Create(&model.ReviewInfo{ReviewID: 300, StoreID: 3}).Error; err != nil {
+		t.Fatal(err)
+	}
+	atomic.StoreInt64(queries, 0)
+
+	const n = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			review, err := env.repo.GetReview(context.Background(), 300)
+			if err == nil && review.ReviewID != 300 {
+				err = fmt.Errorf("reviewId = %d", review.ReviewID)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := atomic.LoadInt64(queries); got != 1 {
+		t.Fatalf("db queries = %d, want 1", got)
+	}
+}
+
+func TestGetReviewRedisDown(t *testing.T) {
+	env := newTestEnv(t, nil)
+	if err := env.db.Create(&model.ReviewInfo{ReviewID: 400, StoreID: 3}).Error; err != nil {
+		t.Fatal(err)
+	}
+	env.mr.Close()
+	if _, err := env.repo.GetReview(context.Background(), 400); err != nil {
+		t.Fatalf("err = %v, want fallback to db", err)
+	}
+}
+
+func TestWriteInvalidatesCache(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	review := &model.ReviewInfo{ReviewID: 500, StoreID: 3, Content: "手感很好", Status: int32(biz.PendingReview)}
+	if err := env.db.Create(review).Error; err != nil {
+		t.Fatal(err)
+	}
+	storeKey := storeListCacheKey(3)
+
+	warm := func() {
+		t.Helper()
+		if _, err := env.repo.GetReview(ctx, 500); err != nil {
+			t.Fatal(err)
+		}
+		env.mr.HSet(storeKey, "0:10", "[]")
+	}
+
+	warm()
+	err := env.repo.AuditReview(ctx, &biz.AuditParam{ReviewId: 500, Status: int32(biz.Approved), OpUser: "op"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.mr.Exists(reviewCacheKey(500)) || env.mr.Exists(storeKey) {
+		t.Fatal("AuditReview did not invalidate cache")
+	}
+	got, err := env.repo.GetReview(ctx, 500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != int32(biz.Approved) {
+		t.Fatalf("status = %d, want approved", got.Status)
+	}
+
+	warm()
+	got.Content = "改过了"
+	if err := env.repo.UpdateReview(ctx, got); err != nil {
+		t.Fatal(err)
+	}
+	if env.mr.Exists(reviewCacheKey(500)) || env.mr.Exists(storeKey) {
+		t.Fatal("UpdateReview did not invalidate cache")
+	}
+	if got, _ = env.repo.GetReview(ctx, 500); got.Content != "改过了" {
+		t.Fatalf("content = %q after update", got.Content)
+	}
+
+	warm()
+	if err := env.repo.DeleteReview(ctx, 500); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := env.repo.GetReview(ctx, 500); !v1.IsReviewNotFound(err) {
+		t.Fatalf("err = %v after delete, want REVIEW_NOT_FOUND", err)
+	}
+}
+
+func approvedStatus() *int32 {
+	s := int32(biz.Approved)
+	return &s
+}
+
+func TestStoreListCache(t *testing.T) {
+	var searches int64
+	env := newTestEnv(t, esHandler(func(r *http.Request) string {
+		atomic.AddInt64(&searches, 1)
+		return `{"took":1,"timed_out":false,"_shards":{"total":1,"successful":1,"skipped":0,"failed":0},
+			"hits":{"total":{"value":1,"relation":"eq"},"hits":[
+				{"_index":"review","_id":"600","_score":1,"_source":{"review_id":"600","store_id":"3","content":"手感很好"}}]}}`
+	}))
+	ctx := context.Background()
+	list := func(offset, limit int) {
+		t.Helper()
+		ret, err := env.repo.ListReviewByStoreId(ctx, 3, approvedStatus(), nil, offset, limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ret) != 1 || ret[0].Content != "手感很好" {
+			t.Fatalf("list = %+v", ret)
+		}
+	}
+
+	list(0, 10)
+	list(0, 10)
+	if n := atomic.LoadInt64(&searches); n != 1 {
+		t.Fatalf("es searches = %d, want 1", n)
+	}
+	if !env.mr.Exists(storeListCacheKey(3)) {
+		t.Fatal("store list not cached")
+	}
+
+	// 超过缓存页数的分页直接查ES
+	list(storeListCachePages*10, 10)
+	list(storeListCachePages*10, 10)
+	if n := atomic.LoadInt64(&searches); n != 3 {
+		t.Fatalf("es searches = %d, want 3", n)
+	}
+
+	env.repo.invalidateReviewCache(ctx, 600, 3)
+	list(0, 10)
+	if n := atomic.LoadInt64(&searches); n != 4 {
+		t.Fatalf("es searches = %d after invalidate, want 4", n)
+	}
+}
+
+// 按标签筛选的店铺评价列表不走缓存,查询条件带上所有标签
+func TestStoreListByTags(t *testing.T) {
+	var (
+		searches int64
+		body     atomic.Value
+	)
+	env := newTestEnv(t, esHandler(func(r *http.Request) string {
+		atomic.AddInt64(&searches, 1)
+		b, _ := io.ReadAll(r.Body)
+		body.Store(string(b))
+		return `{"took":1,"timed_out":false,"_shards":{"total":1,"successful":1,"skipped":0,"failed":0},
+			"hits":{"total":{"value":0,"relation":"eq"},"hits":[]}}`
+	}))
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, err := env.repo.ListReviewByStoreId(ctx, 3, approvedStatus(), []string{"物流快", "性价比高"}, 0, 10); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := atomic.LoadInt64(&searches); n != 2 {
+		t.Fatalf("es searches = %d, want 2", n)
+	}
+	if env.mr.Exists(storeListCacheKey(3)) {
+		t.Fatal("tag filtered list cached")
+	}
+	q := body.Load().(string)
+	for _, tag := range []string{"物流快", "性价比高"} {
+		if !strings.Contains(q, `"tags":{"value":"`+tag+`"}`) {
+			t.Fatalf("query = %s, want tag %s", q, tag)
+		}
+	}
+	if !strings.Contains(q, `"status":{"value":20}`) {
+		t.Fatalf("query = %s, want approved only", q)
+	}
+
+	// 运营查看所有状态的评价,不走缓存,也不按状态筛选
+	if _, err := env.repo.ListReviewByStoreId(ctx, 3, nil, nil, 0, 10); err != nil {
+		t.Fatal(err)
+	}
+	if env.mr.Exists(storeListCacheKey(3)) {
+		t.Fatal("operator list cached")
+	}
+	if q = body.Load().(string); strings.Contains(q, `"status"`) {
+		t.Fatalf("query = %s, want no status filter", q)
+	}
+}
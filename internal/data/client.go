@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/hashicorp/consul/api"
+	ggrpc "google.golang.org/grpc"
+)
+
+// NewDiscovery 服务发现,用来调用订单服务和商品服务
+func NewDiscovery(conf *conf.Registry) registry.Discovery {
+	c := api.DefaultConfig()
+	c.Address = conf.Consul.Address
+	c.Scheme = conf.Consul.Scheme
+	client, err := api.NewClient(c)
+	if err != nil {
+		panic(err)
+	}
+	return consul.New(client)
+}
+
+// newGRPCConn 创建到下游服务的gRPC连接
+func newGRPCConn(endpoint string, c *conf.Client, r registry.Discovery) (*ggrpc.ClientConn, error) {
+	opts := []grpc.ClientOption{
+		grpc.WithEndpoint(endpoint),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(recovery.Recovery()),
+	}
+	if c.GetTimeout() != nil {
+		opts = append(opts, grpc.WithTimeout(c.GetTimeout().AsDuration()))
+	}
+	return grpc.DialInsecure(context.Background(), opts...)
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"review-service/internal/conf"
@@ -12,7 +13,8 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewReviewRepo, NewDB, NewESClient)
+var ProviderSet = wire.NewSet(NewData, NewReviewRepo, NewDB, NewESClient, NewRedisClient, NewDiscovery, NewOrderClient, NewOrderSource, NewProductClient,
+	NewEventPublisher, NewOutboxRelay, NewMediaModerator, NewObjectStorage)
 
 // Data .
 type Data struct {
@@ -21,16 +23,28 @@ type Data struct {
 	query *query.Query
 	log   *log.Helper
 	es    *elasticsearch.TypedClient
+	rdb   *redis.Client
 }
 
 // NewData .
-func NewData(db *gorm.DB, esClient *elasticsearch.TypedClient, logger log.Logger) (*Data, func(), error) {
+func NewData(db *gorm.DB, esClient *elasticsearch.TypedClient, rdb *redis.Client, logger log.Logger) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		_ = rdb.Close()
 	}
 	// 非常重要!为GEN生成的query代码设置数据库连接对象
 	query.SetDefault(db)
-	return &Data{query: query.Q, es: esClient, log: log.NewHelper(logger)}, cleanup, nil
+	return &Data{query: query.Q, es: esClient, rdb: rdb, log: log.NewHelper(logger)}, cleanup, nil
+}
+
+// NewRedisClient Redis Client 的构造函数
+func NewRedisClient(c *conf.Data) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Network:      c.Redis.Network,
+		Addr:         c.Redis.Addr,
+		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
+		WriteTimeout: c.Redis.WriteTimeout.AsDuration(),
+	})
 }
 
 // NewESClient ES Client 的构造函数
@@ -42,7 +56,8 @@ func NewESClient(cfg *conf.Elasticsearch) (*elasticsearch.TypedClient, error) {
 }
 
 func NewDB(c *conf.Data) (*gorm.DB, error) {
-	db, err := gorm.Open(mysql.Open(c.Database.Source))
+	// 把唯一索引冲突转换成gorm.ErrDuplicatedKey,不用区分数据库驱动的错误码
+	db, err := gorm.Open(mysql.Open(c.Database.Source), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, err
 	}
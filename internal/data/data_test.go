@@ -0,0 +1,105 @@
+package data
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/glebarez/sqlite"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"review-service/internal/biz"
+	"review-service/internal/biz/biztest"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"review-service/pkg/snowflake"
+)
+
+func init() {
+	if err := snowflake.InitSnowflake(1); err != nil {
+		panic(err)
+	}
+}
+
+// testEnv 单元测试用的数据层: sqlite内存库 + miniredis + 可选的假ES
+type testEnv struct {
+	db   *gorm.DB
+	mr   *miniredis.Miniredis
+	data *Data
+	repo *reviewRepo
+}
+
+// uniqueIndexes review.sql中的唯一索引,gen生成的model里没有索引信息,AutoMigrate不会创建
+var uniqueIndexes = []string{
+	"CREATE UNIQUE INDEX uk_order_id ON review_info(order_id)",
+	"CREATE UNIQUE INDEX uk_appeal_review_id ON review_appeal_info(review_id)",
+	"CREATE UNIQUE INDEX uk_append_review_id ON review_append_info(review_id)",
+}
+
+func newTestEnv(t *testing.T, es http.Handler) *testEnv {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		TranslateError: true,
+		Logger:         logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 内存库每个连接是独立的库,只用一个连接
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	if err := db.AutoMigrate(&model.ReviewInfo{}, &model.ReviewReplyInfo{}, &model.ReviewAppealInfo{},
+		&model.ReviewAppendInfo{}, &model.ReviewOutbox{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, sql := range uniqueIndexes {
+		if err := db.Exec(sql).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 和NewData一样设置默认query,部分方法直接用了query包里的表
+	query.SetDefault(db)
+	mr := miniredis.RunT(t)
+	d := &Data{
+		query: query.Use(db),
+		rdb:   redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+		log:   log.NewHelper(log.DefaultLogger),
+	}
+	if es != nil {
+		srv := httptest.NewServer(es)
+		t.Cleanup(srv.Close)
+		d.es, err = elasticsearch.NewTypedClient(elasticsearch.Config{Addresses: []string{srv.URL}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &testEnv{db: db, mr: mr, data: d, repo: NewReviewRepo(d, log.DefaultLogger).(*reviewRepo)}
+}
+
+// newTestUsecase 使用测试数据层的评价业务,商品服务里有一个sku 20,订单用返回的订单服务添加
+func newTestUsecase(t *testing.T, env *testEnv, c *conf.Review, moderation *biz.ModerationPipeline, storage biz.ObjectStorage) (*biz.ReviewUsecase, *biztest.FakeOrderClient) {
+	t.Helper()
+	tags, err := biz.NewTagDict(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orders := biztest.NewFakeOrderClient()
+	products := biztest.NewFakeProductClient(&biz.Sku{SkuID: 20, SpuID: 200, StoreID: 3})
+	filter := biz.NewContentFilterWithWords(biz.FilterActionReview, "加微信")
+	return biz.NewReviewUsecase(c, env.repo, orders, products, filter, moderation, storage, tags, log.DefaultLogger), orders
+}
+
+// esHandler 假ES,每个请求都返回同一个响应体
+func esHandler(body func(r *http.Request) string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body(r)))
+	})
+}
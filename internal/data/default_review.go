@@ -0,0 +1,44 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+)
+
+// SaveDefaultReview 保存默认好评
+// order_id上有唯一索引,订单已经有评价或者和用户同时提交评价时插入失败,只保存一条
+// 逻辑删除的评价还在表里,用户删除过的评价也算评价过,不再生成默认好评
+func (r *reviewRepo) SaveDefaultReview(ctx context.Context, review *model.ReviewInfo) (bool, error) {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		if err := tx.ReviewInfo.WithContext(ctx).Create(review); err != nil {
+			return err
+		}
+		return saveOutbox(ctx, tx, review.ReviewID, biz.EventReviewCreated, review)
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return false, nil
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("SaveDefaultReview fail, orderId:%d, err:%v", review.OrderID, err)
+		return false, dbError(err)
+	}
+	r.invalidateReviewCache(ctx, review.ReviewID, review.StoreID)
+	return true, nil
+}
+
+// AcquireJobLock 定时任务的分布式锁,review:job:{name}
+func (r *reviewRepo) AcquireJobLock(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	key := "review:job:" + name
+	ok, err := r.data.rdb.SetNX(ctx, key, time.Now().Format(time.DateTime), ttl).Result()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("AcquireJobLock fail, key:%s, err:%v", key, err)
+		return false, dbError(err)
+	}
+	return ok, nil
+}
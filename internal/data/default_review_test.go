@@ -0,0 +1,99 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/pkg/snowflake"
+)
+
+func countReviews(t *testing.T, env *testEnv, orderId int64) int64 {
+	t.Helper()
+	var n int64
+	if err := env.db.Model(&model.ReviewInfo{}).Where("order_id = ?", orderId).Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// 重复扫描、用户已经评价或者删除过评价的订单都不会再生成默认好评
+func TestDefaultReviewJobIdempotent(t *testing.T) {
+	env := newTestEnv(t, nil)
+	c := &conf.Review{DefaultReview: &conf.DefaultReview{Enable: true, Days: 15, LookbackDays: 3}}
+	uc, orders := newTestUsecase(t, env, c, nil, nil)
+	job := biz.NewDefaultReviewJob(c, uc, orders, log.DefaultLogger)
+	now := time.Now()
+	completeAt := now.Add(-16 * 24 * time.Hour)
+	for i := int64(1); i <= 3; i++ {
+		orders.Put(&biz.Order{OrderID: i, UserID: 9, StoreID: 3, SkuID: 20, Status: biz.OrderCompleted, CompleteAt: completeAt})
+	}
+	ctx := biz.NewCallerContext(context.Background(), &biz.Caller{Role: biz.RoleUser, UserId: 9})
+	// 订单2用户自己评价过,订单3评价后又删除了
+	if _, err := uc.CreateReview(ctx, &model.ReviewInfo{OrderID: 2, Score: 3, Content: "一般般吧"}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := uc.CreateReview(ctx, &model.ReviewInfo{OrderID: 3, Score: 1, Content: "不好用"}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := env.repo.DeleteReview(ctx, deleted.ReviewID); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, want := range []int{1, 0} {
+		created, err := job.RunOnce(context.Background(), now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if created != want {
+			t.Fatalf("run %d: created = %d, want %d", i, created, want)
+		}
+	}
+	for i := int64(1); i <= 3; i++ {
+		if n := countReviews(t, env, i); n != 1 {
+			t.Fatalf("order %d has %d reviews, want 1", i, n)
+		}
+	}
+}
+
+// 用户评价和默认好评同时写入时,后写入的一方被唯一索引挡住
+func TestSaveDefaultReviewRace(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	review := func(orderId int64) *model.ReviewInfo {
+		return &model.ReviewInfo{ReviewID: snowflake.GenerateID(), UserID: 9, OrderID: orderId, StoreID: 3, Score: 5}
+	}
+
+	// 默认好评先写入,用户的评价返回已评价
+	if created, err := env.repo.SaveDefaultReview(ctx, review(1)); err != nil || !created {
+		t.Fatalf("created = %v, err = %v", created, err)
+	}
+	if _, err := env.repo.SaveReview(ctx, review(1)); !v1.IsReviewAlreadyExists(err) {
+		t.Fatalf("err = %v, want REVIEW_ALREADY_EXISTS", err)
+	}
+
+	// 用户的评价先写入,默认好评跳过
+	if _, err := env.repo.SaveReview(ctx, review(2)); err != nil {
+		t.Fatal(err)
+	}
+	if created, err := env.repo.SaveDefaultReview(ctx, review(2)); err != nil || created {
+		t.Fatalf("created = %v, err = %v, want skipped", created, err)
+	}
+
+	for _, orderId := range []int64{1, 2} {
+		if n := countReviews(t, env, orderId); n != 1 {
+			t.Fatalf("order %d has %d reviews, want 1", orderId, n)
+		}
+	}
+	// 插入失败的事务不会留下事件
+	if n := len(outboxRows(t, env)); n != 2 {
+		t.Fatalf("outbox rows = %d, want 2", n)
+	}
+}
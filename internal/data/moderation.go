@@ -0,0 +1,80 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 图片视频审核服务的审核建议
+const (
+	suggestionPass   = "pass"
+	suggestionReview = "review"
+	suggestionBlock  = "block"
+)
+
+// mediaCheckRequest 图片视频审核服务的请求
+type mediaCheckRequest struct {
+	Type string   `json:"type"` // image/video
+	URLs []string `json:"urls"`
+}
+
+// mediaCheckResponse 图片视频审核服务的返回,suggestion是所有地址中最严重的审核建议
+type mediaCheckResponse struct {
+	Suggestion string `json:"suggestion"` // pass/review/block
+	Label      string `json:"label"`      // 命中的违规类型,比如porn、ad
+}
+
+type mediaModerator struct {
+	endpoint string
+	client   *http.Client
+	log      *log.Helper
+}
+
+// NewMediaModerator 通过HTTP调用图片视频审核服务,没有配置地址时返回nil,自动审核跳过图片视频检查
+func NewMediaModerator(c *conf.Review, logger log.Logger) biz.MediaModerator {
+	endpoint := c.GetModeration().GetMediaEndpoint()
+	if endpoint == "" {
+		return nil
+	}
+	return &mediaModerator{endpoint: endpoint, client: &http.Client{}, log: log.NewHelper(logger)}
+}
+
+func (m *mediaModerator) CheckMedia(ctx context.Context, typ biz.MediaType, urls []string) (biz.Verdict, string, error) {
+	body, err := json.Marshal(&mediaCheckRequest{Type: string(typ), URLs: urls})
+	if err != nil {
+		return "", "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("审核服务返回%d", resp.StatusCode)
+	}
+	var ret mediaCheckResponse
+	if err := json.NewDecoder(resp.Body).Decode(&ret); err != nil {
+		return "", "", err
+	}
+	switch ret.Suggestion {
+	case suggestionPass:
+		return biz.VerdictApprove, "", nil
+	case suggestionReview:
+		return biz.VerdictManual, fmt.Sprintf("%s疑似违规: %s", typ, ret.Label), nil
+	case suggestionBlock:
+		return biz.VerdictReject, fmt.Sprintf("%s违规: %s", typ, ret.Label), nil
+	}
+	return "", "", fmt.Errorf("未知的审核建议: %s", ret.Suggestion)
+}
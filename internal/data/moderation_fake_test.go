@@ -0,0 +1,70 @@
+package data
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+)
+
+// FakeModerationServer 本地的图片视频审核服务,单元测试用
+// 默认所有地址都通过,用Block/Review指定某个地址的审核建议,配合httptest.NewServer使用
+type FakeModerationServer struct {
+	mu       sync.Mutex
+	rules    map[string]mediaCheckResponse
+	requests []mediaCheckRequest
+}
+
+func NewFakeModerationServer() *FakeModerationServer {
+	return &FakeModerationServer{rules: make(map[string]mediaCheckResponse)}
+}
+
+// Block 地址审核不通过
+func (s *FakeModerationServer) Block(url, label string) {
+	s.set(url, suggestionBlock, label)
+}
+
+// Review 地址需要人工审核
+func (s *FakeModerationServer) Review(url, label string) {
+	s.set(url, suggestionReview, label)
+}
+
+func (s *FakeModerationServer) set(url, suggestion, label string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rules[url] = mediaCheckResponse{Suggestion: suggestion, Label: label}
+}
+
+// Requests 返回收到的请求
+func (s *FakeModerationServer) Requests() []mediaCheckRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]mediaCheckRequest(nil), s.requests...)
+}
+
+func (s *FakeModerationServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req mediaCheckRequest
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	// 多个地址取最严重的审核建议
+	ret := mediaCheckResponse{Suggestion: suggestionPass}
+	for _, u := range req.URLs {
+		rule, ok := s.rules[u]
+		if !ok {
+			continue
+		}
+		if rule.Suggestion == suggestionBlock || ret.Suggestion == suggestionPass {
+			ret = rule
+		}
+	}
+	s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(&ret)
+}
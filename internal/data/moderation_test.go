@@ -0,0 +1,273 @@
+package data
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+)
+
+const (
+	testImage = "https://img.review-media.example.com/1.jpg"
+	testVideo = "https://img.review-media.example.com/1.mp4"
+)
+
+var testModerationFilter = biz.NewContentFilterWithWords(biz.FilterActionReview, "加微信")
+
+// newModerationConf 开启自动审核的配置,图片视频审核服务用handler模拟
+func newModerationConf(t *testing.T, handler http.Handler, timeout time.Duration) *conf.Review {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return &conf.Review{
+		Moderation: &conf.Moderation{
+			AutoAudit:     true,
+			MediaEndpoint: srv.URL,
+			Timeout:       durationpb.New(timeout),
+			MaxRejected:   2,
+		},
+		Media: &conf.Media{AllowedHosts: []string{".review-media.example.com"}},
+	}
+}
+
+func newTestPipeline(t *testing.T, env *testEnv, handler http.Handler, timeout time.Duration) *biz.ModerationPipeline {
+	t.Helper()
+	c := newModerationConf(t, handler, timeout)
+	return biz.NewModerationPipelineFromConf(c, testModerationFilter, NewMediaModerator(c, log.DefaultLogger), env.repo, log.DefaultLogger)
+}
+
+var testMedia = []*biz.MediaItem{
+	{URL: testImage, Type: biz.MediaImage},
+	{URL: testVideo, Type: biz.MediaVideo},
+}
+
+func newModerationReview(t *testing.T, content string) *model.ReviewInfo {
+	t.Helper()
+	picInfo, videoInfo, err := biz.EncodeMedia(testMedia)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &model.ReviewInfo{ReviewID: 1, UserID: 9, Content: content, PicInfo: picInfo, VideoInfo: videoInfo}
+}
+
+func resultOf(out *biz.ModerationOutcome, checker string) *biz.CheckResult {
+	for i := range out.Results {
+		if out.Results[i].Checker == checker {
+			return &out.Results[i]
+		}
+	}
+	return nil
+}
+
+func TestModerationPipeline(t *testing.T) {
+	cases := []struct {
+		name    string
+		setup   func(s *FakeModerationServer)
+		content string
+		verdict biz.Verdict
+		reason  string
+	}{
+		{"approve", func(s *FakeModerationServer) {}, "味道不错", biz.VerdictApprove, ""},
+		{"reject", func(s *FakeModerationServer) { s.Block(testImage, "porn") }, "味道不错", biz.VerdictReject, "image违规: porn"},
+		{"manual", func(s *FakeModerationServer) { s.Review(testVideo, "ad") }, "味道不错", biz.VerdictManual, "video疑似违规: ad"},
+		{"content manual", func(s *FakeModerationServer) {}, "加微信领红包", biz.VerdictManual, "内容包含敏感词: 加微信"},
+		// 图片驳回时不再检查用户信誉
+		{"reject wins", func(s *FakeModerationServer) { s.Block(testImage, "porn"); s.Review(testVideo, "ad") }, "加微信领红包", biz.VerdictReject, "image违规: porn"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			env := newTestEnv(t, nil)
+			srv := NewFakeModerationServer()
+			c.setup(srv)
+			out := newTestPipeline(t, env, srv, time.Second).Run(context.Background(), newModerationReview(t, c.content))
+			if out.Verdict != c.verdict {
+				t.Fatalf("verdict = %s, want %s, results:%+v", out.Verdict, c.verdict, out.Results)
+			}
+			if c.reason != "" && !strings.Contains(out.Reason(), c.reason) {
+				t.Fatalf("reason = %q, want %q", out.Reason(), c.reason)
+			}
+			// 图片和视频分开送审
+			reqs := srv.Requests()
+			if len(reqs) != 2 || reqs[0].Type != string(biz.MediaImage) || reqs[0].URLs[0] != testImage ||
+				reqs[1].Type != string(biz.MediaVideo) || reqs[1].URLs[0] != testVideo {
+				t.Fatalf("requests = %+v", reqs)
+			}
+		})
+	}
+}
+
+func TestModerationReputation(t *testing.T) {
+	env := newTestEnv(t, nil)
+	for i := int64(1); i <= 2; i++ {
+		if err := env.db.Create(&model.ReviewInfo{ReviewID: 100 + i, UserID: 9, OrderID: 100 + i, Status: int32(biz.ReviewNotApproved)}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	out := newTestPipeline(t, env, NewFakeModerationServer(), time.Second).Run(context.Background(), newModerationReview(t, "味道不错"))
+	if out.Verdict != biz.VerdictManual || resultOf(out, "reputation").Verdict != biz.VerdictManual {
+		t.Fatalf("outcome = %+v, want manual by reputation", out)
+	}
+}
+
+// 审核服务出错或超时时转人工审核,不会误放也不会误杀
+func TestModerationFallbackToManual(t *testing.T) {
+	cases := []struct {
+		name    string
+		handler http.Handler
+	}{
+		{"server error", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})},
+		{"bad response", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"suggestion":"unknown"}`))
+		})},
+		{"timeout", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		})},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			env := newTestEnv(t, nil)
+			start := time.Now()
+			out := newTestPipeline(t, env, c.handler, 100*time.Millisecond).Run(context.Background(), newModerationReview(t, "味道不错"))
+			if out.Verdict != biz.VerdictManual {
+				t.Fatalf("verdict = %s, want manual", out.Verdict)
+			}
+			if r := resultOf(out, "media"); r == nil || r.Verdict != biz.VerdictManual || r.Reason != "media检查失败" {
+				t.Fatalf("media result = %+v", r)
+			}
+			if d := time.Since(start); d > 500*time.Millisecond {
+				t.Fatalf("pipeline took %s, want timeout", d)
+			}
+		})
+	}
+}
+
+// waitReview 等到评价满足done,用来等异步的自动审核完成
+func waitReview(t *testing.T, env *testEnv, reviewId int64, done func(*model.ReviewInfo) bool) *model.ReviewInfo {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		got, err := env.repo.getReviewFromDB(context.Background(), reviewId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if done(got) {
+			return got
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("review not done, got:%+v", got)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// 创建评价后异步自动审核,按结论修改评价状态,转人工和出错时保持待审核
+func TestCreateReviewAutoAudit(t *testing.T) {
+	serverError := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	cases := []struct {
+		name    string
+		handler func() http.Handler
+		status  biz.ReviewStatus
+		opUser  string
+	}{
+		{"approve", func() http.Handler { return NewFakeModerationServer() }, biz.Approved, biz.AutoAuditOpUser},
+		{"reject", func() http.Handler { s := NewFakeModerationServer(); s.Block(testImage, "porn"); return s }, biz.ReviewNotApproved, biz.AutoAuditOpUser},
+		{"manual", func() http.Handler { s := NewFakeModerationServer(); s.Review(testImage, "ad"); return s }, biz.PendingReview, ""},
+		{"server error", func() http.Handler { return serverError }, biz.PendingReview, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			env := newTestEnv(t, nil)
+			rc := newModerationConf(t, c.handler(), time.Second)
+			pipeline := biz.NewModerationPipelineFromConf(rc, testModerationFilter, NewMediaModerator(rc, log.DefaultLogger), env.repo, log.DefaultLogger)
+			uc, orders := newTestUsecase(t, env, rc, pipeline, nil)
+			orders.Put(&biz.Order{OrderID: 1, UserID: 9, StoreID: 3, SkuID: 20, Status: biz.OrderCompleted})
+
+			ctx := biz.NewCallerContext(context.Background(), &biz.Caller{Role: biz.RoleUser, UserId: 9})
+			review, err := uc.CreateReview(ctx, &model.ReviewInfo{OrderID: 1, Score: 5, Content: "味道不错"}, testMedia, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 自动审核完成后ctrl_json里会记录审核结果
+			got := waitReview(t, env, review.ReviewID, func(r *model.ReviewInfo) bool {
+				return strings.Contains(r.CtrlJSON, "auto_audit")
+			})
+			if got.Status != int32(c.status) || got.OpUser != c.opUser {
+				t.Fatalf("status = %d, opUser = %q, want %d, %q", got.Status, got.OpUser, c.status, c.opUser)
+			}
+		})
+	}
+}
+
+// 内容检查已经要求人工审核时不再送自动审核,评价保持待审核
+func TestCreateReviewNeedReviewSkipsAutoAudit(t *testing.T) {
+	env := newTestEnv(t, nil)
+	srv := NewFakeModerationServer()
+	rc := newModerationConf(t, srv, time.Second)
+	pipeline := biz.NewModerationPipelineFromConf(rc, testModerationFilter, NewMediaModerator(rc, log.DefaultLogger), env.repo, log.DefaultLogger)
+	uc, orders := newTestUsecase(t, env, rc, pipeline, nil)
+	orders.Put(&biz.Order{OrderID: 1, UserID: 9, StoreID: 3, SkuID: 20, Status: biz.OrderCompleted})
+
+	ctx := biz.NewCallerContext(context.Background(), &biz.Caller{Role: biz.RoleUser, UserId: 9})
+	review, err := uc.CreateReview(ctx, &model.ReviewInfo{OrderID: 1, Score: 5, Content: "加微信领红包"}, testMedia, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	got, err := env.repo.getReviewFromDB(context.Background(), review.ReviewID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != int32(biz.PendingReview) || !strings.Contains(got.CtrlJSON, "加微信") || strings.Contains(got.CtrlJSON, "auto_audit") {
+		t.Fatalf("status = %d, ctrl_json = %s", got.Status, got.CtrlJSON)
+	}
+	if reqs := srv.Requests(); len(reqs) != 0 {
+		t.Fatalf("requests = %+v, want none", reqs)
+	}
+}
+
+// 修改评价后按修改后的内容重新自动审核,结果写回修改后的版本
+func TestUpdateReviewReAudit(t *testing.T) {
+	env := newTestEnv(t, nil)
+	srv := NewFakeModerationServer()
+	rc := newModerationConf(t, srv, time.Second)
+	pipeline := biz.NewModerationPipelineFromConf(rc, testModerationFilter, NewMediaModerator(rc, log.DefaultLogger), env.repo, log.DefaultLogger)
+	uc, orders := newTestUsecase(t, env, rc, pipeline, nil)
+	orders.Put(&biz.Order{OrderID: 1, UserID: 9, StoreID: 3, SkuID: 20, Status: biz.OrderCompleted})
+
+	ctx := biz.NewCallerContext(context.Background(), &biz.Caller{Role: biz.RoleUser, UserId: 9})
+	review, err := uc.CreateReview(ctx, &model.ReviewInfo{OrderID: 1, Score: 5, Content: "味道不错"}, testMedia, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	approved := waitReview(t, env, review.ReviewID, func(r *model.ReviewInfo) bool { return r.Status == int32(biz.Approved) })
+
+	// 修改后的图片违规,重新审核后驳回
+	srv.Block(testImage, "porn")
+	updated, err := uc.UpdateReview(ctx, &biz.UpdateReviewParam{ReviewId: review.ReviewID, Version: approved.Version, Score: 4, Content: "还可以", Media: testMedia})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := waitReview(t, env, review.ReviewID, func(r *model.ReviewInfo) bool { return r.Status == int32(biz.ReviewNotApproved) })
+	if got.Version != updated.Version+1 || got.OpUser != biz.AutoAuditOpUser || got.Content != "还可以" {
+		t.Fatalf("got = %+v, want rejected at version %d", got, updated.Version+1)
+	}
+	if n := len(srv.Requests()); n != 4 {
+		t.Fatalf("moderation requests = %d, want 4", n)
+	}
+}
@@ -0,0 +1,94 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	orderv1 "review-service/api/order/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+)
+
+type orderClient struct {
+	client orderv1.OrderClient
+	log    *log.Helper
+}
+
+// NewOrderClient 通过gRPC调用订单服务
+func NewOrderClient(c *conf.Client, r registry.Discovery, logger log.Logger) (biz.OrderClient, func(), error) {
+	conn, err := newGRPCConn(c.GetOrderEndpoint(), c, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		_ = conn.Close()
+	}
+	return &orderClient{client: orderv1.NewOrderClient(conn), log: log.NewHelper(logger)}, cleanup, nil
+}
+
+func (c *orderClient) GetOrder(ctx context.Context, orderId int64) (*biz.Order, error) {
+	reply, err := c.client.GetOrder(ctx, &orderv1.GetOrderRequest{OrderId: orderId})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("GetOrder fail, orderId:%d, err:%v", orderId, err)
+		return nil, err
+	}
+	o := reply.GetOrder()
+	if o == nil {
+		return nil, nil
+	}
+	return toBizOrder(o), nil
+}
+
+// NewOrderSource 从订单服务分页查询已完成的订单,没有开启默认好评时返回nil,不建立连接
+func NewOrderSource(rc *conf.Review, c *conf.Client, r registry.Discovery, logger log.Logger) (biz.OrderSource, func(), error) {
+	if !rc.GetDefaultReview().GetEnable() {
+		return nil, func() {}, nil
+	}
+	conn, err := newGRPCConn(c.GetOrderEndpoint(), c, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		_ = conn.Close()
+	}
+	return &orderClient{client: orderv1.NewOrderClient(conn), log: log.NewHelper(logger)}, cleanup, nil
+}
+
+func (c *orderClient) ListCompletedOrders(ctx context.Context, start, end time.Time, afterOrderId int64, limit int) ([]*biz.Order, error) {
+	reply, err := c.client.ListCompletedOrders(ctx, &orderv1.ListCompletedOrdersRequest{
+		CompleteStart: start.Unix(),
+		CompleteEnd:   end.Unix(),
+		LastOrderId:   afterOrderId,
+		Size:          int32(limit),
+	})
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("ListCompletedOrders fail, start:%v, end:%v, lastOrderId:%d, err:%v", start, end, afterOrderId, err)
+		return nil, err
+	}
+	ret := make([]*biz.Order, 0, len(reply.GetOrders()))
+	for _, o := range reply.GetOrders() {
+		ret = append(ret, toBizOrder(o))
+	}
+	return ret, nil
+}
+
+func toBizOrder(o *orderv1.OrderInfo) *biz.Order {
+	order := &biz.Order{
+		OrderID: o.OrderId,
+		UserID:  o.UserId,
+		StoreID: o.StoreId,
+		SkuID:   o.SkuId,
+		Status:  biz.OrderStatus(o.Status),
+	}
+	if o.CompleteAt > 0 {
+		order.CompleteAt = time.Unix(o.CompleteAt, 0)
+	}
+	return order
+}
@@ -0,0 +1,237 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"gorm.io/gorm/clause"
+)
+
+// outbox状态
+const (
+	outboxPending int32 = 0 // 待投递
+	outboxSent    int32 = 1 // 已投递
+	outboxDead    int32 = 2 // 超过重试次数,不再投递,需要人工处理
+)
+
+const (
+	outboxBatchSize      = 100
+	outboxMaxBackoff     = time.Minute
+	defaultRelayInterval = time.Second
+	defaultOutboxRetry   = 10
+	// outboxClaimTTL 认领之后多久没有投递完成(进程退出)其他实例可以重新认领
+	outboxClaimTTL = 30 * time.Second
+)
+
+// saveOutbox 写一条待投递的事件,必须和业务数据在同一个事务里调用
+func saveOutbox(ctx context.Context, tx *query.Query, reviewId int64, eventType string, v interface{}) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return tx.ReviewOutbox.WithContext(ctx).Create(&model.ReviewOutbox{
+		ReviewID:    reviewId,
+		EventType:   eventType,
+		Payload:     string(payload),
+		Status:      outboxPending,
+		NextRetryAt: time.Now(),
+	})
+}
+
+var _ transport.Server = (*OutboxRelay)(nil)
+
+// OutboxRelay 定时把review_outbox中待投递的事件投递出去
+// 先在一个短事务里认领到期的事件(把next_retry_at推后),提交之后再投递,投递期间不持有行锁
+// 先投递再标记已投递,进程在两步之间退出时会重复投递(at-least-once),消费方需要按事件id去重
+// 同一个评价前面还有没投递的事件时后面的事件不认领,保证同一个评价的事件按顺序投递
+type OutboxRelay struct {
+	data     *Data
+	pub      biz.EventPublisher
+	log      *log.Helper
+	interval time.Duration
+	maxRetry int32
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+func NewOutboxRelay(c *conf.Event, data *Data, pub biz.EventPublisher, logger log.Logger) *OutboxRelay {
+	r := &OutboxRelay{data: data, pub: pub, log: log.NewHelper(logger), interval: defaultRelayInterval, maxRetry: defaultOutboxRetry}
+	if c.GetRelayInterval() != nil {
+		r.interval = c.GetRelayInterval().AsDuration()
+	}
+	if c.GetMaxRetry() > 0 {
+		r.maxRetry = c.GetMaxRetry()
+	}
+	return r
+}
+
+func (r *OutboxRelay) Start(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	r.mu.Lock()
+	r.cancel = cancel
+	r.mu.Unlock()
+	defer cancel()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		sent, err := r.RelayOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.log.WithContext(ctx).Errorf("OutboxRelay|RelayOnce fail, err:%v", err)
+		}
+		// 一整批都投递成功说明可能还有积压,马上投递下一批
+		if sent == outboxBatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *OutboxRelay) Stop(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cancel != nil {
+		r.cancel()
+	}
+	return nil
+}
+
+// RelayOnce 认领一批到期的事件并按id顺序投递,返回投递成功的数量
+// 投递失败的事件按退避时间重试,失败maxRetry次之后标记为死信,不再阻塞同一个评价后面的事件
+func (r *OutboxRelay) RelayOnce(ctx context.Context) (int, error) {
+	rows, err := r.claim(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	o := r.data.query.ReviewOutbox
+	sent := 0
+	failed := make(map[int64]bool)
+	for _, row := range rows {
+		// 同一个评价前面的事件这一轮投递失败,后面的事件等下一轮,认领到期之后重新认领
+		if failed[row.ReviewID] {
+			continue
+		}
+		event := &biz.ReviewEvent{
+			ID:       row.ID,
+			ReviewID: row.ReviewID,
+			Type:     row.EventType,
+			Payload:  []byte(row.Payload),
+			CreateAt: row.CreateAt,
+		}
+		if err := r.pub.Publish(ctx, event); err != nil {
+			failed[row.ReviewID] = true
+			columns := map[string]interface{}{
+				"retry_count":   row.RetryCount + 1,
+				"next_retry_at": time.Now().Add(outboxBackoff(row.RetryCount + 1)),
+			}
+			if row.RetryCount+1 >= r.maxRetry {
+				columns["status"] = outboxDead
+				r.log.WithContext(ctx).Errorf("OutboxRelay|Publish fail, dead letter, id:%d, reviewId:%d, type:%s, retry:%d, err:%v",
+					row.ID, row.ReviewID, row.EventType, row.RetryCount+1, err)
+			} else {
+				r.log.WithContext(ctx).Errorf("OutboxRelay|Publish fail, id:%d, reviewId:%d, retry:%d, err:%v",
+					row.ID, row.ReviewID, row.RetryCount+1, err)
+			}
+			if _, err := o.WithContext(ctx).Where(o.ID.Eq(row.ID)).UpdateColumns(columns); err != nil {
+				return sent, err
+			}
+			continue
+		}
+		if _, err := o.WithContext(ctx).Where(o.ID.Eq(row.ID)).Update(o.Status, outboxSent); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// claim 认领一批到期的事件,返回按id排序的事件
+// SKIP LOCKED跳过其他实例正在认领的行;认领时把next_retry_at推后outboxClaimTTL,其他实例在这段时间内不会再认领
+// 同一个评价只认领从最早一条待投递事件开始的连续事件,前面的事件还没到期或者被其他实例认领时整个评价跳过
+func (r *OutboxRelay) claim(ctx context.Context, now time.Time) ([]*model.ReviewOutbox, error) {
+	var claimed []*model.ReviewOutbox
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		o := tx.ReviewOutbox
+		rows, err := o.WithContext(ctx).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where(o.Status.Eq(outboxPending), o.NextRetryAt.Lte(now)).
+			Order(o.ID).
+			Limit(outboxBatchSize).
+			Find()
+		if err != nil || len(rows) == 0 {
+			return err
+		}
+		due := make(map[int64]bool, len(rows))
+		seen := make(map[int64]bool, len(rows))
+		reviewIds := make([]int64, 0, len(rows))
+		for _, row := range rows {
+			due[row.ID] = true
+			if !seen[row.ReviewID] {
+				seen[row.ReviewID] = true
+				reviewIds = append(reviewIds, row.ReviewID)
+			}
+		}
+		// 查出这些评价所有在这批之前或者在这批里的待投递事件,判断哪些事件前面没有阻塞
+		pending, err := o.WithContext(ctx).
+			Select(o.ID, o.ReviewID).
+			Where(o.Status.Eq(outboxPending), o.ReviewID.In(reviewIds...), o.ID.Lte(rows[len(rows)-1].ID)).
+			Order(o.ID).
+			Find()
+		if err != nil {
+			return err
+		}
+		blocked := make(map[int64]bool)
+		ok := make(map[int64]bool, len(rows))
+		for _, p := range pending {
+			if blocked[p.ReviewID] {
+				continue
+			}
+			if !due[p.ID] {
+				blocked[p.ReviewID] = true
+				continue
+			}
+			ok[p.ID] = true
+		}
+		ids := make([]int64, 0, len(rows))
+		for _, row := range rows {
+			if ok[row.ID] {
+				ids = append(ids, row.ID)
+				claimed = append(claimed, row)
+			}
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		_, err = o.WithContext(ctx).Where(o.ID.In(ids...)).Update(o.NextRetryAt, now.Add(outboxClaimTTL))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// outboxBackoff 第n次失败之后的重试间隔: 1s,2s,4s...最多1分钟
+func outboxBackoff(n int32) time.Duration {
+	d := time.Second
+	for i := int32(1); i < n && d < outboxMaxBackoff; i++ {
+		d *= 2
+	}
+	if d > outboxMaxBackoff {
+		d = outboxMaxBackoff
+	}
+	return d
+}
@@ -0,0 +1,259 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"review-service/internal/biz"
+	"review-service/internal/biz/biztest"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+)
+
+// flakyPublisher 指定评价的事件投递失败,其他评价的事件正常投递
+type flakyPublisher struct {
+	*biztest.MemoryEventPublisher
+
+	mu   sync.Mutex
+	fail map[int64]bool
+}
+
+func newFlakyPublisher() *flakyPublisher {
+	return &flakyPublisher{MemoryEventPublisher: biztest.NewMemoryEventPublisher(), fail: make(map[int64]bool)}
+}
+
+func (p *flakyPublisher) SetFail(reviewId int64, fail bool) {
+	p.mu.Lock()
+	p.fail[reviewId] = fail
+	p.mu.Unlock()
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, event *biz.ReviewEvent) error {
+	p.mu.Lock()
+	fail := p.fail[event.ReviewID]
+	p.mu.Unlock()
+	if fail {
+		return errors.New("kafka unavailable")
+	}
+	return p.MemoryEventPublisher.Publish(ctx, event)
+}
+
+func outboxRows(t *testing.T, env *testEnv) []*model.ReviewOutbox {
+	t.Helper()
+	var rows []*model.ReviewOutbox
+	if err := env.db.Order("id").Find(&rows).Error; err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}
+
+func addOutbox(t *testing.T, env *testEnv, reviewId int64, eventType string) {
+	t.Helper()
+	if err := env.db.Create(&model.ReviewOutbox{ReviewID: reviewId, EventType: eventType, Payload: "{}", NextRetryAt: time.Now()}).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// makeDue 把所有待投递事件的重试时间改到现在之前,相当于时间过去了
+func makeDue(t *testing.T, env *testEnv) {
+	t.Helper()
+	if err := env.db.Model(&model.ReviewOutbox{}).Where("status = ?", outboxPending).
+		Update("next_retry_at", time.Now().Add(-time.Second)).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func publishedIds(p *flakyPublisher) []int64 {
+	var ids []int64
+	for _, e := range p.Events() {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+func TestReviewWritesSaveOutbox(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	if err := env.db.Create(&model.ReviewInfo{ReviewID: 1, StoreID: 3, Status: int32(biz.PendingReview)}).Error; err != nil {
+		t.Fatal(err)
+	}
+	version := func() int32 {
+		review, err := env.repo.getReviewFromDB(ctx, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return review.Version
+	}
+	writes := []struct {
+		event string
+		write func() error
+	}{
+		{biz.EventReviewAutoAudited, func() error {
+			return env.repo.SaveAutoAudit(ctx, &biz.AutoAuditParam{ReviewId: 1, Version: version(), Status: int32(biz.PendingReview)})
+		}},
+		{biz.EventReviewAudited, func() error {
+			return env.repo.AuditReview(ctx, &biz.AuditParam{ReviewId: 1, Status: int32(biz.Approved), OpUser: "op"})
+		}},
+		{biz.EventReviewUpdated, func() error {
+			return env.repo.UpdateReview(ctx, &model.ReviewInfo{ReviewID: 1, StoreID: 3, Version: version(), Content: "改过了"})
+		}},
+		{biz.EventReviewAppealed, func() error {
+			_, err := env.repo.SaveAppeal(ctx, &model.ReviewAppealInfo{ReviewID: 1, StoreID: 3, Status: int32(biz.AppealPending), Reason: "恶意差评"})
+			return err
+		}},
+		{biz.EventReviewDeleted, func() error {
+			return env.repo.DeleteReview(ctx, 1)
+		}},
+	}
+	for i, w := range writes {
+		if err := w.write(); err != nil {
+			t.Fatalf("%s: %v", w.event, err)
+		}
+		rows := outboxRows(t, env)
+		if len(rows) != i+1 || rows[i].EventType != w.event || rows[i].ReviewID != 1 {
+			t.Fatalf("%s: outbox = %+v", w.event, rows[len(rows)-1])
+		}
+	}
+
+	// 更新失败时不写事件
+	if err := env.repo.AuditReview(ctx, &biz.AuditParam{ReviewId: 1, Status: int32(biz.Approved)}); err == nil {
+		t.Fatal("want error for deleted review")
+	}
+	if n := len(outboxRows(t, env)); n != len(writes) {
+		t.Fatalf("outbox rows = %d, want %d", n, len(writes))
+	}
+}
+
+func TestRelaySkipsRowsNotDue(t *testing.T) {
+	env := newTestEnv(t, nil)
+	pub := newFlakyPublisher()
+	relay := NewOutboxRelay(&conf.Event{}, env.data, pub, log.DefaultLogger)
+	if err := env.db.Create(&model.ReviewOutbox{ReviewID: 1, EventType: biz.EventReviewCreated, Payload: "{}",
+		NextRetryAt: time.Now().Add(time.Minute)}).Error; err != nil {
+		t.Fatal(err)
+	}
+	sent, err := relay.RelayOnce(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent != 0 || len(pub.Events()) != 0 {
+		t.Fatalf("sent = %d, want 0", sent)
+	}
+}
+
+func TestRelayRetryKeepsOrder(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	pub := newFlakyPublisher()
+	relay := NewOutboxRelay(&conf.Event{}, env.data, pub, log.DefaultLogger)
+	addOutbox(t, env, 1, biz.EventReviewCreated)  // id 1
+	addOutbox(t, env, 2, biz.EventReviewCreated)  // id 2
+	addOutbox(t, env, 1, biz.EventReviewReplied)  // id 3
+	addOutbox(t, env, 2, biz.EventReviewReplied)  // id 4
+	addOutbox(t, env, 1, biz.EventReviewAppended) // id 5
+
+	pub.SetFail(1, true)
+	sent, err := relay.RelayOnce(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent != 2 {
+		t.Fatalf("sent = %d, want 2", sent)
+	}
+	rows := outboxRows(t, env)
+	if rows[0].Status != outboxPending || rows[0].RetryCount != 1 || !rows[0].NextRetryAt.After(time.Now()) {
+		t.Fatalf("failed row = %+v", rows[0])
+	}
+
+	// 失败的事件还没到重试时间,同一个评价后面的事件也不投递
+	pub.SetFail(1, false)
+	if sent, _ = relay.RelayOnce(ctx); sent != 0 {
+		t.Fatalf("sent = %d before retry time, want 0", sent)
+	}
+
+	makeDue(t, env)
+	if sent, _ = relay.RelayOnce(ctx); sent != 3 {
+		t.Fatalf("sent = %d, want 3", sent)
+	}
+	want := []int64{2, 4, 1, 3, 5}
+	got := publishedIds(pub)
+	if len(got) != len(want) {
+		t.Fatalf("published = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("published = %v, want %v", got, want)
+		}
+	}
+	for _, row := range outboxRows(t, env) {
+		if row.Status != outboxSent {
+			t.Fatalf("row %d status = %d, want sent", row.ID, row.Status)
+		}
+	}
+}
+
+func TestRelayDeadLetter(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	pub := newFlakyPublisher()
+	relay := NewOutboxRelay(&conf.Event{MaxRetry: 2}, env.data, pub, log.DefaultLogger)
+	addOutbox(t, env, 1, biz.EventReviewCreated)
+	addOutbox(t, env, 1, biz.EventReviewReplied)
+
+	pub.SetFail(1, true)
+	for i := 0; i < 2; i++ {
+		makeDue(t, env)
+		if _, err := relay.RelayOnce(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rows := outboxRows(t, env)
+	if rows[0].Status != outboxDead || rows[0].RetryCount != 2 {
+		t.Fatalf("row = %+v, want dead letter", rows[0])
+	}
+
+	// 死信不再投递,也不再阻塞同一个评价后面的事件
+	pub.SetFail(1, false)
+	makeDue(t, env)
+	if sent, _ := relay.RelayOnce(ctx); sent != 1 {
+		t.Fatalf("sent = %d, want 1", sent)
+	}
+	if got := publishedIds(pub); len(got) != 1 || got[0] != rows[1].ID {
+		t.Fatalf("published = %v, want [%d]", got, rows[1].ID)
+	}
+}
+
+func TestRelayClaimedRowsNotRelayedTwice(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	pub := newFlakyPublisher()
+	relay := NewOutboxRelay(&conf.Event{}, env.data, pub, log.DefaultLogger)
+	addOutbox(t, env, 1, biz.EventReviewCreated)
+	addOutbox(t, env, 1, biz.EventReviewReplied)
+	addOutbox(t, env, 2, biz.EventReviewCreated)
+
+	// 另一个实例认领了评价1的第一条事件还没投递完
+	if err := env.db.Model(&model.ReviewOutbox{}).Where("id = ?", 1).
+		Update("next_retry_at", time.Now().Add(outboxClaimTTL)).Error; err != nil {
+		t.Fatal(err)
+	}
+	claimed, err := relay.claim(ctx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(claimed) != 1 || claimed[0].ReviewID != 2 {
+		t.Fatalf("claimed = %+v, want only review 2", claimed)
+	}
+	// 已经认领的事件在认领过期之前不会被再次认领
+	if claimed, _ = relay.claim(ctx, time.Now()); len(claimed) != 0 {
+		t.Fatalf("claimed again = %+v", claimed)
+	}
+	if claimed, _ = relay.claim(ctx, time.Now().Add(outboxClaimTTL+time.Second)); len(claimed) != 3 {
+		t.Fatalf("claimed after ttl = %d rows, want 3", len(claimed))
+	}
+}
@@ -0,0 +1,53 @@
+package data
+
+import (
+	"context"
+	productv1 "review-service/api/product/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+)
+
+type productClient struct {
+	client productv1.ProductClient
+	log    *log.Helper
+}
+
+// NewProductClient 通过gRPC调用商品服务
+func NewProductClient(c *conf.Client, r registry.Discovery, logger log.Logger) (biz.ProductClient, func(), error) {
+	conn, err := newGRPCConn(c.GetProductEndpoint(), c, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		_ = conn.Close()
+	}
+	return &productClient{client: productv1.NewProductClient(conn), log: log.NewHelper(logger)}, cleanup, nil
+}
+
+func (c *productClient) GetSku(ctx context.Context, skuId int64) (*biz.Sku, error) {
+	reply, err := c.client.GetSku(ctx, &productv1.GetSkuRequest{SkuId: skuId})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("GetSku fail, skuId:%d, err:%v", skuId, err)
+		return nil, err
+	}
+	s := reply.GetSku()
+	if s == nil {
+		return nil, nil
+	}
+	return &biz.Sku{
+		SkuID:   s.SkuId,
+		SpuID:   s.SpuId,
+		StoreID: s.StoreId,
+		Title:   s.Title,
+		Pic:     s.Pic,
+		Price:   s.Price,
+		Attrs:   s.Attrs,
+	}, nil
+}
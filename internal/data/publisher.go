@@ -0,0 +1,44 @@
+package data
+
+import (
+	"context"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type kafkaEventPublisher struct {
+	w *kafka.Writer
+}
+
+// NewEventPublisher 把评价变更事件投递到kafka
+// 用review_id做消息key,同一个评价的事件会进同一个分区,保证消费顺序
+func NewEventPublisher(c *conf.Event) (biz.EventPublisher, func(), error) {
+	w := &kafka.Writer{
+		Addr:         kafka.TCP(c.Brokers...),
+		Topic:        c.Topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		// relay是一条一条同步投递的,不需要攒批
+		BatchTimeout: 10 * time.Millisecond,
+	}
+	cleanup := func() {
+		_ = w.Close()
+	}
+	return &kafkaEventPublisher{w: w}, cleanup, nil
+}
+
+func (p *kafkaEventPublisher) Publish(ctx context.Context, event *biz.ReviewEvent) error {
+	return p.w.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(strconv.FormatInt(event.ReviewID, 10)),
+		Value: event.Payload,
+		Headers: []kafka.Header{
+			{Key: "event_id", Value: []byte(strconv.FormatInt(event.ID, 10))},
+			{Key: "event_type", Value: []byte(event.Type)},
+		},
+		Time: event.CreateAt,
+	})
+}
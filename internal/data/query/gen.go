@@ -18,14 +18,18 @@ import (
 var (
 	Q                = new(Query)
 	ReviewAppealInfo *reviewAppealInfo
+	ReviewAppendInfo *reviewAppendInfo
 	ReviewInfo       *reviewInfo
+	ReviewOutbox     *reviewOutbox
 	ReviewReplyInfo  *reviewReplyInfo
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	ReviewAppealInfo = &Q.ReviewAppealInfo
+	ReviewAppendInfo = &Q.ReviewAppendInfo
 	ReviewInfo = &Q.ReviewInfo
+	ReviewOutbox = &Q.ReviewOutbox
 	ReviewReplyInfo = &Q.ReviewReplyInfo
 }
 
@@ -33,7 +37,9 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
 		db:               db,
 		ReviewAppealInfo: newReviewAppealInfo(db, opts...),
+		ReviewAppendInfo: newReviewAppendInfo(db, opts...),
 		ReviewInfo:       newReviewInfo(db, opts...),
+		ReviewOutbox:     newReviewOutbox(db, opts...),
 		ReviewReplyInfo:  newReviewReplyInfo(db, opts...),
 	}
 }
@@ -42,7 +48,9 @@ type Query struct {
 	db *gorm.DB
 
 	ReviewAppealInfo reviewAppealInfo
+	ReviewAppendInfo reviewAppendInfo
 	ReviewInfo       reviewInfo
+	ReviewOutbox     reviewOutbox
 	ReviewReplyInfo  reviewReplyInfo
 }
 
@@ -52,7 +60,9 @@ func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
 		db:               db,
 		ReviewAppealInfo: q.ReviewAppealInfo.clone(db),
+		ReviewAppendInfo: q.ReviewAppendInfo.clone(db),
 		ReviewInfo:       q.ReviewInfo.clone(db),
+		ReviewOutbox:     q.ReviewOutbox.clone(db),
 		ReviewReplyInfo:  q.ReviewReplyInfo.clone(db),
 	}
 }
@@ -69,21 +79,27 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
 		db:               db,
 		ReviewAppealInfo: q.ReviewAppealInfo.replaceDB(db),
+		ReviewAppendInfo: q.ReviewAppendInfo.replaceDB(db),
 		ReviewInfo:       q.ReviewInfo.replaceDB(db),
+		ReviewOutbox:     q.ReviewOutbox.replaceDB(db),
 		ReviewReplyInfo:  q.ReviewReplyInfo.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
 	ReviewAppealInfo IReviewAppealInfoDo
+	ReviewAppendInfo IReviewAppendInfoDo
 	ReviewInfo       IReviewInfoDo
+	ReviewOutbox     IReviewOutboxDo
 	ReviewReplyInfo  IReviewReplyInfoDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
 		ReviewAppealInfo: q.ReviewAppealInfo.WithContext(ctx),
+		ReviewAppendInfo: q.ReviewAppendInfo.WithContext(ctx),
 		ReviewInfo:       q.ReviewInfo.WithContext(ctx),
+		ReviewOutbox:     q.ReviewOutbox.WithContext(ctx),
 		ReviewReplyInfo:  q.ReviewReplyInfo.WithContext(ctx),
 	}
 }
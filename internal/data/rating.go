@@ -0,0 +1,193 @@
+package data
+
+import (
+	"context"
+	"review-service/internal/biz"
+	"review-service/internal/esindex"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"gorm.io/gen"
+)
+
+// GetStoreRatingSummary 店铺评分汇总,只统计审核通过且未删除的评价
+// 优先走ES聚合,ES出错时降级到MySQL聚合查询(走store_id索引,单个店铺的数据量可以接受)
+func (r *reviewRepo) GetStoreRatingSummary(ctx context.Context, param *biz.StoreRatingParam) (*biz.StoreRatingSummary, error) {
+	ret, err := r.storeRatingFromES(ctx, param)
+	if err == nil {
+		return ret, nil
+	}
+	r.log.WithContext(ctx).Warnf("GetStoreRatingSummary|es fail, fallback to mysql, storeId:%d, err:%v", param.StoreId, err)
+	ret, err = r.storeRatingFromDB(ctx, param)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("GetStoreRatingSummary|mysql fail, storeId:%d, err:%v", param.StoreId, err)
+		return nil, dbError(err)
+	}
+	return ret, nil
+}
+
+func (r *reviewRepo) storeRatingFromES(ctx context.Context, param *biz.StoreRatingParam) (*biz.StoreRatingSummary, error) {
+	avg := func(field string) types.Aggregations {
+		return types.Aggregations{Avg: &types.AverageAggregation{Field: &field}}
+	}
+	count := func(field string) types.Aggregations {
+		return types.Aggregations{Filter: &types.Query{
+			Term: map[string]types.TermQuery{field: {Value: 1}},
+		}}
+	}
+	scoreField, scoreSize := "score", 5
+	resp, err := r.data.es.Search().Index(esindex.ReviewAlias).
+		Size(0).
+		TrackTotalHits(true).
+		Query(buildStoreRatingQuery(param)).
+		Aggregations(map[string]types.Aggregations{
+			"avg_score":         avg("score"),
+			"avg_service_score": avg("service_score"),
+			"avg_express_score": avg("express_score"),
+			"score_count":       {Terms: &types.TermsAggregation{Field: &scoreField, Size: &scoreSize}},
+			"media_count":       count("has_media"),
+			"reply_count":       count("has_reply"),
+		}).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := &biz.StoreRatingSummary{
+		StoreId:    param.StoreId,
+		ScoreCount: make(map[int32]int64, 5),
+	}
+	if resp.Hits.Total != nil {
+		ret.Total = resp.Hits.Total.Value
+	}
+	avgValue := func(name string) float64 {
+		if agg, ok := resp.Aggregations[name].(*types.AvgAggregate); ok && agg.Value != nil {
+			return float64(*agg.Value)
+		}
+		return 0
+	}
+	ret.AvgScore = avgValue("avg_score")
+	ret.AvgServiceScore = avgValue("avg_service_score")
+	ret.AvgExpressScore = avgValue("avg_express_score")
+	if agg, ok := resp.Aggregations["score_count"].(*types.LongTermsAggregate); ok {
+		if buckets, ok := agg.Buckets.([]types.LongTermsBucket); ok {
+			for _, b := range buckets {
+				ret.ScoreCount[int32(b.Key)] = b.DocCount
+			}
+		}
+	}
+	if agg, ok := resp.Aggregations["media_count"].(*types.FilterAggregate); ok {
+		ret.MediaCount = agg.DocCount
+	}
+	if agg, ok := resp.Aggregations["reply_count"].(*types.FilterAggregate); ok {
+		ret.ReplyCount = agg.DocCount
+	}
+	return ret, nil
+}
+
+// buildStoreRatingQuery 店铺评分汇总的ES查询条件
+func buildStoreRatingQuery(param *biz.StoreRatingParam) *types.Query {
+	filter := []types.Query{
+		{Term: map[string]types.TermQuery{"store_id": {Value: param.StoreId}}},
+		{Term: map[string]types.TermQuery{"status": {Value: int32(biz.Approved)}}},
+	}
+	if !param.StartTime.IsZero() || !param.EndTime.IsZero() {
+		format := "yyyy-MM-dd HH:mm:ss"
+		timeRange := types.DateRangeQuery{Format: &format}
+		if !param.StartTime.IsZero() {
+			gte := param.StartTime.Format(time.DateTime)
+			timeRange.Gte = &gte
+		}
+		if !param.EndTime.IsZero() {
+			lte := param.EndTime.Format(time.DateTime)
+			timeRange.Lte = &lte
+		}
+		filter = append(filter, types.Query{Range: map[string]types.RangeQuery{"create_at": timeRange}})
+	}
+	return &types.Query{
+		Bool: &types.BoolQuery{
+			Filter: filter,
+			MustNot: []types.Query{
+				{
+					Exists: &types.ExistsQuery{Field: "delete_at"},
+				},
+			},
+		},
+	}
+}
+
+// storeRatingFromDB MySQL降级: 一条聚合查询算平均分和计数,一条group by算星级分布
+func (r *reviewRepo) storeRatingFromDB(ctx context.Context, param *biz.StoreRatingParam) (*biz.StoreRatingSummary, error) {
+	ri := r.data.query.ReviewInfo
+	conds := []gen.Condition{
+		ri.StoreID.Eq(param.StoreId),
+		ri.Status.Eq(int32(biz.Approved)),
+		ri.DeleteAt.IsNull(),
+	}
+	if !param.StartTime.IsZero() {
+		conds = append(conds, ri.CreateAt.Gte(param.StartTime))
+	}
+	if !param.EndTime.IsZero() {
+		conds = append(conds, ri.CreateAt.Lte(param.EndTime))
+	}
+	var agg struct {
+		Total           int64
+		AvgScore        *float64
+		AvgServiceScore *float64
+		AvgExpressScore *float64
+		MediaCount      *int64
+		ReplyCount      *int64
+	}
+	err := ri.WithContext(ctx).
+		Select(
+			ri.ID.Count().As("total"),
+			ri.Score.Avg().As("avg_score"),
+			ri.ServiceScore.Avg().As("avg_service_score"),
+			ri.ExpressScore.Avg().As("avg_express_score"),
+			ri.HasMedia.Sum().As("media_count"),
+			ri.HasReply.Sum().As("reply_count"),
+		).
+		Where(conds...).
+		Scan(&agg)
+	if err != nil {
+		return nil, err
+	}
+	var rows []struct {
+		Score int32
+		Cnt   int64
+	}
+	err = ri.WithContext(ctx).
+		Select(ri.Score, ri.ID.Count().As("cnt")).
+		Where(conds...).
+		Group(ri.Score).
+		Scan(&rows)
+	if err != nil {
+		return nil, err
+	}
+	// 没有评价时AVG和SUM返回NULL
+	value := func(p *float64) float64 {
+		if p == nil {
+			return 0
+		}
+		return *p
+	}
+	count := func(p *int64) int64 {
+		if p == nil {
+			return 0
+		}
+		return *p
+	}
+	ret := &biz.StoreRatingSummary{
+		StoreId:         param.StoreId,
+		Total:           agg.Total,
+		AvgScore:        value(agg.AvgScore),
+		AvgServiceScore: value(agg.AvgServiceScore),
+		AvgExpressScore: value(agg.AvgExpressScore),
+		ScoreCount:      make(map[int32]int64, len(rows)),
+		MediaCount:      count(agg.MediaCount),
+		ReplyCount:      count(agg.ReplyCount),
+	}
+	for _, row := range rows {
+		ret.ScoreCount[row.Score] = row.Cnt
+	}
+	return ret, nil
+}
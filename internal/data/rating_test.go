@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+)
+
+// ratingAggs 和下面MySQL里的数据对应的ES聚合结果
+const ratingAggs = `{"took":1,"timed_out":false,"_shards":{"total":1,"successful":1,"skipped":0,"failed":0},
+"hits":{"total":{"value":3,"relation":"eq"},"hits":[]},
+"aggregations":{
+	"avg#avg_score":{"value":4.0},
+	"avg#avg_service_score":{"value":4.0},
+	"avg#avg_express_score":{"value":3.0},
+	"lterms#score_count":{"doc_count_error_upper_bound":0,"sum_other_doc_count":0,"buckets":[{"key":5,"doc_count":2},{"key":2,"doc_count":1}]},
+	"filter#media_count":{"doc_count":1},
+	"filter#reply_count":{"doc_count":2}
+}}`
+
+// ES聚合失败时降级到MySQL,两边算出来的结果一样
+func TestStoreRatingFallbackToMySQL(t *testing.T) {
+	var (
+		fail atomic.Bool
+		body atomic.Value
+	)
+	env := newTestEnv(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body.Store(string(b))
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":{"type":"search_phase_execution_exception","reason":"all shards failed"},"status":500}`))
+			return
+		}
+		_, _ = w.Write([]byte(ratingAggs))
+	}))
+	deleteAt := time.Now()
+	for _, row := range []*model.ReviewInfo{
+		{ReviewID: 1, OrderID: 1, StoreID: 3, Score: 5, ServiceScore: 5, ExpressScore: 4, HasMedia: 1, HasReply: 1, Status: int32(biz.Approved)},
+		{ReviewID: 2, OrderID: 2, StoreID: 3, Score: 5, ServiceScore: 4, ExpressScore: 4, HasReply: 1, Status: int32(biz.Approved)},
+		{ReviewID: 3, OrderID: 3, StoreID: 3, Score: 2, ServiceScore: 3, ExpressScore: 1, Status: int32(biz.Approved)},
+		// 下面的都不统计: 待审核、已删除、别的店铺
+		{ReviewID: 4, OrderID: 4, StoreID: 3, Score: 1, ServiceScore: 1, ExpressScore: 1, Status: int32(biz.PendingReview)},
+		{ReviewID: 5, OrderID: 5, StoreID: 3, Score: 1, ServiceScore: 1, ExpressScore: 1, Status: int32(biz.Approved), DeleteAt: &deleteAt},
+		{ReviewID: 6, OrderID: 6, StoreID: 4, Score: 1, ServiceScore: 1, ExpressScore: 1, Status: int32(biz.Approved)},
+	} {
+		if err := env.db.Create(row).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := &biz.StoreRatingSummary{
+		StoreId:         3,
+		Total:           3,
+		AvgScore:        4,
+		AvgServiceScore: 4,
+		AvgExpressScore: 3,
+		ScoreCount:      map[int32]int64{5: 2, 2: 1},
+		MediaCount:      1,
+		ReplyCount:      2,
+	}
+	param := &biz.StoreRatingParam{StoreId: 3}
+
+	fromES, err := env.repo.GetStoreRatingSummary(context.Background(), param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, _ := body.Load().(string)
+	if !strings.Contains(q, `"store_id":{"value":3}`) || !strings.Contains(q, `"status":{"value":20}`) || !strings.Contains(q, `"delete_at"`) {
+		t.Fatalf("es query = %s", q)
+	}
+
+	fail.Store(true)
+	fromDB, err := env.repo.GetStoreRatingSummary(context.Background(), param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fromES, want) || !reflect.DeepEqual(fromDB, want) {
+		t.Fatalf("es = %+v, mysql = %+v, want %+v", fromES, fromDB, want)
+	}
+
+	// 没有评价的店铺MySQL的AVG返回NULL,按0处理
+	empty := &biz.StoreRatingParam{StoreId: 5}
+	got, err := env.repo.GetStoreRatingSummary(context.Background(), empty)
+	if err != nil || got.Total != 0 || got.AvgScore != 0 || len(got.ScoreCount) != 0 {
+		t.Fatalf("empty store = %+v, err = %v", got, err)
+	}
+}
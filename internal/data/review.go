@@ -1,24 +1,35 @@
 package data
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/sortorder"
 	"gorm.io/gorm"
 	"review-service/internal/data/model"
 	"review-service/internal/data/query"
 	"review-service/pkg/snowflake"
+	"strconv"
+	"strings"
+	"time"
 
+	v1 "review-service/api/review/v1"
 	"review-service/internal/biz"
+	"review-service/internal/esindex"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/sync/singleflight"
 )
 
 type reviewRepo struct {
 	data *Data
 	log  *log.Helper
+	// sf 合并同一个key的并发回源请求,防止缓存击穿
+	sf singleflight.Group
 }
 
 // NewGreeterRepo .
@@ -29,18 +40,42 @@ func NewReviewRepo(data *Data, logger log.Logger) biz.ReviewRepo {
 	}
 }
 
+// dbError 把数据库返回的错误转换成DB_FAILED,已经是业务错误的保持不变
+func dbError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if se := new(errors.Error); errors.As(err, &se) {
+		return err
+	}
+	return v1.ErrorDbFailed("数据库操作失败").WithCause(err)
+}
+
 func (r *reviewRepo) SaveReview(ctx context.Context, review *model.ReviewInfo) (*model.ReviewInfo, error) {
-	err := r.data.query.ReviewInfo.
-		WithContext(ctx).
-		Save(review)
-	return review, err
+	// 评价和创建事件在同一个事务里写入
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		if err := tx.ReviewInfo.
+			WithContext(ctx).
+			Save(review); err != nil {
+			return err
+		}
+		return saveOutbox(ctx, tx, review.ReviewID, biz.EventReviewCreated, review)
+	})
+	// order_id上有唯一索引,同一个订单并发提交评价或者和默认好评同时写入时只有一个能成功
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, v1.ErrorReviewAlreadyExists("订单:%d已评价", review.OrderID)
+	}
+	return review, dbError(err)
 }
 
 // GetReviewByOrderId 根据订单Id查询评价
 func (r *reviewRepo) GetReviewByOrderId(ctx context.Context, orderId int64) ([]*model.ReviewInfo, error) {
 	return r.data.query.ReviewInfo.
 		WithContext(ctx).
-		Where(r.data.query.ReviewInfo.OrderID.Eq(orderId)).
+		Where(
+			r.data.query.ReviewInfo.OrderID.Eq(orderId),
+			r.data.query.ReviewInfo.DeleteAt.IsNull(),
+		).
 		Find()
 }
 
@@ -48,18 +83,24 @@ func (r *reviewRepo) SaveReply(ctx context.Context, reply *model.ReviewReplyInfo
 	// 1. 数据校验
 	// 1.1 数据合法性校验(已回复的评价不允许商家再次回复)
 	// 先用评价ID查库，看下是否已回复
-	review, err := r.data.query.ReviewInfo.WithContext(ctx).Where(r.data.query.ReviewInfo.ReviewID.Eq(reply.ReviewID)).First()
+	review, err := r.data.query.ReviewInfo.WithContext(ctx).Where(
+		r.data.query.ReviewInfo.ReviewID.Eq(reply.ReviewID),
+		r.data.query.ReviewInfo.DeleteAt.IsNull(),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, v1.ErrorReviewNotFound("评价:%d不存在", reply.ReviewID)
+	}
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 	if review.HasReply == 1 {
-		return nil, errors.New("该评价已回复")
+		return nil, v1.ErrorAlreadyReplied("该评价已回复")
 	}
 
 	// 1.2 水平越权校验(A商家只能回复自己的不能回复B商家的)
 	// 举例子: 用户A删除订单，userId + orderId 当条件去查询订单然后删除
 	if review.StoreID != reply.StoreID {
-		return nil, errors.New("水平越权")
+		return nil, v1.ErrorForbiddenStore("水平越权")
 	}
 
 	// 2. 更新数据库中的数据(评价回复表和评价表要同时更新，涉及到事务操作)
@@ -72,16 +113,24 @@ func (r *reviewRepo) SaveReply(ctx context.Context, reply *model.ReviewReplyInfo
 			r.log.WithContext(ctx).Errorf("SaveReply create reply fail,err:%v", err)
 			return err
 		}
-		// 评价表更新hasReply字段
-		if _, err := tx.ReviewInfo.WithContext(ctx).Where(tx.ReviewInfo.ReviewID.Eq(reply.ReviewID)).Update(tx.ReviewInfo.HasReply, 1); err != nil {
+		// 评价表更新hasReply字段,同时增加版本号,ES同步按版本号丢弃过期的变更
+		if _, err := tx.ReviewInfo.WithContext(ctx).Where(tx.ReviewInfo.ReviewID.Eq(reply.ReviewID)).UpdateColumns(map[string]interface{}{
+			"has_reply": 1,
+			"version":   gorm.Expr("version + 1"),
+		}); err != nil {
 			r.log.WithContext(ctx).Errorf("SaveReply update reply fail,err:%v", err)
 			return err
 		}
-		return nil
+		return saveOutbox(ctx, tx, reply.ReviewID, biz.EventReviewReplied, reply)
 	})
 
+	if err != nil {
+		return nil, dbError(err)
+	}
+	r.invalidateReviewCache(ctx, reply.ReviewID, review.StoreID)
+
 	// 3. 返回
-	return reply, err
+	return reply, nil
 
 }
 
@@ -89,52 +138,64 @@ func (r *reviewRepo) SaveAppeal(ctx context.Context, info *model.ReviewAppealInf
 	var err error
 	_, err = r.data.query.ReviewInfo.WithContext(ctx).Where(
 		query.ReviewInfo.ReviewID.Eq(info.ReviewID),
-		query.ReviewInfo.StoreID.Eq(info.StoreID)).First()
+		query.ReviewInfo.StoreID.Eq(info.StoreID),
+		query.ReviewInfo.DeleteAt.IsNull()).First()
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, errors.New("评价不存在或不属于该商店")
+		return nil, v1.ErrorReviewNotFound("评价不存在或不属于该商店")
 	}
 	if err != nil {
 		r.log.WithContext(ctx).Errorf("SaveAppeal|查询评价失败, reviewID:%d, storeID:%d, err:%v",
 			info.ReviewID, info.StoreID, err)
-		return nil, err
+		return nil, dbError(err)
 	}
 	// 先查询有没有申述
 	ret, err := r.data.query.ReviewAppealInfo.WithContext(ctx).Where(
 		query.ReviewAppealInfo.ReviewID.Eq(info.ReviewID),
 		query.ReviewAppealInfo.StoreID.Eq(info.StoreID),
+		query.ReviewAppealInfo.DeleteAt.IsNull(),
 	).First()
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		r.log.WithContext(ctx).Errorf("SaveAppeal|First fail,data:%v,err:%v", info, err)
-		return nil, err
+		return nil, dbError(err)
 	}
-	// 查询不到审核过的申述记录
-	if ret != nil {
-		if ret.Status > 10 {
-			return nil, errors.New("该评价已有审核过的申述记录")
-		}
-		// 1. 有申述记录但是处于待审核状态,需要更新
-		_, err = r.data.query.ReviewAppealInfo.WithContext(ctx).
-			Where(r.data.query.ReviewAppealInfo.ReviewID.Eq(info.ReviewID)).
-			UpdateColumns(map[string]interface{}{
-				"status":     info.Status,
-				"content":    info.Content,
-				"reason":     info.Reason,
-				"pic_info":   info.PicInfo,
-				"video_info": info.VideoInfo,
-			})
-		if err != nil {
-			r.log.WithContext(ctx).Errorf("SaveAppeal|UpdateColumns fail,err:%v", err)
-			return nil, err
+	// 申诉和申诉事件在同一个事务里写入
+	err = r.data.query.Transaction(func(tx *query.Query) error {
+		// 查询不到审核过的申述记录
+		if ret != nil {
+			// 只有待审核的申述才能修改
+			if !biz.AppealStatus(ret.Status).CanTransitTo(biz.AppealStatus(info.Status)) {
+				return v1.ErrorAppealAlreadyAudited("该评价已有审核过的申述记录")
+			}
+			// 1. 有申述记录但是处于待审核状态,需要更新
+			_, err := tx.ReviewAppealInfo.WithContext(ctx).
+				Where(tx.ReviewAppealInfo.ReviewID.Eq(info.ReviewID)).
+				UpdateColumns(map[string]interface{}{
+					"status":     info.Status,
+					"content":    info.Content,
+					"reason":     info.Reason,
+					"pic_info":   info.PicInfo,
+					"video_info": info.VideoInfo,
+				})
+			if err != nil {
+				r.log.WithContext(ctx).Errorf("SaveAppeal|UpdateColumns fail,err:%v", err)
+				return err
+			}
+			info.AppealID = ret.AppealID
+		} else {
+			// 2. 没有申述记录,需要创建
+			info.AppealID = snowflake.GenerateID()
+			if err := tx.ReviewAppealInfo.WithContext(ctx).Save(info); err != nil {
+				r.log.WithContext(ctx).Errorf("SaveAppeal|Save fail,err:%v", err)
+				return err
+			}
 		}
-		return ret, nil
-
-	}
-	// 2. 没有申述记录,需要创建
-	info.AppealID = snowflake.GenerateID()
-	err = r.data.query.ReviewAppealInfo.WithContext(ctx).Save(info)
+		return saveOutbox(ctx, tx, info.ReviewID, biz.EventReviewAppealed, info)
+	})
 	if err != nil {
-		r.log.WithContext(ctx).Errorf("SaveAppeal|Save fail,err:%v", err)
-		return nil, err
+		return nil, dbError(err)
+	}
+	if ret != nil {
+		return ret, nil
 	}
 	return info, nil
 
@@ -143,7 +204,12 @@ func (r *reviewRepo) SaveAppeal(ctx context.Context, info *model.ReviewAppealInf
 func (r *reviewRepo) UpdateAppeal(ctx context.Context, info *model.ReviewAppealInfo) error {
 	var err error
 	err = r.data.query.Transaction(func(tx *query.Query) error {
-		_, err = tx.ReviewAppealInfo.WithContext(ctx).Where(r.data.query.ReviewAppealInfo.AppealID.Eq(info.AppealID)).
+		// 带上待审核状态作为条件,防止重复审核
+		ret, err := tx.ReviewAppealInfo.WithContext(ctx).
+			Where(
+				tx.ReviewAppealInfo.AppealID.Eq(info.AppealID),
+				tx.ReviewAppealInfo.Status.Eq(int32(biz.AppealPending)),
+			).
 			UpdateColumns(map[string]interface{}{
 				"status":  info.Status,
 				"op_user": info.OpUser,
@@ -153,51 +219,721 @@ func (r *reviewRepo) UpdateAppeal(ctx context.Context, info *model.ReviewAppealI
 			r.log.WithContext(ctx).Errorf("SaveAppeal|UpdateColumns fail,err:%v", err)
 			return err
 		}
-		if info.Status == biz.Approved {
+		if ret.RowsAffected == 0 {
+			return v1.ErrorAppealAlreadyAudited("申诉已被审核")
+		}
+		if biz.AppealStatus(info.Status) == biz.AppealApproved {
 			_, err = tx.ReviewInfo.WithContext(ctx).Where(tx.ReviewInfo.ReviewID.Eq(info.ReviewID)).UpdateColumns(map[string]interface{}{
-				"status": biz.Hidden,
+				"status":  int32(biz.Hidden),
+				"version": gorm.Expr("version + 1"),
 			})
 			if err != nil {
 				r.log.WithContext(ctx).Errorf("SaveAppeal|UpdateColumns fail,err:%v", err)
 				return err
 			}
 		}
-		return nil
+		return saveOutbox(ctx, tx, info.ReviewID, biz.EventReviewAppealAudited, info)
+	})
+	if err != nil {
+		return dbError(err)
+	}
+	r.invalidateReviewCache(ctx, info.ReviewID, 0)
+	return nil
+}
+
+// AuditReview 运营审核评价
+// 带上status条件更新,防止两个运营同时审核同一条评价
+func (r *reviewRepo) AuditReview(ctx context.Context, param *biz.AuditParam) error {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		ret, err := tx.ReviewInfo.
+			WithContext(ctx).
+			Where(
+				tx.ReviewInfo.ReviewID.Eq(param.ReviewId),
+				tx.ReviewInfo.Status.Eq(int32(biz.PendingReview)),
+				tx.ReviewInfo.DeleteAt.IsNull(),
+			).
+			UpdateColumns(map[string]interface{}{
+				"status":     param.Status,
+				"op_user":    param.OpUser,
+				"op_reason":  param.OpReason,
+				"op_remarks": param.OpRemarks,
+				"version":    gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			r.log.WithContext(ctx).Errorf("AuditReview|UpdateColumns fail, reviewId:%d, err:%v", param.ReviewId, err)
+			return err
+		}
+		if ret.RowsAffected == 0 {
+			return v1.ErrorNeedRetry("评价状态已变更,请刷新后重试")
+		}
+		return saveOutbox(ctx, tx, param.ReviewId, biz.EventReviewAudited, param)
 	})
-	return err
+	if err != nil {
+		return dbError(err)
+	}
+	r.invalidateReviewCache(ctx, param.ReviewId, 0)
+	return nil
+}
+
+// SaveAutoAudit 保存自动审核结果
+// 带上version和status条件,自动审核期间用户修改了评价或者运营已经审核过时不覆盖
+func (r *reviewRepo) SaveAutoAudit(ctx context.Context, param *biz.AutoAuditParam) error {
+	columns := map[string]interface{}{
+		"status":    param.Status,
+		"ctrl_json": param.CtrlJSON,
+		"version":   gorm.Expr("version + 1"),
+	}
+	// 转人工审核时审核人留空,由运营审核时填写
+	if param.Status != int32(biz.PendingReview) {
+		columns["op_user"] = biz.AutoAuditOpUser
+		columns["op_reason"] = param.OpReason
+	}
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		ri := tx.ReviewInfo
+		ret, err := ri.WithContext(ctx).
+			Where(
+				ri.ReviewID.Eq(param.ReviewId),
+				ri.Version.Eq(param.Version),
+				ri.Status.Eq(int32(biz.PendingReview)),
+				ri.DeleteAt.IsNull(),
+			).
+			UpdateColumns(columns)
+		if err != nil {
+			r.log.WithContext(ctx).Errorf("SaveAutoAudit|UpdateColumns fail, reviewId:%d, err:%v", param.ReviewId, err)
+			return err
+		}
+		if ret.RowsAffected == 0 {
+			return v1.ErrorNeedRetry("评价已被修改或已审核")
+		}
+		return saveOutbox(ctx, tx, param.ReviewId, biz.EventReviewAutoAudited, param)
+	})
+	if err != nil {
+		return dbError(err)
+	}
+	r.invalidateReviewCache(ctx, param.ReviewId, 0)
+	return nil
+}
+
+// CountUserReviews 统计用户某个状态的评价数
+func (r *reviewRepo) CountUserReviews(ctx context.Context, userId int64, status biz.ReviewStatus) (int64, error) {
+	ri := r.data.query.ReviewInfo
+	n, err := ri.WithContext(ctx).
+		Where(ri.UserID.Eq(userId), ri.Status.Eq(int32(status)), ri.DeleteAt.IsNull()).
+		Count()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("CountUserReviews|Count fail, userId:%d, err:%v", userId, err)
+		return 0, dbError(err)
+	}
+	return n, nil
 }
 
 // ListReviewByStoreId 根据storeId 分页查询评价
-func (r *reviewRepo) ListReviewByStoreId(ctx context.Context, storeId int64, offset, limit int) ([]*biz.MyReviewInfo, error) {
-	// 去ES里面查询评价
-	resp, err := r.data.es.Search().Index("review").From(offset).Size(limit).
+// 店铺页展示的审核通过的评价,不按标签筛选时前几页的查询结果缓存在redis里,其他情况直接查ES
+func (r *reviewRepo) ListReviewByStoreId(ctx context.Context, storeId int64, status *int32, tags []string, offset, limit int) ([]*biz.MyReviewInfo, error) {
+	var (
+		hits []json.RawMessage
+		err  error
+	)
+	approved := status != nil && *status == int32(biz.Approved)
+	if approved && len(tags) == 0 && limit > 0 && offset%limit == 0 && offset/limit < storeListCachePages {
+		field := fmt.Sprintf("%d:%d", offset, limit)
+		var v interface{}
+		v, err, _ = r.sf.Do(storeListCacheKey(storeId)+":"+field, func() (interface{}, error) {
+			if list, ok := r.getStoreListCache(ctx, storeId, field); ok {
+				return list, nil
+			}
+			list, err := r.searchReviewByStoreId(ctx, storeId, status, nil, offset, limit)
+			if err != nil {
+				return nil, err
+			}
+			r.setStoreListCache(ctx, storeId, field, list)
+			return list, nil
+		})
+		if err == nil {
+			hits = v.([]json.RawMessage)
+		}
+	} else {
+		hits, err = r.searchReviewByStoreId(ctx, storeId, status, tags, offset, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// 反序列化数据
+	list := make([]*biz.MyReviewInfo, 0, len(hits))
+	for _, hit := range hits {
+		tmp := &biz.MyReviewInfo{}
+		if err := json.Unmarshal(hit, tmp); err != nil {
+			r.log.Errorf("ListReviewByStoreId fail,err:%v", err)
+			continue
+		}
+		list = append(list, tmp)
+	}
+	return list, nil
+}
+
+// searchReviewByStoreId 去ES里面查询商家的评价,返回每条评价的原始json
+func (r *reviewRepo) searchReviewByStoreId(ctx context.Context, storeId int64, status *int32, tags []string, offset, limit int) ([]json.RawMessage, error) {
+	filter := []types.Query{
+		{
+			Term: map[string]types.TermQuery{
+				"store_id": {Value: storeId},
+			},
+		},
+	}
+	if status != nil {
+		filter = append(filter, types.Query{Term: map[string]types.TermQuery{"status": {Value: *status}}})
+	}
+	for _, tag := range tags {
+		filter = append(filter, types.Query{Term: map[string]types.TermQuery{"tags": {Value: tag}}})
+	}
+	resp, err := r.data.es.Search().Index(esindex.ReviewAlias).From(offset).Size(limit).
 		Query(&types.Query{
 			Bool: &types.BoolQuery{
-				Filter: []types.Query{
+				Filter: filter,
+				// 排除已经逻辑删除的评价
+				MustNot: []types.Query{
 					{
-						Term: map[string]types.TermQuery{
-							"store_id": {Value: storeId},
-						},
+						Exists: &types.ExistsQuery{Field: "delete_at"},
 					},
 				},
 			},
 		}).Do(ctx)
 	if err != nil {
 		r.log.WithContext(ctx).Errorf("ListReviewByStoreId fail,err:%v", err)
-		return nil, err
+		return nil, v1.ErrorSearchFailed("查询评价列表失败").WithCause(err)
 	}
 	fmt.Printf("es result total:%v\n", resp.Hits.Total.Value)
 	//b, _ := json.Marshal(resp.Hits.Hits)
 	//fmt.Printf("es result:%v\n", b)
-	// 反序列化数据
-	list := make([]*biz.MyReviewInfo, 0, resp.Hits.Total.Value)
+	hits := make([]json.RawMessage, 0, len(resp.Hits.Hits))
+	for _, hit := range resp.Hits.Hits {
+		hits = append(hits, hit.Source_)
+	}
+	return hits, nil
+}
+
+// GetReview 根据评价Id查询评价
+// 先查redis缓存,未命中再查数据库并回写缓存,不存在的评价也会缓存一小段时间
+func (r *reviewRepo) GetReview(ctx context.Context, reviewId int64) (*model.ReviewInfo, error) {
+	key := reviewCacheKey(reviewId)
+	v, err, _ := r.sf.Do(key, func() (interface{}, error) {
+		if val, ok := r.getCache(ctx, key); ok {
+			if val == cacheNotFound {
+				return nil, v1.ErrorReviewNotFound("评价:%d不存在", reviewId)
+			}
+			review := new(model.ReviewInfo)
+			if err := json.Unmarshal([]byte(val), review); err == nil {
+				return review, nil
+			}
+		}
+		review, err := r.getReviewFromDB(ctx, reviewId)
+		if v1.IsReviewNotFound(err) {
+			r.setCache(ctx, key, cacheNotFound, negativeCacheTTL)
+			return nil, err
+		}
+		if err != nil {
+			return nil, err
+		}
+		if b, err := json.Marshal(review); err == nil {
+			r.setCache(ctx, key, b, reviewCacheTTL)
+		}
+		return review, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// singleflight的结果会被并发的调用方共享,返回一份拷贝避免互相修改
+	review := *v.(*model.ReviewInfo)
+	return &review, nil
+}
+
+func (r *reviewRepo) getReviewFromDB(ctx context.Context, reviewId int64) (*model.ReviewInfo, error) {
+	review, err := r.data.query.ReviewInfo.
+		WithContext(ctx).
+		Where(
+			r.data.query.ReviewInfo.ReviewID.Eq(reviewId),
+			r.data.query.ReviewInfo.DeleteAt.IsNull(),
+		).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, v1.ErrorReviewNotFound("评价:%d不存在", reviewId)
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("GetReview|First fail, reviewId:%d, err:%v", reviewId, err)
+		return nil, dbError(err)
+	}
+	return review, nil
+}
+
+// GetReplyByReviewId 查询评价的商家回复,没有回复时返回nil
+func (r *reviewRepo) GetReplyByReviewId(ctx context.Context, reviewId int64) (*model.ReviewReplyInfo, error) {
+	reply, err := r.data.query.ReviewReplyInfo.
+		WithContext(ctx).
+		Where(
+			r.data.query.ReviewReplyInfo.ReviewID.Eq(reviewId),
+			r.data.query.ReviewReplyInfo.DeleteAt.IsNull(),
+		).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("GetReplyByReviewId|First fail, reviewId:%d, err:%v", reviewId, err)
+		return nil, dbError(err)
+	}
+	return reply, nil
+}
+
+// GetAppealByReviewId 查询评价的商家申诉,没有申诉时返回nil
+func (r *reviewRepo) GetAppealByReviewId(ctx context.Context, reviewId int64) (*model.ReviewAppealInfo, error) {
+	appeal, err := r.data.query.ReviewAppealInfo.
+		WithContext(ctx).
+		Where(
+			r.data.query.ReviewAppealInfo.ReviewID.Eq(reviewId),
+			r.data.query.ReviewAppealInfo.DeleteAt.IsNull(),
+		).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("GetAppealByReviewId|First fail, reviewId:%d, err:%v", reviewId, err)
+		return nil, dbError(err)
+	}
+	return appeal, nil
+}
+
+// ListReviewByUser 分页查询用户的评价(不区分状态)和对应的商家回复,返回当前页和总数
+func (r *reviewRepo) ListReviewByUser(ctx context.Context, userId int64, offset, limit int) ([]*biz.ReviewDetail, int64, error) {
+	ri := r.data.query.ReviewInfo
+	reviews, total, err := ri.WithContext(ctx).
+		Where(ri.UserID.Eq(userId), ri.DeleteAt.IsNull()).
+		Order(ri.ID.Desc()).
+		FindByPage(offset, limit)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListReviewByUser|FindByPage fail, userId:%d, err:%v", userId, err)
+		return nil, 0, dbError(err)
+	}
+	if len(reviews) == 0 {
+		return nil, total, nil
+	}
+	// 一次查出当前页所有评价的回复,避免N+1查询
+	reviewIds := make([]int64, 0, len(reviews))
+	for _, v := range reviews {
+		reviewIds = append(reviewIds, v.ReviewID)
+	}
+	rr := r.data.query.ReviewReplyInfo
+	replies, err := rr.WithContext(ctx).
+		Where(rr.ReviewID.In(reviewIds...), rr.DeleteAt.IsNull()).
+		Find()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListReviewByUser|find reply fail, userId:%d, err:%v", userId, err)
+		return nil, 0, dbError(err)
+	}
+	replyMap := make(map[int64]*model.ReviewReplyInfo, len(replies))
+	for _, v := range replies {
+		replyMap[v.ReviewID] = v
+	}
+	ret := make([]*biz.ReviewDetail, 0, len(reviews))
+	for _, v := range reviews {
+		ret = append(ret, &biz.ReviewDetail{Review: v, Reply: replyMap[v.ReviewID]})
+	}
+	return ret, total, nil
+}
+
+// UpdateReview 修改评价
+// review.Version是调用方看到的版本号,只有数据库中的版本号一致时才更新(乐观锁)
+func (r *reviewRepo) UpdateReview(ctx context.Context, review *model.ReviewInfo) error {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		ret, err := tx.ReviewInfo.
+			WithContext(ctx).
+			Where(
+				tx.ReviewInfo.ReviewID.Eq(review.ReviewID),
+				tx.ReviewInfo.Version.Eq(review.Version),
+				tx.ReviewInfo.DeleteAt.IsNull(),
+			).
+			UpdateColumns(map[string]interface{}{
+				"score":         review.Score,
+				"service_score": review.ServiceScore,
+				"express_score": review.ExpressScore,
+				"content":       review.Content,
+				"tags":          review.Tags,
+				"pic_info":      review.PicInfo,
+				"video_info":    review.VideoInfo,
+				"has_media":     review.HasMedia,
+				"status":        review.Status,
+				"ctrl_json":     review.CtrlJSON,
+				"version":       gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			r.log.WithContext(ctx).Errorf("UpdateReview|UpdateColumns fail, reviewId:%d, err:%v", review.ReviewID, err)
+			return err
+		}
+		if ret.RowsAffected == 0 {
+			return v1.ErrorNeedRetry("评价已被修改,请刷新后重试")
+		}
+		return saveOutbox(ctx, tx, review.ReviewID, biz.EventReviewUpdated, review)
+	})
+	if err != nil {
+		return dbError(err)
+	}
+	r.invalidateReviewCache(ctx, review.ReviewID, review.StoreID)
+	return nil
+}
+
+// DeleteReview 逻辑删除评价
+// 同时删除评价的商家回复、追评和待审核的申诉,放在一个事务里
+func (r *reviewRepo) DeleteReview(ctx context.Context, reviewId int64) error {
+	now := time.Now()
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		ret, err := tx.ReviewInfo.WithContext(ctx).
+			Where(tx.ReviewInfo.ReviewID.Eq(reviewId), tx.ReviewInfo.DeleteAt.IsNull()).
+			UpdateColumns(map[string]interface{}{
+				"delete_at": now,
+				"version":   gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			r.log.WithContext(ctx).Errorf("DeleteReview|delete review fail, reviewId:%d, err:%v", reviewId, err)
+			return err
+		}
+		if ret.RowsAffected == 0 {
+			return v1.ErrorReviewNotFound("评价:%d不存在", reviewId)
+		}
+		if _, err := tx.ReviewReplyInfo.WithContext(ctx).
+			Where(tx.ReviewReplyInfo.ReviewID.Eq(reviewId), tx.ReviewReplyInfo.DeleteAt.IsNull()).
+			Update(tx.ReviewReplyInfo.DeleteAt, now); err != nil {
+			r.log.WithContext(ctx).Errorf("DeleteReview|delete reply fail, reviewId:%d, err:%v", reviewId, err)
+			return err
+		}
+		// 已经审核过的申诉保留,作为运营处理记录
+		if _, err := tx.ReviewAppealInfo.WithContext(ctx).
+			Where(
+				tx.ReviewAppealInfo.ReviewID.Eq(reviewId),
+				tx.ReviewAppealInfo.Status.Eq(int32(biz.AppealPending)),
+				tx.ReviewAppealInfo.DeleteAt.IsNull(),
+			).
+			Update(tx.ReviewAppealInfo.DeleteAt, now); err != nil {
+			r.log.WithContext(ctx).Errorf("DeleteReview|delete appeal fail, reviewId:%d, err:%v", reviewId, err)
+			return err
+		}
+		if _, err := tx.ReviewAppendInfo.WithContext(ctx).
+			Where(tx.ReviewAppendInfo.ReviewID.Eq(reviewId), tx.ReviewAppendInfo.DeleteAt.IsNull()).
+			Update(tx.ReviewAppendInfo.DeleteAt, now); err != nil {
+			r.log.WithContext(ctx).Errorf("DeleteReview|delete append fail, reviewId:%d, err:%v", reviewId, err)
+			return err
+		}
+		return saveOutbox(ctx, tx, reviewId, biz.EventReviewDeleted, map[string]interface{}{
+			"review_id": reviewId,
+			"delete_at": now,
+		})
+	})
+	if err != nil {
+		return dbError(err)
+	}
+	r.invalidateReviewCache(ctx, reviewId, 0)
+	return nil
+}
+
+// ListReview 多条件筛选评价列表
+// 排序字段之后固定加review_id,保证排序稳定,游标(search_after)才不会漏数据或重复
+func (r *reviewRepo) ListReview(ctx context.Context, param *biz.ListReviewParam) (*biz.ListReviewResult, error) {
+	order := sortorder.Desc
+	if param.Asc {
+		order = sortorder.Asc
+	}
+	sortField := "create_at"
+	if param.SortBy == biz.ListSortByScore {
+		sortField = "score"
+	}
+	search := r.data.es.Search().Index(esindex.ReviewAlias).
+		Size(param.Size).
+		TrackTotalHits(true).
+		Query(buildListReviewQuery(param)).
+		Sort(
+			types.SortOptions{SortOptions: map[string]types.FieldSort{sortField: {Order: &order}}},
+			types.SortOptions{SortOptions: map[string]types.FieldSort{"review_id": {Order: &order}}},
+		)
+	if param.PageToken != "" {
+		after, err := decodePageToken(param.PageToken)
+		if err != nil {
+			return nil, v1.ErrorInvalidParam("分页参数不合法")
+		}
+		search = search.SearchAfter(after...)
+	}
+	resp, err := search.Do(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListReview fail,err:%v", err)
+		return nil, v1.ErrorSearchFailed("查询评价列表失败").WithCause(err)
+	}
+	ret := &biz.ListReviewResult{
+		List: make([]*biz.MyReviewInfo, 0, len(resp.Hits.Hits)),
+	}
+	if resp.Hits.Total != nil {
+		ret.Total = resp.Hits.Total.Value
+	}
 	for _, hit := range resp.Hits.Hits {
 		tmp := &biz.MyReviewInfo{}
 		if err := json.Unmarshal(hit.Source_, tmp); err != nil {
-			r.log.Errorf("ListReviewByStoreId fail,err:%v", err)
+			r.log.Errorf("ListReview fail,err:%v", err)
 			continue
 		}
-		list = append(list, tmp)
+		ret.List = append(ret.List, tmp)
 	}
-	return list, nil
+	// 取满一页说明可能还有下一页,用最后一条的sort值作为下一页的游标
+	if n := len(resp.Hits.Hits); n > 0 && n == param.Size {
+		ret.NextPageToken, err = encodePageToken(resp.Hits.Hits[n-1])
+		if err != nil {
+			return nil, v1.ErrorSearchFailed("生成分页游标失败").WithCause(err)
+		}
+	}
+	return ret, nil
+}
+
+// buildListReviewQuery 根据筛选条件拼装ES查询,所有条件都放在filter中(不需要算分)
+func buildListReviewQuery(param *biz.ListReviewParam) *types.Query {
+	filter := make([]types.Query, 0, 8)
+	term := func(field string, value interface{}) {
+		filter = append(filter, types.Query{
+			Term: map[string]types.TermQuery{field: {Value: value}},
+		})
+	}
+	if param.UserId > 0 {
+		term("user_id", param.UserId)
+	}
+	if param.SpuId > 0 {
+		term("spu_id", param.SpuId)
+	}
+	if param.SkuId > 0 {
+		term("sku_id", param.SkuId)
+	}
+	if param.StoreId > 0 {
+		term("store_id", param.StoreId)
+	}
+	if param.Status != nil {
+		term("status", *param.Status)
+	}
+	if param.HasMedia != nil {
+		term("has_media", boolToInt(*param.HasMedia))
+	}
+	if param.HasReply != nil {
+		term("has_reply", boolToInt(*param.HasReply))
+	}
+	if param.ExcludeAnonymous {
+		term("anonymous", 0)
+	}
+	for _, tag := range param.Tags {
+		term("tags", tag)
+	}
+	if param.MinScore > 0 || param.MaxScore > 0 {
+		scoreRange := types.NumberRangeQuery{}
+		if param.MinScore > 0 {
+			gte := types.Float64(param.MinScore)
+			scoreRange.Gte = &gte
+		}
+		if param.MaxScore > 0 {
+			lte := types.Float64(param.MaxScore)
+			scoreRange.Lte = &lte
+		}
+		filter = append(filter, types.Query{Range: map[string]types.RangeQuery{"score": scoreRange}})
+	}
+	if !param.StartTime.IsZero() || !param.EndTime.IsZero() {
+		format := "yyyy-MM-dd HH:mm:ss"
+		timeRange := types.DateRangeQuery{Format: &format}
+		if !param.StartTime.IsZero() {
+			gte := param.StartTime.Format(time.DateTime)
+			timeRange.Gte = &gte
+		}
+		if !param.EndTime.IsZero() {
+			lte := param.EndTime.Format(time.DateTime)
+			timeRange.Lte = &lte
+		}
+		filter = append(filter, types.Query{Range: map[string]types.RangeQuery{"create_at": timeRange}})
+	}
+	return &types.Query{
+		Bool: &types.BoolQuery{
+			Filter: filter,
+			// 排除已经逻辑删除的评价
+			MustNot: []types.Query{
+				{
+					Exists: &types.ExistsQuery{Field: "delete_at"},
+				},
+			},
+		},
+	}
+}
+
+// 搜索评价时检索和高亮的字段
+// tags是keyword数组,分词检索用它的text子字段
+// searchFields 搜索的字段和权重,评价内容最重要,标签次之
+// 运营备注只有运营搜索时才加上
+func searchFields(param *biz.SearchReviewParam) []string {
+	fields := []string{"content^3", "tags.text^2"}
+	if param.WithOpRemarks {
+		fields = append(fields, "op_remarks")
+	}
+	return fields
+}
+
+// SearchReviews 关键词搜索评价
+// 按相关度(_score)排序,相同分数再按review_id排序,保证search_after翻页稳定
+func (r *reviewRepo) SearchReviews(ctx context.Context, param *biz.SearchReviewParam) (*biz.SearchReviewResult, error) {
+	fields := searchFields(param)
+	highlightFields := make(map[string]types.HighlightField, len(fields))
+	for _, f := range fields {
+		highlightFields[strings.SplitN(f, "^", 2)[0]] = types.HighlightField{}
+	}
+	fragmentSize, fragments := 100, 3
+	desc := sortorder.Desc
+	search := r.data.es.Search().Index(esindex.ReviewAlias).
+		Size(param.Size).
+		TrackTotalHits(true).
+		Query(buildSearchReviewQuery(param)).
+		Highlight(&types.Highlight{
+			Fields:            highlightFields,
+			PreTags:           []string{"<em>"},
+			PostTags:          []string{"</em>"},
+			FragmentSize:      &fragmentSize,
+			NumberOfFragments: &fragments,
+		}).
+		Sort(
+			types.SortOptions{SortOptions: map[string]types.FieldSort{"_score": {Order: &desc}}},
+			types.SortOptions{SortOptions: map[string]types.FieldSort{"review_id": {Order: &desc}}},
+		)
+	if param.PageToken != "" {
+		after, err := decodePageToken(param.PageToken)
+		if err != nil {
+			return nil, v1.ErrorInvalidParam("分页参数不合法")
+		}
+		search = search.SearchAfter(after...)
+	}
+	resp, err := search.Do(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("SearchReviews fail,err:%v", err)
+		return nil, v1.ErrorSearchFailed("搜索评价失败").WithCause(err)
+	}
+	ret := &biz.SearchReviewResult{
+		List: make([]*biz.SearchReviewHit, 0, len(resp.Hits.Hits)),
+	}
+	if resp.Hits.Total != nil {
+		ret.Total = resp.Hits.Total.Value
+	}
+	for _, hit := range resp.Hits.Hits {
+		tmp := &biz.MyReviewInfo{}
+		if err := json.Unmarshal(hit.Source_, tmp); err != nil {
+			r.log.Errorf("SearchReviews fail,err:%v", err)
+			continue
+		}
+		ret.List = append(ret.List, &biz.SearchReviewHit{Review: tmp, Highlight: hit.Highlight})
+	}
+	if n := len(resp.Hits.Hits); n > 0 && n == param.Size {
+		ret.NextPageToken, err = encodePageToken(resp.Hits.Hits[n-1])
+		if err != nil {
+			return nil, v1.ErrorSearchFailed("生成分页游标失败").WithCause(err)
+		}
+	}
+	return ret, nil
+}
+
+// buildSearchReviewQuery 关键词放在must中参与算分,筛选条件放在filter中
+func buildSearchReviewQuery(param *biz.SearchReviewParam) *types.Query {
+	filter := make([]types.Query, 0, 4)
+	term := func(field string, value interface{}) {
+		filter = append(filter, types.Query{
+			Term: map[string]types.TermQuery{field: {Value: value}},
+		})
+	}
+	if param.StoreId > 0 {
+		term("store_id", param.StoreId)
+	}
+	if param.SpuId > 0 {
+		term("spu_id", param.SpuId)
+	}
+	if param.Status != nil {
+		term("status", *param.Status)
+	}
+	for _, tag := range param.Tags {
+		term("tags", tag)
+	}
+	if param.MinScore > 0 || param.MaxScore > 0 {
+		scoreRange := types.NumberRangeQuery{}
+		if param.MinScore > 0 {
+			gte := types.Float64(param.MinScore)
+			scoreRange.Gte = &gte
+		}
+		if param.MaxScore > 0 {
+			lte := types.Float64(param.MaxScore)
+			scoreRange.Lte = &lte
+		}
+		filter = append(filter, types.Query{Range: map[string]types.RangeQuery{"score": scoreRange}})
+	}
+	return &types.Query{
+		Bool: &types.BoolQuery{
+			Must: []types.Query{
+				{
+					MultiMatch: &types.MultiMatchQuery{
+						Query:  param.Keyword,
+						Fields: searchFields(param),
+					},
+				},
+			},
+			Filter: filter,
+			MustNot: []types.Query{
+				{
+					Exists: &types.ExistsQuery{Field: "delete_at"},
+				},
+			},
+		},
+	}
+}
+
+// encodePageToken 用最后一条评价生成下一页的游标: [排序字段的sort值, review_id]
+// typed client把sort值解析成float64,review_id这种超过2^53的雪花id会丢失精度
+// 所以排序字段之后的review_id从_source中取,不用hit.Sort中的值
+func encodePageToken(hit types.Hit) (string, error) {
+	if len(hit.Sort) == 0 {
+		return "", fmt.Errorf("hit has no sort values")
+	}
+	reviewId, err := sourceReviewId(hit.Source_)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal([]interface{}{hit.Sort[0], reviewId})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// sourceReviewId 从ES文档中取出review_id,canal同步的文档中数字是字符串
+func sourceReviewId(source json.RawMessage) (int64, error) {
+	var doc struct {
+		ReviewID json.RawMessage `json:"review_id"`
+	}
+	if err := json.Unmarshal(source, &doc); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.Trim(string(doc.ReviewID), `"`), 10, 64)
+}
+
+// decodePageToken 把分页游标还原成search_after的参数
+// 数字用json.Number保存,避免review_id这种大整数转成float64丢失精度
+func decodePageToken(token string) ([]types.FieldValue, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var values []types.FieldValue
+	if err := dec.Decode(&values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func boolToInt(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
 }
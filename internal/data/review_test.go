@@ -0,0 +1,249 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+)
+
+func TestPageTokenKeepsReviewIdPrecision(t *testing.T) {
+	const reviewId = int64(1857345678901234567)
+	cases := map[string]string{
+		"number": `{"review_id":1857345678901234567}`,
+		"string": `{"review_id":"1857345678901234567"}`,
+	}
+	for name, source := range cases {
+		t.Run(name, func(t *testing.T) {
+			// typed client解析出来的sort值是float64,review_id已经丢了精度
+			hit := types.Hit{
+				Source_: json.RawMessage(source),
+				Sort:    []types.FieldValue{float64(1700000000000), float64(reviewId)},
+			}
+			token, err := encodePageToken(hit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			after, err := decodePageToken(token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(after) != 2 {
+				t.Fatalf("search_after len = %d, want 2", len(after))
+			}
+			b, _ := json.Marshal(after)
+			if want := `[1700000000000,1857345678901234567]`; string(b) != want {
+				t.Fatalf("search_after = %s, want %s", b, want)
+			}
+		})
+	}
+}
+
+func TestPageTokenInvalid(t *testing.T) {
+	if _, err := encodePageToken(types.Hit{Source_: json.RawMessage(`{"review_id":1}`)}); err == nil {
+		t.Fatal("want error for hit without sort values")
+	}
+	if _, err := decodePageToken("not base64!"); err == nil {
+		t.Fatal("want error for invalid token")
+	}
+}
+
+// review_info的每次写入都要增加version,ES同步按version丢弃过期的变更
+func TestReviewWritesBumpVersion(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	if err := env.db.Create(&model.ReviewInfo{ReviewID: 1, StoreID: 3, Status: int32(biz.Approved)}).Error; err != nil {
+		t.Fatal(err)
+	}
+	version := func() int32 {
+		t.Helper()
+		var review model.ReviewInfo
+		if err := env.db.Where("review_id = ?", 1).First(&review).Error; err != nil {
+			t.Fatal(err)
+		}
+		return review.Version
+	}
+	writes := []struct {
+		name  string
+		write func() error
+	}{
+		{"SaveReply", func() error {
+			_, err := env.repo.SaveReply(ctx, &model.ReviewReplyInfo{ReplyID: 10, ReviewID: 1, StoreID: 3, Content: "感谢支持"})
+			return err
+		}},
+		{"UpdateAppeal", func() error {
+			appeal := &model.ReviewAppealInfo{AppealID: 20, ReviewID: 1, StoreID: 3, Status: int32(biz.AppealPending)}
+			if err := env.db.Create(appeal).Error; err != nil {
+				return err
+			}
+			appeal.Status = int32(biz.AppealApproved)
+			return env.repo.UpdateAppeal(ctx, appeal)
+		}},
+		{"DeleteReview", func() error {
+			return env.repo.DeleteReview(ctx, 1)
+		}},
+	}
+	for _, w := range writes {
+		before := version()
+		if err := w.write(); err != nil {
+			t.Fatalf("%s: %v", w.name, err)
+		}
+		if after := version(); after != before+1 {
+			t.Fatalf("%s: version = %d, want %d", w.name, after, before+1)
+		}
+	}
+}
+
+// 按旧版本号修改评价时返回NEED_RETRY,数据不变
+func TestUpdateReviewStaleVersion(t *testing.T) {
+	env := newTestEnv(t, nil)
+	uc, orders := newTestUsecase(t, env, &conf.Review{}, nil, nil)
+	orders.Put(&biz.Order{OrderID: 1, UserID: 9, StoreID: 3, SkuID: 20, Status: biz.OrderCompleted})
+	ctx := biz.NewCallerContext(context.Background(), &biz.Caller{Role: biz.RoleUser, UserId: 9})
+	review, err := uc.CreateReview(ctx, &model.ReviewInfo{OrderID: 1, Score: 5, Content: "味道不错"}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := func(version int32, content string) (*model.ReviewInfo, error) {
+		return uc.UpdateReview(ctx, &biz.UpdateReviewParam{ReviewId: review.ReviewID, Version: version, Score: 4, Content: content})
+	}
+	updated, err := update(review.Version, "第一次修改")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Version != review.Version+1 {
+		t.Fatalf("version = %d, want %d", updated.Version, review.Version+1)
+	}
+	// 另一个客户端还拿着修改前的版本号
+	if _, err := update(review.Version, "并发修改"); !v1.IsNeedRetry(err) {
+		t.Fatalf("err = %v, want NEED_RETRY", err)
+	}
+	got, err := env.repo.getReviewFromDB(context.Background(), review.ReviewID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Content != "第一次修改" || got.Version != updated.Version {
+		t.Fatalf("got content = %q, version = %d", got.Content, got.Version)
+	}
+	// 刷新后用新版本号可以修改
+	if _, err := update(got.Version, "第二次修改"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// deleted 查询表中review_id的记录是否都已经逻辑删除
+func deleted(t *testing.T, env *testEnv, table interface{}, reviewId int64) bool {
+	t.Helper()
+	var total, alive int64
+	if err := env.db.Model(table).Where("review_id = ?", reviewId).Count(&total).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := env.db.Model(table).Where("review_id = ? AND delete_at IS NULL", reviewId).Count(&alive).Error; err != nil {
+		t.Fatal(err)
+	}
+	if total == 0 {
+		t.Fatalf("no rows in %T for review %d", table, reviewId)
+	}
+	return alive == 0
+}
+
+// 删除评价时同一个事务里删除回复、追评和待审核的申诉,审核过的申诉保留,其他评价不受影响
+func TestDeleteReviewCascade(t *testing.T) {
+	env := newTestEnv(t, nil)
+	ctx := context.Background()
+	for _, row := range []interface{}{
+		&model.ReviewInfo{ReviewID: 1, UserID: 9, OrderID: 1, StoreID: 3, Status: int32(biz.Approved)},
+		&model.ReviewReplyInfo{ReplyID: 10, ReviewID: 1, StoreID: 3, Content: "感谢支持"},
+		&model.ReviewAppealInfo{AppealID: 20, ReviewID: 1, StoreID: 3, Status: int32(biz.AppealPending)},
+		&model.ReviewAppendInfo{AppendID: 30, ReviewID: 1, UserID: 9, StoreID: 3, Content: "用了一个月还不错"},
+		&model.ReviewInfo{ReviewID: 2, UserID: 9, OrderID: 2, StoreID: 3, Status: int32(biz.Approved)},
+		&model.ReviewAppealInfo{AppealID: 21, ReviewID: 2, StoreID: 3, Status: int32(biz.AppealRejected)},
+		&model.ReviewInfo{ReviewID: 3, UserID: 9, OrderID: 3, StoreID: 3, Status: int32(biz.Approved)},
+		&model.ReviewReplyInfo{ReplyID: 11, ReviewID: 3, StoreID: 3, Content: "欢迎再来"},
+	} {
+		if err := env.db.Create(row).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, id := range []int64{1, 2} {
+		if err := env.repo.DeleteReview(ctx, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, table := range []interface{}{&model.ReviewInfo{}, &model.ReviewReplyInfo{}, &model.ReviewAppealInfo{}, &model.ReviewAppendInfo{}} {
+		if !deleted(t, env, table, 1) {
+			t.Fatalf("%T of review 1 not deleted", table)
+		}
+	}
+	if deleted(t, env, &model.ReviewAppealInfo{}, 2) {
+		t.Fatal("rejected appeal of review 2 should be kept")
+	}
+	if deleted(t, env, &model.ReviewInfo{}, 3) || deleted(t, env, &model.ReviewReplyInfo{}, 3) {
+		t.Fatal("review 3 should not be touched")
+	}
+	// 已经删除的评价再删除返回不存在
+	if err := env.repo.DeleteReview(ctx, 1); !v1.IsReviewNotFound(err) {
+		t.Fatalf("err = %v, want REVIEW_NOT_FOUND", err)
+	}
+}
+
+// 用户只能删除自己的评价,运营可以删除任意评价
+func TestDeleteReviewOwner(t *testing.T) {
+	env := newTestEnv(t, nil)
+	uc, _ := newTestUsecase(t, env, &conf.Review{}, nil, nil)
+	if err := env.db.Create(&model.ReviewInfo{ReviewID: 1, UserID: 9, OrderID: 1, StoreID: 3, Status: int32(biz.Approved)}).Error; err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name   string
+		caller *biz.Caller
+		check  func(error) bool
+	}{
+		{"other user", &biz.Caller{Role: biz.RoleUser, UserId: 8}, v1.IsForbiddenUser},
+		{"store", &biz.Caller{Role: biz.RoleStore, StoreId: 3}, v1.IsPermissionDenied},
+		{"public", &biz.Caller{Role: biz.RolePublic}, v1.IsUnauthorized},
+	}
+	for _, c := range cases {
+		err := uc.DeleteReview(biz.NewCallerContext(context.Background(), c.caller), &biz.DeleteReviewParam{ReviewId: 1})
+		if !c.check(err) {
+			t.Fatalf("%s: err = %v", c.name, err)
+		}
+		if deleted(t, env, &model.ReviewInfo{}, 1) {
+			t.Fatalf("%s: review deleted by non-owner", c.name)
+		}
+	}
+	op := biz.NewCallerContext(context.Background(), &biz.Caller{Role: biz.RoleOperator, OpUser: "op"})
+	if err := uc.DeleteReview(op, &biz.DeleteReviewParam{ReviewId: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if !deleted(t, env, &model.ReviewInfo{}, 1) {
+		t.Fatal("review not deleted by operator")
+	}
+}
+
+func TestSearchOpRemarksOnlyForOperator(t *testing.T) {
+	for _, withOpRemarks := range []bool{false, true} {
+		q := buildSearchReviewQuery(&biz.SearchReviewParam{Keyword: "物流", WithOpRemarks: withOpRemarks})
+		b, _ := json.Marshal(q)
+		if got := strings.Contains(string(b), "op_remarks"); got != withOpRemarks {
+			t.Fatalf("withOpRemarks:%v, query = %s", withOpRemarks, b)
+		}
+	}
+}
+
+func TestSearchReviewsByTags(t *testing.T) {
+	q := buildSearchReviewQuery(&biz.SearchReviewParam{Keyword: "物流", Tags: []string{"物流快", "包装好"}})
+	b, _ := json.Marshal(q)
+	for _, tag := range []string{"物流快", "包装好"} {
+		if !strings.Contains(string(b), `"tags":{"value":"`+tag+`"}`) {
+			t.Fatalf("query = %s, want tag %s", b, tag)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"review-service/internal/biz"
+	"review-service/internal/esindex"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/sortorder"
+
+	v1 "review-service/api/review/v1"
+)
+
+// ListReviewBySpu 商品评价列表
+// query只包含spu/sku和状态条件,各tab的评价数用filter聚合在query的结果上统计,
+// 当前选中的tab放在post_filter中,只影响返回的评价,不影响聚合
+func (r *reviewRepo) ListReviewBySpu(ctx context.Context, param *biz.ListReviewBySpuParam) (*biz.ListReviewBySpuResult, error) {
+	aggs := make(map[string]types.Aggregations, len(biz.SpuTabs))
+	for _, tab := range biz.SpuTabs {
+		aggs[tab] = types.Aggregations{Filter: spuTabQuery(tab)}
+	}
+	desc := sortorder.Desc
+	search := r.data.es.Search().Index(esindex.ReviewAlias).
+		Size(param.Size).
+		Query(buildSpuReviewQuery(param)).
+		PostFilter(spuTabQuery(param.Tab)).
+		Aggregations(aggs).
+		Sort(
+			types.SortOptions{SortOptions: map[string]types.FieldSort{"create_at": {Order: &desc}}},
+			types.SortOptions{SortOptions: map[string]types.FieldSort{"review_id": {Order: &desc}}},
+		)
+	if param.PageToken != "" {
+		after, err := decodePageToken(param.PageToken)
+		if err != nil {
+			return nil, v1.ErrorInvalidParam("分页参数不合法")
+		}
+		search = search.SearchAfter(after...)
+	}
+	resp, err := search.Do(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListReviewBySpu fail,err:%v", err)
+		return nil, v1.ErrorSearchFailed("查询商品评价失败").WithCause(err)
+	}
+	ret := &biz.ListReviewBySpuResult{
+		List:      make([]*biz.MyReviewInfo, 0, len(resp.Hits.Hits)),
+		TabCounts: make(map[string]int64, len(biz.SpuTabs)),
+	}
+	for _, tab := range biz.SpuTabs {
+		if agg, ok := resp.Aggregations[tab].(*types.FilterAggregate); ok {
+			ret.TabCounts[tab] = agg.DocCount
+		}
+	}
+	for _, hit := range resp.Hits.Hits {
+		tmp := &biz.MyReviewInfo{}
+		if err := json.Unmarshal(hit.Source_, tmp); err != nil {
+			r.log.Errorf("ListReviewBySpu fail,err:%v", err)
+			continue
+		}
+		ret.List = append(ret.List, tmp)
+	}
+	if n := len(resp.Hits.Hits); n > 0 && n == param.Size {
+		ret.NextPageToken, err = encodePageToken(resp.Hits.Hits[n-1])
+		if err != nil {
+			return nil, v1.ErrorSearchFailed("生成分页游标失败").WithCause(err)
+		}
+	}
+	return ret, nil
+}
+
+// buildSpuReviewQuery 商品评价列表的公共条件: spu(sku)、标签、审核通过、未删除
+func buildSpuReviewQuery(param *biz.ListReviewBySpuParam) *types.Query {
+	filter := []types.Query{
+		{Term: map[string]types.TermQuery{"spu_id": {Value: param.SpuId}}},
+		{Term: map[string]types.TermQuery{"status": {Value: int32(biz.Approved)}}},
+	}
+	if param.SkuId > 0 {
+		filter = append(filter, types.Query{
+			Term: map[string]types.TermQuery{"sku_id": {Value: param.SkuId}},
+		})
+	}
+	for _, tag := range param.Tags {
+		filter = append(filter, types.Query{
+			Term: map[string]types.TermQuery{"tags": {Value: tag}},
+		})
+	}
+	return &types.Query{
+		Bool: &types.BoolQuery{
+			Filter: filter,
+			MustNot: []types.Query{
+				{
+					Exists: &types.ExistsQuery{Field: "delete_at"},
+				},
+			},
+		},
+	}
+}
+
+// spuTabQuery 每个tab对应的筛选条件
+func spuTabQuery(tab string) *types.Query {
+	scoreRange := func(gte, lte float64) *types.Query {
+		from, to := types.Float64(gte), types.Float64(lte)
+		return &types.Query{Range: map[string]types.RangeQuery{
+			"score": types.NumberRangeQuery{Gte: &from, Lte: &to},
+		}}
+	}
+	switch tab {
+	case biz.SpuTabMedia:
+		return &types.Query{Term: map[string]types.TermQuery{"has_media": {Value: 1}}}
+	case biz.SpuTabPositive:
+		return scoreRange(4, 5)
+	case biz.SpuTabNeutral:
+		return scoreRange(3, 3)
+	case biz.SpuTabNegative:
+		return scoreRange(1, 2)
+	}
+	return &types.Query{MatchAll: &types.MatchAllQuery{}}
+}
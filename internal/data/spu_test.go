@@ -0,0 +1,91 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"review-service/internal/biz"
+)
+
+// spuAggs 各tab的评价数,只和query有关,和选中的tab无关
+const spuAggs = `{"took":1,"timed_out":false,"_shards":{"total":1,"successful":1,"skipped":0,"failed":0},
+"hits":{"total":{"value":1,"relation":"eq"},"hits":[{"_index":"review","_id":"1","_source":{"review_id":"1"},"sort":[1700000000000,1]}]},
+"aggregations":{
+	"filter#all":{"doc_count":10},
+	"filter#media":{"doc_count":3},
+	"filter#positive":{"doc_count":7},
+	"filter#neutral":{"doc_count":2},
+	"filter#negative":{"doc_count":1}
+}}`
+
+// spuSearchBody 商品评价列表发给ES的请求体
+type spuSearchBody struct {
+	Query        json.RawMessage            `json:"query"`
+	PostFilter   json.RawMessage            `json:"post_filter"`
+	Aggregations map[string]json.RawMessage `json:"aggregations"`
+}
+
+// 选中的tab只放在post_filter里,query和聚合不随tab变化,各tab的评价数保持不变
+func TestListReviewBySpuTabs(t *testing.T) {
+	var body atomic.Value
+	env := newTestEnv(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body.Store(b)
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(spuAggs))
+	}))
+	wantCounts := map[string]int64{biz.SpuTabAll: 10, biz.SpuTabMedia: 3, biz.SpuTabPositive: 7, biz.SpuTabNeutral: 2, biz.SpuTabNegative: 1}
+	postFilters := map[string]string{
+		biz.SpuTabAll:      `"match_all"`,
+		biz.SpuTabMedia:    `"has_media":{"value":1}`,
+		biz.SpuTabPositive: `"score":{"gte":4,"lte":5}`,
+		biz.SpuTabNeutral:  `"score":{"gte":3,"lte":3}`,
+		biz.SpuTabNegative: `"score":{"gte":1,"lte":2}`,
+	}
+	var first *spuSearchBody
+	for _, tab := range biz.SpuTabs {
+		ret, err := env.repo.ListReviewBySpu(context.Background(), &biz.ListReviewBySpuParam{SpuId: 200, Tab: tab, Tags: []string{"物流快"}, Size: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(ret.TabCounts, wantCounts) || len(ret.List) != 1 || ret.List[0].ReviewID != 1 {
+			t.Fatalf("tab %s: result = %+v", tab, ret)
+		}
+
+		raw, _ := body.Load().([]byte)
+		var got spuSearchBody
+		if err := json.Unmarshal(raw, &got); err != nil {
+			t.Fatal(err)
+		}
+		query := string(got.Query)
+		// 只查审核通过且未删除的评价,标签条件在query里,tab的评价数也按标签统计
+		for _, want := range []string{`"spu_id":{"value":200}`, `"status":{"value":20}`, `"delete_at"`, `"tags":{"value":"物流快"}`} {
+			if !strings.Contains(query, want) {
+				t.Fatalf("tab %s: query = %s, want %s", tab, query, want)
+			}
+		}
+		if strings.Contains(query, "has_media") || strings.Contains(query, `"score"`) {
+			t.Fatalf("tab %s: tab filter leaked into query: %s", tab, query)
+		}
+		if !strings.Contains(string(got.PostFilter), postFilters[tab]) {
+			t.Fatalf("tab %s: post_filter = %s", tab, got.PostFilter)
+		}
+		if len(got.Aggregations) != len(biz.SpuTabs) {
+			t.Fatalf("tab %s: aggregations = %v", tab, got.Aggregations)
+		}
+		if first == nil {
+			first = &got
+			continue
+		}
+		if string(got.Query) != string(first.Query) || !reflect.DeepEqual(got.Aggregations, first.Aggregations) {
+			t.Fatalf("tab %s: query or aggregations changed with tab:\n%s\n%s", tab, raw, first.Query)
+		}
+	}
+}
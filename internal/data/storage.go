@@ -0,0 +1,98 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/redis/go-redis/v9"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+)
+
+const defaultUploadRegion = "us-east-1"
+
+type objectStorage struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewObjectStorage S3兼容的对象存储,没有配置地址时返回nil,不提供上传功能
+// 配置了region时不需要请求对象存储查询bucket所在的region
+func NewObjectStorage(c *conf.Review) (biz.ObjectStorage, error) {
+	uc := c.GetUpload()
+	if uc.GetEndpoint() == "" {
+		return nil, nil
+	}
+	region := uc.GetRegion()
+	if region == "" {
+		region = defaultUploadRegion
+	}
+	client, err := minio.New(uc.GetEndpoint(), &minio.Options{
+		Creds:  credentials.NewStaticV4(uc.GetAccessKey(), uc.GetSecretKey(), ""),
+		Secure: uc.GetUseSsl(),
+		Region: region,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &objectStorage{client: client, bucket: uc.GetBucket()}, nil
+}
+
+// PresignPut 把Content-Type和Content-Length一起签名,上传的文件类型和大小必须和申请时一致
+func (s *objectStorage) PresignPut(ctx context.Context, key, contentType string, size int64, expire time.Duration) (string, error) {
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Length", strconv.FormatInt(size, 10))
+	u, err := s.client.PresignHeader(ctx, http.MethodPut, s.bucket, key, expire, nil, header)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func (s *objectStorage) StatObject(ctx context.Context, key string) (*biz.ObjectInfo, error) {
+	info, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &biz.ObjectInfo{Size: info.Size, ContentType: info.ContentType}, nil
+}
+
+// uploadQuotaTTL 配额按天计算,key多保留一段时间,跨天的请求不会提前过期
+const uploadQuotaTTL = 25 * time.Hour
+
+func uploadQuotaKey(owner string, day time.Time) string {
+	return fmt.Sprintf("review:upload:%s:%s", owner, day.Format("20060102"))
+}
+
+// takeUploadQuotaScript 配额够用时才增加计数,检查和增加是原子的,超出配额的请求不占用配额
+// KEYS[1]: 计数的key, ARGV: 本次数量、配额、过期秒数; 返回增加后的数量,配额不够时返回-1
+var takeUploadQuotaScript = redis.NewScript(`
+local used = tonumber(redis.call('GET', KEYS[1]) or '0')
+local n = tonumber(ARGV[1])
+if used + n > tonumber(ARGV[2]) then
+	return -1
+end
+used = redis.call('INCRBY', KEYS[1], n)
+redis.call('EXPIRE', KEYS[1], ARGV[3])
+return used
+`)
+
+// TakeUploadQuota 占用上传者当天的上传配额,review:upload:{owner}:{yyyymmdd}
+func (r *reviewRepo) TakeUploadQuota(ctx context.Context, owner string, n, quota int64) (bool, error) {
+	key := uploadQuotaKey(owner, time.Now())
+	used, err := takeUploadQuotaScript.Run(ctx, r.data.rdb, []string{key}, n, quota, int64(uploadQuotaTTL/time.Second)).Int64()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("TakeUploadQuota fail, key:%s, err:%v", key, err)
+		return false, dbError(err)
+	}
+	return used >= 0, nil
+}
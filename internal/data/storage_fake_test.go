@@ -0,0 +1,96 @@
+package data
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FakeObjectStore 本地的S3兼容对象存储,只支持path-style的PUT/HEAD/GET,单元测试用
+// 不校验签名,只要求PUT请求是预签名地址;配合httptest.NewServer使用,endpoint填server的host
+type FakeObjectStore struct {
+	mu      sync.Mutex
+	objects map[string]*fakeObject
+}
+
+type fakeObject struct {
+	contentType string
+	body        []byte
+	etag        string
+	modTime     time.Time
+}
+
+func NewFakeObjectStore() *FakeObjectStore {
+	return &FakeObjectStore{objects: make(map[string]*fakeObject)}
+}
+
+// Put 直接写入对象,key是bucket/object
+func (s *FakeObjectStore) Put(key, contentType string, body []byte) {
+	sum := md5.Sum(body)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = &fakeObject{
+		contentType: contentType,
+		body:        body,
+		etag:        hex.EncodeToString(sum[:]),
+		modTime:     time.Now().UTC(),
+	}
+}
+
+// Keys 返回所有对象的key
+func (s *FakeObjectStore) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0, len(s.objects))
+	for k := range s.objects {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (s *FakeObjectStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	switch r.Method {
+	case http.MethodPut:
+		if r.URL.Query().Get("X-Amz-Signature") == "" {
+			writeS3Error(w, http.StatusForbidden, "AccessDenied")
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeS3Error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		s.Put(key, r.Header.Get("Content-Type"), body)
+		w.WriteHeader(http.StatusOK)
+	case http.MethodHead, http.MethodGet:
+		s.mu.Lock()
+		obj, ok := s.objects[key]
+		s.mu.Unlock()
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("Content-Type", obj.contentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(obj.body)))
+		w.Header().Set("ETag", `"`+obj.etag+`"`)
+		w.Header().Set("Last-Modified", obj.modTime.Format(http.TimeFormat))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(obj.body)
+		}
+	default:
+		writeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>`+code+`</Code></Error>`)
+}
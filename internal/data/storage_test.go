@@ -0,0 +1,236 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+)
+
+const testBucket = "review-media"
+
+// newUploadUsecase 对象存储用FakeObjectStore模拟的评价业务,wrap不为空时包装一下对象存储
+func newUploadUsecase(t *testing.T, env *testEnv, quota int32, wrap ...func(biz.ObjectStorage) biz.ObjectStorage) (*biz.ReviewUsecase, *FakeObjectStore) {
+	t.Helper()
+	store := NewFakeObjectStore()
+	srv := httptest.NewServer(store)
+	t.Cleanup(srv.Close)
+	c := &conf.Review{
+		Upload: &conf.Upload{
+			Endpoint:   strings.TrimPrefix(srv.URL, "http://"),
+			Bucket:     testBucket,
+			AccessKey:  "test-access-key",
+			SecretKey:  "test-secret-key",
+			PublicUrl:  "https://img.review-media.example.com",
+			DailyQuota: quota,
+		},
+		Media: &conf.Media{AllowedHosts: []string{".review-media.example.com"}},
+	}
+	storage, err := NewObjectStorage(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, w := range wrap {
+		storage = w(storage)
+	}
+	uc, orders := newTestUsecase(t, env, c, nil, storage)
+	for i := int64(1); i <= 3; i++ {
+		orders.Put(&biz.Order{OrderID: i, UserID: 9, StoreID: 3, SkuID: 20, Status: biz.OrderCompleted})
+	}
+	return uc, store
+}
+
+func uploadCtx(userId int64) context.Context {
+	return biz.NewCallerContext(context.Background(), &biz.Caller{Role: biz.RoleUser, UserId: userId})
+}
+
+func jpeg(size int64) *biz.UploadFile {
+	return &biz.UploadFile{Type: biz.MediaImage, ContentType: "image/jpeg", Size: size}
+}
+
+// upload 按上传地址上传文件
+func upload(t *testing.T, token *biz.UploadToken, body []byte) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPut, token.UploadURL, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range token.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("upload status = %d", resp.StatusCode)
+	}
+}
+
+func createWithImage(uc *biz.ReviewUsecase, userId, orderId int64, url string) error {
+	_, err := uc.CreateReview(uploadCtx(userId), &model.ReviewInfo{OrderID: orderId, Score: 5, Content: "味道不错"},
+		[]*biz.MediaItem{{URL: url, Type: biz.MediaImage}}, nil)
+	return err
+}
+
+func TestUploadAndVerify(t *testing.T) {
+	env := newTestEnv(t, nil)
+	uc, store := newUploadUsecase(t, env, 10)
+	tokens, err := uc.GetUploadToken(uploadCtx(9), []*biz.UploadFile{jpeg(4), jpeg(4)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 2 || !strings.HasPrefix(tokens[0].Key, "review/u9/") || tokens[0].URL != "https://img.review-media.example.com/"+tokens[0].Key {
+		t.Fatalf("tokens = %+v", tokens[0])
+	}
+
+	// 还没有上传完成
+	if err := createWithImage(uc, 9, 1, tokens[0].URL); !v1.IsMediaNotFound(err) {
+		t.Fatalf("err = %v, want MEDIA_NOT_FOUND", err)
+	}
+	upload(t, tokens[0], []byte("jpeg"))
+	// 不能引用别人上传的图片
+	if err := createWithImage(uc, 8, 2, tokens[0].URL); !v1.IsMediaNotFound(err) {
+		t.Fatalf("err = %v, want MEDIA_NOT_FOUND", err)
+	}
+	// 绕过上传地址写入的文件类型不合法
+	store.Put(testBucket+"/"+tokens[1].Key, "text/html", []byte("<script>"))
+	if err := createWithImage(uc, 9, 2, tokens[1].URL); !v1.IsMediaNotFound(err) {
+		t.Fatalf("err = %v, want MEDIA_NOT_FOUND", err)
+	}
+	if err := createWithImage(uc, 9, 3, tokens[0].URL); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// 允许的域名上不是本服务上传的地址都要拒绝,写法不同的同一个地址也要校验
+func TestVerifyMediaURLVariants(t *testing.T) {
+	env := newTestEnv(t, nil)
+	uc, _ := newUploadUsecase(t, env, 10)
+	tokens, err := uc.GetUploadToken(uploadCtx(9), []*biz.UploadFile{jpeg(4), jpeg(4)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	upload(t, tokens[0], []byte("jpeg"))
+	missing := tokens[1].Key
+	for _, raw := range []string{
+		"https://IMG.Review-Media.example.com/" + missing,
+		"HTTPS://img.review-media.example.com/" + missing,
+		"https://img.review-media.example.com:443/" + missing,
+		"https://img.review-media.example.com/review/u9/../u8/" + missing[len("review/u9/"):],
+		"https://cdn.review-media.example.com/" + tokens[0].Key,
+		"https://img.review-media.example.com:8443/" + tokens[0].Key,
+	} {
+		if err := createWithImage(uc, 9, 1, raw); !v1.IsMediaNotFound(err) {
+			t.Fatalf("%s: err = %v, want MEDIA_NOT_FOUND", raw, err)
+		}
+	}
+	// 同一个地址的不同写法都能认出来是本服务上传的
+	if err := createWithImage(uc, 9, 1, "https://IMG.review-media.example.com:443/"+tokens[0].Key+"?x-oss-process=resize"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// failPresign 生成上传地址失败的对象存储
+type failPresign struct {
+	biz.ObjectStorage
+	fail atomic.Bool
+}
+
+func (s *failPresign) PresignPut(ctx context.Context, key, contentType string, size int64, expire time.Duration) (string, error) {
+	if s.fail.Load() {
+		return "", errors.New("presign fail")
+	}
+	return s.ObjectStorage.PresignPut(ctx, key, contentType, size, expire)
+}
+
+func TestUploadPresignFailKeepsQuota(t *testing.T) {
+	env := newTestEnv(t, nil)
+	storage := &failPresign{}
+	storage.fail.Store(true)
+	uc, _ := newUploadUsecase(t, env, 1, func(s biz.ObjectStorage) biz.ObjectStorage {
+		storage.ObjectStorage = s
+		return storage
+	})
+	if _, err := uc.GetUploadToken(uploadCtx(9), []*biz.UploadFile{jpeg(4)}); !v1.IsDependencyFailed(err) {
+		t.Fatalf("err = %v, want DEPENDENCY_FAILED", err)
+	}
+	storage.fail.Store(false)
+	if _, err := uc.GetUploadToken(uploadCtx(9), []*biz.UploadFile{jpeg(4)}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUploadInvalidFile(t *testing.T) {
+	env := newTestEnv(t, nil)
+	uc, _ := newUploadUsecase(t, env, 1)
+	for _, f := range []*biz.UploadFile{
+		{Type: biz.MediaImage, ContentType: "text/html", Size: 4},
+		jpeg(0),
+		jpeg(11 << 20),
+	} {
+		if _, err := uc.GetUploadToken(uploadCtx(9), []*biz.UploadFile{f}); !v1.IsInvalidParam(err) {
+			t.Fatalf("file %+v: err = %v, want INVALID_PARAM", f, err)
+		}
+	}
+	// 不合法的文件不占用配额
+	if _, err := uc.GetUploadToken(uploadCtx(9), []*biz.UploadFile{jpeg(4)}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUploadQuota(t *testing.T) {
+	env := newTestEnv(t, nil)
+	uc, _ := newUploadUsecase(t, env, 3)
+	if _, err := uc.GetUploadToken(uploadCtx(9), []*biz.UploadFile{jpeg(4), jpeg(4)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.GetUploadToken(uploadCtx(9), []*biz.UploadFile{jpeg(4), jpeg(4)}); !v1.IsUploadQuotaExceeded(err) {
+		t.Fatalf("err = %v, want UPLOAD_QUOTA_EXCEEDED", err)
+	}
+	// 被拒绝的请求不占用配额,剩下的1个还能用
+	if _, err := uc.GetUploadToken(uploadCtx(9), []*biz.UploadFile{jpeg(4)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.GetUploadToken(uploadCtx(9), []*biz.UploadFile{jpeg(4)}); !v1.IsUploadQuotaExceeded(err) {
+		t.Fatalf("err = %v, want UPLOAD_QUOTA_EXCEEDED", err)
+	}
+	// 每个上传者的配额是分开的
+	if _, err := uc.GetUploadToken(uploadCtx(8), []*biz.UploadFile{jpeg(4)}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUploadQuotaConcurrent(t *testing.T) {
+	env := newTestEnv(t, nil)
+	uc, _ := newUploadUsecase(t, env, 5)
+	var (
+		wg sync.WaitGroup
+		ok int32
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := uc.GetUploadToken(uploadCtx(9), []*biz.UploadFile{jpeg(4)}); err == nil {
+				atomic.AddInt32(&ok, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	if ok != 5 {
+		t.Fatalf("granted = %d, want 5", ok)
+	}
+}
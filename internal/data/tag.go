@@ -0,0 +1,65 @@
+package data
+
+import (
+	"context"
+	"review-service/internal/biz"
+	"review-service/internal/esindex"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+
+	v1 "review-service/api/review/v1"
+)
+
+// GetTagCloud 标签云: 在店铺或者商品审核通过的评价上按tags做terms聚合,按评价数倒序
+func (r *reviewRepo) GetTagCloud(ctx context.Context, param *biz.TagCloudParam) ([]*biz.TagCount, error) {
+	field := "tags"
+	resp, err := r.data.es.Search().Index(esindex.ReviewAlias).
+		Size(0).
+		Query(buildTagCloudQuery(param)).
+		Aggregations(map[string]types.Aggregations{
+			"tags": {Terms: &types.TermsAggregation{Field: &field, Size: &param.Size}},
+		}).
+		Do(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("GetTagCloud fail, storeId:%d, spuId:%d, err:%v", param.StoreId, param.SpuId, err)
+		return nil, v1.ErrorSearchFailed("查询标签云失败").WithCause(err)
+	}
+	var ret []*biz.TagCount
+	if agg, ok := resp.Aggregations["tags"].(*types.StringTermsAggregate); ok {
+		if buckets, ok := agg.Buckets.([]types.StringTermsBucket); ok {
+			ret = make([]*biz.TagCount, 0, len(buckets))
+			for _, b := range buckets {
+				tag, _ := b.Key.(string)
+				ret = append(ret, &biz.TagCount{Tag: tag, Count: b.DocCount})
+			}
+		}
+	}
+	return ret, nil
+}
+
+// buildTagCloudQuery 标签云的条件: 店铺、商品、审核通过、未删除
+func buildTagCloudQuery(param *biz.TagCloudParam) *types.Query {
+	filter := []types.Query{
+		{Term: map[string]types.TermQuery{"status": {Value: int32(biz.Approved)}}},
+	}
+	if param.StoreId > 0 {
+		filter = append(filter, types.Query{
+			Term: map[string]types.TermQuery{"store_id": {Value: param.StoreId}},
+		})
+	}
+	if param.SpuId > 0 {
+		filter = append(filter, types.Query{
+			Term: map[string]types.TermQuery{"spu_id": {Value: param.SpuId}},
+		})
+	}
+	return &types.Query{
+		Bool: &types.BoolQuery{
+			Filter: filter,
+			MustNot: []types.Query{
+				{
+					Exists: &types.ExistsQuery{Field: "delete_at"},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,111 @@
+// Package esindex 管理评价在ES中的索引
+// 读写都通过别名ReviewAlias访问,真实的索引名带版本号(review_20060102150405),
+// 修改mapping时新建一个索引全量导入数据,再把别名原子的切换过去,查询不会中断
+package esindex
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ReviewAlias 评价索引的别名,review-service查询和review-task同步都使用这个名字
+const ReviewAlias = "review"
+
+//go:embed mapping.json
+var reviewMapping []byte
+
+type Manager struct {
+	es  *elasticsearch.TypedClient
+	log *log.Helper
+}
+
+func NewManager(es *elasticsearch.TypedClient, logger log.Logger) *Manager {
+	return &Manager{es: es, log: log.NewHelper(logger)}
+}
+
+// NewIndexName 生成带版本号的索引名
+func NewIndexName() string {
+	return ReviewAlias + "_" + time.Now().Format("20060102150405")
+}
+
+// CreateIndex 用mapping.json中的settings和mapping创建索引
+func (m *Manager) CreateIndex(ctx context.Context, index string) error {
+	_, err := m.es.Indices.Create(index).Raw(bytes.NewReader(reviewMapping)).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("创建索引%s失败: %w", index, err)
+	}
+	m.log.WithContext(ctx).Infof("[esindex] index created, index:%s", index)
+	return nil
+}
+
+// AliasIndices 查询别名当前指向的索引,别名不存在时返回空
+func (m *Manager) AliasIndices(ctx context.Context) ([]string, error) {
+	resp, err := m.es.Indices.GetAlias().Name(ReviewAlias).Do(ctx)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]string, 0, len(resp))
+	for index := range resp {
+		indices = append(indices, index)
+	}
+	return indices, nil
+}
+
+// SwitchAlias 把别名原子的切换到index,同时作为写索引
+// 如果之前是靠动态mapping自动创建出来的同名索引(没有别名),会在同一个请求里删掉它
+func (m *Manager) SwitchAlias(ctx context.Context, index string) error {
+	old, err := m.AliasIndices(ctx)
+	if err != nil {
+		return err
+	}
+	var actions []types.IndicesAction
+	if len(old) == 0 {
+		exists, err := m.es.Indices.Exists(ReviewAlias).Do(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			actions = append(actions, types.IndicesAction{
+				RemoveIndex: &types.RemoveIndexAction{Index: strPtr(ReviewAlias)},
+			})
+		}
+	}
+	for _, o := range old {
+		if o == index {
+			continue
+		}
+		actions = append(actions, types.IndicesAction{
+			Remove: &types.RemoveAction{Index: strPtr(o), Alias: strPtr(ReviewAlias)},
+		})
+	}
+	isWrite := true
+	actions = append(actions, types.IndicesAction{
+		Add: &types.AddAction{Index: strPtr(index), Alias: strPtr(ReviewAlias), IsWriteIndex: &isWrite},
+	})
+	if _, err := m.es.Indices.UpdateAliases().Actions(actions...).Do(ctx); err != nil {
+		return fmt.Errorf("切换别名到%s失败: %w", index, err)
+	}
+	m.log.WithContext(ctx).Infof("[esindex] alias switched, alias:%s, from:%v, to:%s", ReviewAlias, old, index)
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var esErr *types.ElasticsearchError
+	return errors.As(err, &esErr) && esErr.Status == http.StatusNotFound
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,222 @@
+package esindex
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// fakeDoc ES中的一个文档,version是external版本号
+type fakeDoc struct {
+	version int64
+	source  map[string]interface{}
+}
+
+// FakeES 本地的ES,只实现索引管理和bulk用到的接口,单元测试用
+// bulk按external版本号写入,版本号不大于已有文档时返回409,和ES的行为一致
+type FakeES struct {
+	mu      sync.Mutex
+	indices map[string]map[string]*fakeDoc
+	aliases map[string]bool // 别名指向的索引 -> 是否写索引
+	actions []map[string]map[string]interface{}
+	bulks   int
+	// beforeBulk 第n次(从1开始)bulk写入之前调用,用来模拟导入期间review-task的写入
+	beforeBulk func(n int)
+	// failBulk 不为空时bulk的每一条都返回这个错误
+	failBulk string
+}
+
+func NewFakeES() *FakeES {
+	return &FakeES{indices: make(map[string]map[string]*fakeDoc), aliases: make(map[string]bool)}
+}
+
+// CreateIndex 直接建一个索引,模拟动态mapping自动创建出来的索引
+func (s *FakeES) CreateIndex(index string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.indices[index] = make(map[string]*fakeDoc)
+}
+
+// SetAlias 直接把别名指向index
+func (s *FakeES) SetAlias(index string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.aliases = map[string]bool{index: true}
+}
+
+// Put 按external版本号写入文档,返回是否写入成功
+func (s *FakeES) Put(index, id string, version int64, source map[string]interface{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.put(index, id, version, source)
+}
+
+func (s *FakeES) put(index, id string, version int64, source map[string]interface{}) bool {
+	docs, ok := s.indices[index]
+	if !ok {
+		docs = make(map[string]*fakeDoc)
+		s.indices[index] = docs
+	}
+	if old, ok := docs[id]; ok && old.version >= version {
+		return false
+	}
+	docs[id] = &fakeDoc{version: version, source: source}
+	return true
+}
+
+// Doc 查询文档,不存在时返回nil
+func (s *FakeES) Doc(index, id string) *fakeDoc {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.indices[index][id]
+}
+
+// Aliases 返回别名指向的索引
+func (s *FakeES) Aliases() map[string]bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ret := make(map[string]bool, len(s.aliases))
+	for k, v := range s.aliases {
+		ret[k] = v
+	}
+	return ret
+}
+
+// Actions 返回最近一次切换别名的actions
+func (s *FakeES) Actions() []map[string]map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.actions
+}
+
+func (s *FakeES) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("X-Elastic-Product", "Elasticsearch")
+	w.Header().Set("Content-Type", "application/json")
+	path := strings.Trim(r.URL.Path, "/")
+	switch {
+	case r.Method == http.MethodPost && path == "_bulk":
+		s.bulk(w, r)
+	case r.Method == http.MethodPost && path == "_aliases":
+		s.updateAliases(w, r)
+	case r.Method == http.MethodGet && path == "_alias/"+ReviewAlias:
+		s.getAlias(w)
+	case r.Method == http.MethodHead:
+		s.mu.Lock()
+		_, ok := s.indices[path]
+		s.mu.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case r.Method == http.MethodPut && !strings.Contains(path, "/"):
+		s.CreateIndex(path)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"acknowledged": true, "shards_acknowledged": true, "index": path})
+	default:
+		writeJSON(w, http.StatusBadRequest, esError(http.StatusBadRequest, "unsupported "+r.Method+" "+path))
+	}
+}
+
+func (s *FakeES) getAlias(w http.ResponseWriter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.aliases) == 0 {
+		writeJSON(w, http.StatusNotFound, esError(http.StatusNotFound, "alias ["+ReviewAlias+"] missing"))
+		return
+	}
+	ret := make(map[string]interface{}, len(s.aliases))
+	for index := range s.aliases {
+		ret[index] = map[string]interface{}{"aliases": map[string]interface{}{ReviewAlias: map[string]interface{}{}}}
+	}
+	writeJSON(w, http.StatusOK, ret)
+}
+
+func (s *FakeES) updateAliases(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Actions []map[string]map[string]interface{} `json:"actions"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, esError(http.StatusBadRequest, err.Error()))
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.actions = req.Actions
+	for _, action := range req.Actions {
+		for typ, v := range action {
+			index, _ := v["index"].(string)
+			switch typ {
+			case "add":
+				isWrite, _ := v["is_write_index"].(bool)
+				s.aliases[index] = isWrite
+			case "remove":
+				delete(s.aliases, index)
+			case "remove_index":
+				delete(s.indices, index)
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"acknowledged": true})
+}
+
+func (s *FakeES) bulk(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.bulks++
+	n, hook := s.bulks, s.beforeBulk
+	s.mu.Unlock()
+	if hook != nil {
+		hook(n)
+	}
+
+	type bulkMeta struct {
+		Index   string `json:"_index"`
+		ID      string `json:"_id"`
+		Version int64  `json:"version"`
+	}
+	var (
+		items  []map[string]interface{}
+		errors bool
+	)
+	sc := bufio.NewScanner(r.Body)
+	sc.Buffer(make([]byte, 1<<20), 1<<20)
+	for sc.Scan() {
+		var meta map[string]bulkMeta
+		if err := json.Unmarshal(sc.Bytes(), &meta); err != nil || !sc.Scan() {
+			writeJSON(w, http.StatusBadRequest, esError(http.StatusBadRequest, "bad bulk body"))
+			return
+		}
+		var source map[string]interface{}
+		if err := json.Unmarshal(sc.Bytes(), &source); err != nil {
+			writeJSON(w, http.StatusBadRequest, esError(http.StatusBadRequest, err.Error()))
+			return
+		}
+		m := meta["index"]
+		item := map[string]interface{}{"_index": m.Index, "_id": m.ID, "status": http.StatusCreated}
+		s.mu.Lock()
+		switch {
+		case s.failBulk != "":
+			errors = true
+			item["status"] = http.StatusBadRequest
+			item["error"] = map[string]interface{}{"type": "mapper_parsing_exception", "reason": s.failBulk}
+		case !s.put(m.Index, m.ID, m.Version, source):
+			errors = true
+			item["status"] = http.StatusConflict
+			item["error"] = map[string]interface{}{"type": "version_conflict_engine_exception", "reason": "version conflict"}
+		}
+		s.mu.Unlock()
+		items = append(items, map[string]interface{}{"index": item})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"took": 1, "errors": errors, "items": items})
+}
+
+func esError(status int, reason string) map[string]interface{} {
+	return map[string]interface{}{
+		"error":  map[string]interface{}{"type": "exception", "reason": reason},
+		"status": status,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,191 @@
+package esindex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/glebarez/sqlite"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+)
+
+func newTestManager(t *testing.T, es *FakeES) *Manager {
+	t.Helper()
+	srv := httptest.NewServer(es)
+	t.Cleanup(srv.Close)
+	client, err := elasticsearch.NewTypedClient(elasticsearch.Config{Addresses: []string{srv.URL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewManager(client, log.DefaultLogger)
+}
+
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	if err := db.AutoMigrate(&model.ReviewInfo{}); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// 删除的版本号要保留到全量重建结束,见mapping.json中的说明
+func TestMappingGcDeletes(t *testing.T) {
+	var mapping struct {
+		Settings map[string]interface{} `json:"settings"`
+	}
+	if err := json.Unmarshal(reviewMapping, &mapping); err != nil {
+		t.Fatal(err)
+	}
+	v, _ := mapping.Settings["gc_deletes"].(string)
+	d, err := time.ParseDuration(v)
+	if err != nil || d < time.Hour {
+		t.Fatalf("gc_deletes = %q, want at least 1h", v)
+	}
+}
+
+func TestSwitchAlias(t *testing.T) {
+	cases := []struct {
+		name    string
+		setup   func(es *FakeES)
+		actions []string
+	}{
+		{"first switch", func(es *FakeES) {}, []string{"add review_new"}},
+		// 之前靠动态mapping自动创建的同名索引在同一个请求里删掉
+		{"replace auto created index", func(es *FakeES) { es.CreateIndex(ReviewAlias) }, []string{"remove_index review", "add review_new"}},
+		{"move alias", func(es *FakeES) { es.CreateIndex("review_old"); es.SetAlias("review_old") }, []string{"remove review_old", "add review_new"}},
+		// 重复切换到同一个索引不会先删掉别名
+		{"same index", func(es *FakeES) { es.SetAlias("review_new") }, []string{"add review_new"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			es := NewFakeES()
+			c.setup(es)
+			es.CreateIndex("review_new")
+			if err := newTestManager(t, es).SwitchAlias(context.Background(), "review_new"); err != nil {
+				t.Fatal(err)
+			}
+			var actions []string
+			for _, action := range es.Actions() {
+				for typ, v := range action {
+					actions = append(actions, typ+" "+v["index"].(string))
+				}
+			}
+			if !reflect.DeepEqual(actions, c.actions) {
+				t.Fatalf("actions = %v, want %v", actions, c.actions)
+			}
+			// 新索引是别名唯一的写索引
+			if got := es.Aliases(); !reflect.DeepEqual(got, map[string]bool{"review_new": true}) {
+				t.Fatalf("aliases = %v", got)
+			}
+		})
+	}
+}
+
+// 补数据时按external版本号写入,比索引中旧的才写入,冲突说明已经是更新的数据,忽略
+func TestBulkIndexVersion(t *testing.T) {
+	es := NewFakeES()
+	m := newTestManager(t, es)
+	ctx := context.Background()
+	es.Put("review_new", "1", 3, map[string]interface{}{"content": "review-task写入的"})
+	rows := []*model.ReviewInfo{
+		{ID: 1, ReviewID: 1, Version: 2, Content: "旧版本"},
+		{ID: 2, ReviewID: 2, Version: 1, Content: "新评价"},
+	}
+	if err := m.bulkIndex(ctx, "review_new", rows); err != nil {
+		t.Fatal(err)
+	}
+	if doc := es.Doc("review_new", "1"); doc.version != 3 || doc.source["content"] != "review-task写入的" {
+		t.Fatalf("doc 1 = %+v, want version 3 kept", doc)
+	}
+	if doc := es.Doc("review_new", "2"); doc == nil || doc.version != 1 {
+		t.Fatalf("doc 2 = %+v, want version 1", doc)
+	}
+	// 同一个版本重复写入也是冲突,不报错
+	if err := m.bulkIndex(ctx, "review_new", rows[1:]); err != nil {
+		t.Fatal(err)
+	}
+	// 其他错误要返回
+	es.mu.Lock()
+	es.failBulk = "failed to parse"
+	es.mu.Unlock()
+	if err := m.bulkIndex(ctx, "review_new", rows[1:]); err == nil || !strings.Contains(err.Error(), "failed to parse") {
+		t.Fatalf("err = %v, want bulk error", err)
+	}
+}
+
+// 全量导入期间的变更在切换别名之后补上,补数据时不会覆盖review-task已经写入的更新版本
+func TestReindexCatchUp(t *testing.T) {
+	db := newTestDB(t)
+	es := NewFakeES()
+	es.CreateIndex("review_old")
+	es.SetAlias("review_old")
+	m := newTestManager(t, es)
+
+	old := time.Now().Add(-time.Hour)
+	for i := int64(1); i <= 3; i++ {
+		if err := db.Create(&model.ReviewInfo{ReviewID: i, OrderID: i, Version: 1, Content: "原始内容", CreateAt: old, UpdateAt: old}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	update := func(reviewId int64, version int32, content string) {
+		t.Helper()
+		if err := db.Model(&model.ReviewInfo{}).Where("review_id = ?", reviewId).
+			Updates(map[string]interface{}{"version": version, "content": content, "update_at": time.Now()}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	var newIndex string
+	es.beforeBulk = func(n int) {
+		switch n {
+		case 1:
+			// 全量导入的数据已经查出来了,这时评价1、2被修改,review-task写到了还在别名上的旧索引
+			update(1, 2, "导入期间修改")
+			update(2, 2, "导入期间修改")
+		case 2:
+			// 补数据查出评价1的版本2之后、写入之前,评价1又被修改,review-task已经写到了新索引
+			for index := range es.Aliases() {
+				newIndex = index
+			}
+			update(1, 3, "切换之后修改")
+			es.Put(newIndex, "1", 3, map[string]interface{}{"content": "切换之后修改"})
+		}
+	}
+
+	index, err := m.Reindex(context.Background(), query.Use(db))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index != newIndex || !reflect.DeepEqual(es.Aliases(), map[string]bool{index: true}) {
+		t.Fatalf("index = %s, aliases = %v", index, es.Aliases())
+	}
+	want := map[string]struct {
+		version int64
+		content string
+	}{
+		"1": {3, "切换之后修改"},
+		"2": {2, "导入期间修改"},
+		"3": {1, "原始内容"},
+	}
+	for id, w := range want {
+		doc := es.Doc(index, id)
+		if doc == nil || doc.version != w.version || doc.source["content"] != w.content {
+			t.Fatalf("doc %s = %+v, want %+v", id, doc, w)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package esindex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"strconv"
+	"time"
+)
+
+const reindexBatchSize = 500
+
+// Reindex 从MySQL全量重建索引,返回新索引名
+// 1. 新建带mapping的索引 2. 分批导入review_info 3. 原子切换别名 4. 补齐导入期间的变更
+// 导入时带上version(external),和review-task的同步同时进行也不会写入旧数据
+// 删除的版本号只保留index.gc_deletes,重建的耗时必须比它短,否则已经删除的评价会被写回来(见mapping.json)
+// 旧索引不会删除,确认没问题之后手动删除,需要回滚时把别名切回去就可以
+func (m *Manager) Reindex(ctx context.Context, q *query.Query) (string, error) {
+	index := NewIndexName()
+	if err := m.CreateIndex(ctx, index); err != nil {
+		return "", err
+	}
+	start := time.Now()
+	n, err := m.load(ctx, q, index, time.Time{})
+	if err != nil {
+		return "", err
+	}
+	m.log.WithContext(ctx).Infof("[esindex] full load done, index:%s, count:%d", index, n)
+	if err := m.SwitchAlias(ctx, index); err != nil {
+		return "", err
+	}
+	// 导入期间review-task的变更写到了旧索引,切换之后再补一次
+	// 往前多取一分钟,避免数据库和本机时钟不一致漏数据
+	n, err = m.load(ctx, q, index, start.Add(-time.Minute))
+	if err != nil {
+		return "", err
+	}
+	m.log.WithContext(ctx).Infof("[esindex] catch up done, index:%s, count:%d", index, n)
+	return index, nil
+}
+
+// load 按id顺序分批把review_info导入index,since不为零时只导入update_at在since之后的评价
+// 逻辑删除的评价也要导入,和canal同步过来的数据保持一致,查询时再过滤
+func (m *Manager) load(ctx context.Context, q *query.Query, index string, since time.Time) (int, error) {
+	ri := q.ReviewInfo
+	var (
+		lastId int64
+		total  int
+	)
+	for {
+		do := ri.WithContext(ctx).Where(ri.ID.Gt(lastId))
+		if !since.IsZero() {
+			do = do.Where(ri.UpdateAt.Gte(since))
+		}
+		rows, err := do.Order(ri.ID).Limit(reindexBatchSize).Find()
+		if err != nil {
+			return total, err
+		}
+		if len(rows) == 0 {
+			return total, nil
+		}
+		if err := m.bulkIndex(ctx, index, rows); err != nil {
+			return total, err
+		}
+		total += len(rows)
+		lastId = rows[len(rows)-1].ID
+	}
+}
+
+func (m *Manager) bulkIndex(ctx context.Context, index string, rows []*model.ReviewInfo) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, r := range rows {
+		meta := map[string]interface{}{
+			"index": map[string]interface{}{
+				"_index":       index,
+				"_id":          strconv.FormatInt(r.ReviewID, 10),
+				"version":      r.Version,
+				"version_type": "external",
+			},
+		}
+		if err := enc.Encode(meta); err != nil {
+			return err
+		}
+		if err := enc.Encode(reviewDoc(r)); err != nil {
+			return err
+		}
+	}
+	resp, err := m.es.Bulk().Raw(&buf).Do(ctx)
+	if err != nil {
+		return err
+	}
+	if !resp.Errors {
+		return nil
+	}
+	for _, item := range resp.Items {
+		for _, ret := range item {
+			// 版本冲突说明review-task已经写入了相同或更新的数据,忽略
+			if ret.Error != nil && ret.Status != http.StatusConflict {
+				return fmt.Errorf("写入评价%v失败: %v", ptrValue(ret.Id_), ptrValue(ret.Error.Reason))
+			}
+		}
+	}
+	return nil
+}
+
+// reviewDoc 转换成和canal消息一样的文档格式: 列名做key,值都是字符串,NULL是null
+func reviewDoc(r *model.ReviewInfo) map[string]interface{} {
+	i64 := func(v int64) string { return strconv.FormatInt(v, 10) }
+	i32 := func(v int32) string { return strconv.FormatInt(int64(v), 10) }
+	var deleteAt interface{}
+	if r.DeleteAt != nil {
+		deleteAt = r.DeleteAt.Format(time.DateTime)
+	}
+	return NormalizeDoc(map[string]interface{}{
+		"id":              i64(r.ID),
+		"create_by":       r.CreateBy,
+		"update_by":       r.UpdateBy,
+		"create_at":       r.CreateAt.Format(time.DateTime),
+		"update_at":       r.UpdateAt.Format(time.DateTime),
+		"version":         i32(r.Version),
+		"delete_at":       deleteAt,
+		"review_id":       i64(r.ReviewID),
+		"content":         r.Content,
+		"score":           i32(r.Score),
+		"service_score":   i32(r.ServiceScore),
+		"express_score":   i32(r.ExpressScore),
+		"has_media":       i32(r.HasMedia),
+		"order_id":        i64(r.OrderID),
+		"sku_id":          i64(r.SkuID),
+		"spu_id":          i64(r.SpuID),
+		"store_id":        i64(r.StoreID),
+		"user_id":         i64(r.UserID),
+		"anonymous":       i32(r.Anonymous),
+		"tags":            r.Tags,
+		"pic_info":        r.PicInfo,
+		"video_info":      r.VideoInfo,
+		"status":          i32(r.Status),
+		"is_default":      i32(r.IsDefault),
+		"has_reply":       i32(r.HasReply),
+		"op_reason":       r.OpReason,
+		"op_remarks":      r.OpRemarks,
+		"op_user":         r.OpUser,
+		"goods_snapshoot": r.GoodsSnapshoot,
+		"ext_json":        r.ExtJSON,
+		"ctrl_json":       r.CtrlJSON,
+	})
+}
+
+// NormalizeDoc 把canal格式的文档转换成索引中的格式,review-task同步和全量导入都要调用
+// tags列是JSON字符串,索引中是keyword数组,格式不对时当作没有标签
+func NormalizeDoc(doc map[string]interface{}) map[string]interface{} {
+	if raw, ok := doc["tags"].(string); ok {
+		tags := []string{}
+		if raw != "" && json.Unmarshal([]byte(raw), &tags) != nil {
+			tags = []string{}
+		}
+		doc["tags"] = tags
+	}
+	return doc
+}
+
+func ptrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
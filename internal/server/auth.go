@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 网关认证通过后透传的调用方身份请求头
+const (
+	headerCallerRole    = "X-Caller-Role"
+	headerCallerUserId  = "X-Caller-User-Id"
+	headerCallerStoreId = "X-Caller-Store-Id"
+	headerCallerOpUser  = "X-Caller-Op-User"
+)
+
+// callerClaims JWT中携带的调用方身份
+type callerClaims struct {
+	Role    string `json:"role"`
+	UserId  int64  `json:"user_id,omitempty"`
+	StoreId int64  `json:"store_id,omitempty"`
+	OpUser  string `json:"op_user,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Auth 解析调用方身份放到ctx中,biz层通过biz.CallerFromContext获取
+// 1. 有Authorization: Bearer <token>时校验JWT(HS256),token必须带exp,配置了issuer、audience时一并校验,
+//    校验失败或者没有配置密钥时直接返回401,不会再看网关请求头
+// 2. 没有token并且信任网关时,从X-Caller-*请求头中取
+// 3. 都没有时按未登录的C端访客处理,由biz层决定接口是否允许匿名访问
+func Auth(c *conf.Server_Auth) middleware.Middleware {
+	secret := []byte(c.GetJwtSecret())
+	trustGateway := c.GetTrustGateway()
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+	if c.GetIssuer() != "" {
+		opts = append(opts, jwt.WithIssuer(c.GetIssuer()))
+	}
+	if c.GetAudience() != "" {
+		opts = append(opts, jwt.WithAudience(c.GetAudience()))
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			header := tr.RequestHeader()
+			var (
+				caller *biz.Caller
+				err    error
+			)
+			if auth := header.Get("Authorization"); auth != "" {
+				if len(secret) == 0 {
+					return nil, v1.ErrorUnauthorized("未配置token密钥")
+				}
+				caller, err = parseToken(auth, secret, opts)
+			} else if trustGateway && header.Get(headerCallerRole) != "" {
+				caller, err = parseGatewayHeader(header)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if caller != nil {
+				ctx = biz.NewCallerContext(ctx, caller)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+func parseToken(auth string, secret []byte, opts []jwt.ParserOption) (*biz.Caller, error) {
+	token, ok := strings.CutPrefix(auth, "Bearer ")
+	if !ok {
+		return nil, v1.ErrorUnauthorized("Authorization格式错误")
+	}
+	claims := &callerClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return secret, nil
+	}, opts...)
+	if err != nil {
+		return nil, v1.ErrorUnauthorized("token无效").WithCause(err)
+	}
+	return newCaller(claims.Role, claims.UserId, claims.StoreId, claims.OpUser)
+}
+
+func parseGatewayHeader(header transport.Header) (*biz.Caller, error) {
+	var userId, storeId int64
+	var err error
+	if v := header.Get(headerCallerUserId); v != "" {
+		if userId, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, v1.ErrorUnauthorized("%s不合法", headerCallerUserId)
+		}
+	}
+	if v := header.Get(headerCallerStoreId); v != "" {
+		if storeId, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, v1.ErrorUnauthorized("%s不合法", headerCallerStoreId)
+		}
+	}
+	return newCaller(header.Get(headerCallerRole), userId, storeId, header.Get(headerCallerOpUser))
+}
+
+// newCaller 校验角色和角色对应的身份字段
+func newCaller(role string, userId, storeId int64, opUser string) (*biz.Caller, error) {
+	caller := &biz.Caller{Role: biz.Role(role)}
+	switch caller.Role {
+	case biz.RoleUser:
+		caller.UserId = userId
+		if userId <= 0 {
+			return nil, v1.ErrorUnauthorized("缺少用户Id")
+		}
+	case biz.RoleStore:
+		caller.StoreId = storeId
+		if storeId <= 0 {
+			return nil, v1.ErrorUnauthorized("缺少商家Id")
+		}
+	case biz.RoleOperator:
+		caller.OpUser = opUser
+		if opUser == "" {
+			return nil, v1.ErrorUnauthorized("缺少运营账号")
+		}
+	default:
+		return nil, v1.ErrorUnauthorized("未知角色: %s", role)
+	}
+	return caller, nil
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testSecret = "jwt-from-env"
+
+// testTransport 只提供请求头的transport
+type testTransport struct {
+	header http.Header
+}
+
+func (t *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *testTransport) Endpoint() string                { return "" }
+func (t *testTransport) Operation() string               { return "" }
+func (t *testTransport) RequestHeader() transport.Header { return headerCarrier(t.header) }
+func (t *testTransport) ReplyHeader() transport.Header   { return headerCarrier(http.Header{}) }
+
+type headerCarrier http.Header
+
+func (h headerCarrier) Get(key string) string      { return http.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { http.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { http.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return http.Header(h).Values(key) }
+
+func signToken(t *testing.T, claims jwt.RegisteredClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &callerClaims{
+		Role:             string(biz.RoleUser),
+		UserId:           9,
+		RegisteredClaims: claims,
+	}).SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return "Bearer " + token
+}
+
+// runAuth 带着请求头执行认证中间件,返回解析出的调用方
+func runAuth(c *conf.Server_Auth, header http.Header) (*biz.Caller, error) {
+	ctx := transport.NewServerContext(context.Background(), &testTransport{header: header})
+	var caller *biz.Caller
+	_, err := Auth(c)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		caller = biz.CallerFromContext(ctx)
+		return nil, nil
+	})(ctx, nil)
+	return caller, err
+}
+
+func TestAuthToken(t *testing.T) {
+	c := &conf.Server_Auth{JwtSecret: testSecret, Issuer: "passport", Audience: "review", TrustGateway: true}
+	exp := jwt.NewNumericDate(time.Now().Add(time.Hour))
+	valid := jwt.RegisteredClaims{ExpiresAt: exp, Issuer: "passport", Audience: jwt.ClaimStrings{"review"}}
+
+	caller, err := runAuth(c, http.Header{"Authorization": {signToken(t, valid)}})
+	if err != nil || caller.Role != biz.RoleUser || caller.UserId != 9 {
+		t.Fatalf("caller = %+v, err = %v", caller, err)
+	}
+
+	cases := map[string]jwt.RegisteredClaims{
+		"no exp":         {Issuer: "passport", Audience: jwt.ClaimStrings{"review"}},
+		"expired":        {ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)), Issuer: "passport", Audience: jwt.ClaimStrings{"review"}},
+		"wrong issuer":   {ExpiresAt: exp, Issuer: "other", Audience: jwt.ClaimStrings{"review"}},
+		"wrong audience": {ExpiresAt: exp, Issuer: "passport", Audience: jwt.ClaimStrings{"other"}},
+	}
+	for name, claims := range cases {
+		t.Run(name, func(t *testing.T) {
+			// token校验失败时不会退回到网关请求头
+			header := http.Header{"Authorization": {signToken(t, claims)}, headerCallerRole: {string(biz.RoleOperator)}, headerCallerOpUser: {"op"}}
+			if _, err := runAuth(c, header); !v1.IsUnauthorized(err) {
+				t.Fatalf("err = %v, want UNAUTHORIZED", err)
+			}
+		})
+	}
+}
+
+// 没有配置密钥时带token的请求直接拒绝,不能退回到网关请求头
+func TestAuthTokenWithoutSecret(t *testing.T) {
+	c := &conf.Server_Auth{TrustGateway: true}
+	header := http.Header{"Authorization": {"Bearer x"}, headerCallerRole: {string(biz.RoleOperator)}, headerCallerOpUser: {"op"}}
+	if _, err := runAuth(c, header); !v1.IsUnauthorized(err) {
+		t.Fatalf("err = %v, want UNAUTHORIZED", err)
+	}
+	// 没有token时照常使用网关请求头
+	header.Del("Authorization")
+	caller, err := runAuth(c, header)
+	if err != nil || caller.Role != biz.RoleOperator || caller.OpUser != "op" {
+		t.Fatalf("caller = %+v, err = %v", caller, err)
+	}
+}
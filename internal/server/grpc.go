@@ -16,6 +16,7 @@ func NewGRPCServer(c *conf.Server, review *service.ReviewService, logger log.Log
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			Auth(c.GetAuth()),
 			validate.Validator(),
 		),
 	}
@@ -16,6 +16,7 @@ func NewHTTPServer(c *conf.Server, review *service.ReviewService, logger log.Log
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			Auth(c.GetAuth()),
 			validate.Validator(),
 		),
 	}
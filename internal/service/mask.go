@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+)
+
+// anonymousName 匿名评价对外展示的用户名
+const anonymousName = "匿名用户"
+
+// reviewMasker 评价的脱敏策略,所有返回评价的接口都要经过它
+// 订单号只有运营和评价作者本人能看到,其他人拿到订单号可以关联出买家
+// 运营处理信息: 评价和申诉的运营备注、审核人只有运营能看到,评价的审核原因只有运营和评价作者本人能看到
+// 匿名评价:
+//   - 运营: 看到真实用户
+//   - 评价作者本人: 看到自己的真实信息
+//   - 商家: 隐藏userId,展示按店铺生成的匿名代号,同一个用户在同一个店铺的代号固定,不同店铺之间无法关联
+//   - 其他C端用户和未登录访客: 隐藏userId,只展示"匿名用户"
+type reviewMasker struct {
+	secret []byte
+}
+
+func newReviewMasker(secret string) *reviewMasker {
+	return &reviewMasker{secret: []byte(secret)}
+}
+
+// Mask 按ctx中调用方的角色对评价脱敏
+func (m *reviewMasker) Mask(ctx context.Context, review *pb.ReviewInfo) *pb.ReviewInfo {
+	if review == nil {
+		return review
+	}
+	caller := biz.CallerFromContext(ctx)
+	if caller.Role == biz.RoleOperator {
+		return review
+	}
+	owner := caller.Role == biz.RoleUser && caller.UserId == review.UserId
+	review.OpRemarks, review.OpUser = "", ""
+	if !owner {
+		review.OrderId = 0
+		review.OpReason = ""
+		if review.Append != nil {
+			review.Append.OpReason = ""
+		}
+	}
+	if owner || !review.Anonymous {
+		return review
+	}
+	switch {
+	case caller.Role == biz.RoleStore && len(m.secret) > 0:
+		review.UserAlias = anonymousName + "_" + m.pseudonym(review.StoreId, review.UserId)
+	default:
+		review.UserAlias = anonymousName
+	}
+	review.UserId = 0
+	return review
+}
+
+// MaskAppeal 申诉的运营备注和审核人只有运营能看到
+func (m *reviewMasker) MaskAppeal(ctx context.Context, appeal *pb.ReviewAppealInfo) *pb.ReviewAppealInfo {
+	if appeal == nil || biz.CallerFromContext(ctx).Role == biz.RoleOperator {
+		return appeal
+	}
+	appeal.OpRemarks, appeal.OpUser = "", ""
+	return appeal
+}
+
+// pseudonym 用HMAC(店铺Id+用户Id)生成匿名代号,没有密钥无法反推出userId
+func (m *reviewMasker) pseudonym(storeId, userId int64) string {
+	h := hmac.New(sha256.New, m.secret)
+	h.Write([]byte(strconv.FormatInt(storeId, 10) + ":" + strconv.FormatInt(userId, 10)))
+	return hex.EncodeToString(h.Sum(nil))[:8]
+}
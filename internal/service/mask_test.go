@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+)
+
+func newMaskReview(anonymous bool) *pb.ReviewInfo {
+	return &pb.ReviewInfo{
+		ReviewId:  1,
+		UserId:    9,
+		OrderId:   100,
+		StoreId:   3,
+		Anonymous: anonymous,
+		OpReason:  "含有广告",
+		OpRemarks: "二次审核",
+		OpUser:    "op",
+		Append:    &pb.ReviewAppendInfo{ReviewId: 1, OpReason: "含有广告"},
+	}
+}
+
+func TestMask(t *testing.T) {
+	m := newReviewMasker("secret")
+	cases := []struct {
+		name      string
+		caller    *biz.Caller
+		anonymous bool
+		// 期望看到的字段
+		userId    bool
+		orderId   bool
+		opReason  bool
+		opRemarks bool
+		alias     string
+	}{
+		{"operator", &biz.Caller{Role: biz.RoleOperator, OpUser: "op"}, true, true, true, true, true, ""},
+		{"owner", &biz.Caller{Role: biz.RoleUser, UserId: 9}, true, true, true, true, false, ""},
+		{"other user", &biz.Caller{Role: biz.RoleUser, UserId: 8}, false, true, false, false, false, ""},
+		{"other user anonymous", &biz.Caller{Role: biz.RoleUser, UserId: 8}, true, false, false, false, false, anonymousName},
+		{"public", &biz.Caller{Role: biz.RolePublic}, false, true, false, false, false, ""},
+		{"store", &biz.Caller{Role: biz.RoleStore, StoreId: 3}, false, true, false, false, false, ""},
+		{"store anonymous", &biz.Caller{Role: biz.RoleStore, StoreId: 3}, true, false, false, false, false, anonymousName + "_" + m.pseudonym(3, 9)},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := biz.NewCallerContext(context.Background(), c.caller)
+			got := m.Mask(ctx, newMaskReview(c.anonymous))
+			if (got.UserId != 0) != c.userId {
+				t.Errorf("userId = %d", got.UserId)
+			}
+			if (got.OrderId != 0) != c.orderId {
+				t.Errorf("orderId = %d", got.OrderId)
+			}
+			if (got.OpReason != "") != c.opReason || (got.Append.OpReason != "") != c.opReason {
+				t.Errorf("opReason = %q, append opReason = %q", got.OpReason, got.Append.OpReason)
+			}
+			if (got.OpRemarks != "" || got.OpUser != "") != c.opRemarks {
+				t.Errorf("opRemarks = %q, opUser = %q", got.OpRemarks, got.OpUser)
+			}
+			if got.UserAlias != c.alias {
+				t.Errorf("userAlias = %q, want %q", got.UserAlias, c.alias)
+			}
+		})
+	}
+}
@@ -3,8 +3,12 @@ package service
 import (
 	"context"
 	"fmt"
+	"math"
+	"net/http"
 	"review-service/internal/biz"
+	"review-service/internal/conf"
 	"review-service/internal/data/model"
+	"time"
 
 	pb "review-service/api/review/v1"
 )
@@ -12,11 +16,12 @@ import (
 type ReviewService struct {
 	pb.UnimplementedReviewServer
 
-	uc *biz.ReviewUsecase
+	uc     *biz.ReviewUsecase
+	masker *reviewMasker
 }
 
-func NewReviewService(uc *biz.ReviewUsecase) *ReviewService {
-	return &ReviewService{uc: uc}
+func NewReviewService(c *conf.Review, uc *biz.ReviewUsecase) *ReviewService {
+	return &ReviewService{uc: uc, masker: newReviewMasker(c.GetAnonymousSecret())}
 }
 
 func (s *ReviewService) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.CreateReviewReply, error) {
@@ -29,17 +34,13 @@ func (s *ReviewService) CreateReview(ctx context.Context, req *pb.CreateReviewRe
 		anonymous = 1
 	}
 	review, err := s.uc.CreateReview(ctx, &model.ReviewInfo{
-		UserID:       req.GetUserId(),
 		OrderID:      req.GetOrderId(),
 		Score:        req.GetScore(),
 		ServiceScore: req.GetServiceScore(),
 		ExpressScore: req.GetExpressScore(),
 		Content:      req.GetContent(),
-		PicInfo:      req.GetPicInfo(),
-		VideoInfo:    req.GetVideoInfo(),
 		Anonymous:    anonymous,
-		Status:       0,
-	})
+	}, toBizMedia(req.GetMedia()), req.GetTags())
 	if err != nil {
 		return nil, err
 	}
@@ -53,11 +54,9 @@ func (s *ReviewService) ReplyReview(ctx context.Context, req *pb.ReplyReviewRequ
 
 	// 调用biz层
 	reply, err := s.uc.CreateReply(ctx, &biz.ReplyParam{
-		ReviewId:  req.GetReviewId(),
-		StoreId:   req.GetStoreId(),
-		Content:   req.GetContent(),
-		PicInfo:   req.GetPicInfo(),
-		VideoInfo: req.GetVideoInfo(),
+		ReviewId: req.GetReviewId(),
+		Content:  req.GetContent(),
+		Media:    toBizMedia(req.GetMedia()),
 	})
 	if err != nil {
 		return nil, err
@@ -69,13 +68,10 @@ func (s *ReviewService) ReplyReview(ctx context.Context, req *pb.ReplyReviewRequ
 func (s *ReviewService) AppealReview(ctx context.Context, req *pb.AppealReviewRequest) (*pb.AppealReviewReply, error) {
 	fmt.Printf("[service] AppealReview, req:%+v\n", req)
 	ret, err := s.uc.CreateAppeal(ctx, &biz.AppealParam{
-		ReviewId:  req.GetReviewId(),
-		StoreId:   req.GetStoreId(),
-		Content:   req.GetContent(),
-		PicInfo:   req.GetPicInfo(),
-		VideoInfo: req.GetVideoInfo(),
-		OpUser:    req.GetOpUser(),
-		Reason:    req.GetReason(),
+		ReviewId: req.GetReviewId(),
+		Content:  req.GetContent(),
+		Media:    toBizMedia(req.GetMedia()),
+		Reason:   req.GetReason(),
 	})
 	if err != nil {
 		return nil, err
@@ -83,6 +79,20 @@ func (s *ReviewService) AppealReview(ctx context.Context, req *pb.AppealReviewRe
 	return &pb.AppealReviewReply{AppealId: ret.AppealID}, nil
 }
 
+func (s *ReviewService) AuditReview(ctx context.Context, req *pb.AuditReviewRequest) (*pb.AuditReviewReply, error) {
+	fmt.Printf("[service] AuditReview, req:%+v\n", req)
+	err := s.uc.AuditReview(ctx, &biz.AuditParam{
+		ReviewId:  req.GetReviewId(),
+		Status:    req.GetStatus(),
+		OpReason:  req.GetOpReason(),
+		OpRemarks: req.GetOpRemarks(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditReviewReply{ReviewId: req.GetReviewId(), Status: req.GetStatus()}, nil
+}
+
 func (s *ReviewService) AuditAppeal(ctx context.Context, req *pb.AuditAppealRequest) (*pb.AuditAppealReply, error) {
 	fmt.Printf("[service] AuditAppeal, req:%+v\n", req)
 	var resp *pb.AuditAppealReply
@@ -90,7 +100,6 @@ func (s *ReviewService) AuditAppeal(ctx context.Context, req *pb.AuditAppealRequ
 		AppealId: req.GetAppealId(),
 		ReviewId: req.GetReviewId(),
 		Status:   req.GetStatus(),
-		OpUser:   req.GetOpUser(),
 		Reason:   req.GetOpReason(),
 	})
 	if err != nil {
@@ -107,13 +116,13 @@ func (s *ReviewService) TestConn(context.Context, *pb.TestConnRequest) (*pb.Test
 
 func (s *ReviewService) ListReviewByStoreId(ctx context.Context, req *pb.ListReviewByStoreIdRequest) (*pb.ListReviewByStoreIdReply, error) {
 	fmt.Printf("[service] ListReviewByStoreId, req:%+v\n", req)
-	reviewList, err := s.uc.ListReviewByStoreId(ctx, req.StoreId, int(req.Page), int(req.Size))
+	reviewList, err := s.uc.ListReviewByStoreId(ctx, req.StoreId, req.GetTags(), int(req.Page), int(req.Size))
 	if err != nil {
 		return nil, err
 	}
 	retList := make([]*pb.ReviewInfo, 0, len(reviewList))
 	for _, v := range reviewList {
-		retList = append(retList, &pb.ReviewInfo{
+		retList = append(retList, s.masker.Mask(ctx, &pb.ReviewInfo{
 			ReviewId:     v.ReviewID,
 			UserId:       v.UserID,
 			OrderId:      v.OrderID,
@@ -121,23 +130,435 @@ func (s *ReviewService) ListReviewByStoreId(ctx context.Context, req *pb.ListRev
 			ServiceScore: v.ServiceScore,
 			ExpressScore: v.ExpressScore,
 			Content:      v.Content,
-			PicInfo:      v.PicInfo,
-			VideoInfo:    v.VideoInfo,
-		})
+			Media:        toPbMedia(v.PicInfo, v.VideoInfo),
+			StoreId:      v.StoreID,
+			Anonymous:    v.Anonymous == 1,
+			Tags:         v.Tags,
+			Append:       toAppendInfo(v.Append),
+		}))
 
 	}
 	return &pb.ListReviewByStoreIdReply{List: retList}, nil
 }
 
 func (s *ReviewService) UpdateReview(ctx context.Context, req *pb.UpdateReviewRequest) (*pb.UpdateReviewReply, error) {
-	return &pb.UpdateReviewReply{}, nil
+	fmt.Printf("[service] UpdateReview, req:%+v\n", req)
+	review, err := s.uc.UpdateReview(ctx, &biz.UpdateReviewParam{
+		ReviewId:     req.GetReviewId(),
+		Version:      req.GetVersion(),
+		Score:        req.GetScore(),
+		ServiceScore: req.GetServiceScore(),
+		ExpressScore: req.GetExpressScore(),
+		Content:      req.GetContent(),
+		Media:        toBizMedia(req.GetMedia()),
+		Tags:         req.GetTags(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateReviewReply{ReviewId: review.ReviewID, Version: review.Version}, nil
 }
 func (s *ReviewService) DeleteReview(ctx context.Context, req *pb.DeleteReviewRequest) (*pb.DeleteReviewReply, error) {
+	fmt.Printf("[service] DeleteReview, req:%+v\n", req)
+	err := s.uc.DeleteReview(ctx, &biz.DeleteReviewParam{
+		ReviewId: req.GetReviewId(),
+	})
+	if err != nil {
+		return nil, err
+	}
 	return &pb.DeleteReviewReply{}, nil
 }
 func (s *ReviewService) GetReview(ctx context.Context, req *pb.GetReviewRequest) (*pb.GetReviewReply, error) {
-	return &pb.GetReviewReply{}, nil
+	fmt.Printf("[service] GetReview, req:%+v\n", req)
+	detail, err := s.uc.GetReview(ctx, req.GetReviewId())
+	if err != nil {
+		return nil, err
+	}
+	review := toReviewInfo(detail.Review)
+	review.Append = toAppendInfo(detail.Append)
+	return &pb.GetReviewReply{
+		Review: s.masker.Mask(ctx, review),
+		Reply:  toReplyInfo(detail.Reply),
+		Appeal: s.masker.MaskAppeal(ctx, toAppealInfo(detail.Appeal)),
+	}, nil
 }
 func (s *ReviewService) ListReview(ctx context.Context, req *pb.ListReviewRequest) (*pb.ListReviewReply, error) {
-	return &pb.ListReviewReply{}, nil
+	fmt.Printf("[service] ListReview, req:%+v\n", req)
+	param := &biz.ListReviewParam{
+		UserId:    req.GetUserId(),
+		SpuId:     req.GetSpuId(),
+		SkuId:     req.GetSkuId(),
+		StoreId:   req.GetStoreId(),
+		Status:    req.Status,
+		MinScore:  req.GetMinScore(),
+		MaxScore:  req.GetMaxScore(),
+		HasMedia:  req.HasMedia,
+		HasReply:  req.HasReply,
+		SortBy:    req.GetSortBy(),
+		Asc:       req.GetAsc(),
+		PageToken: req.GetPageToken(),
+		Size:      int(req.GetSize()),
+		Tags:      req.GetTags(),
+	}
+	var err error
+	if req.GetStartTime() != "" {
+		if param.StartTime, err = time.ParseInLocation(time.DateTime, req.GetStartTime(), time.Local); err != nil {
+			return nil, pb.ErrorInvalidParam("startTime格式错误: %v", err)
+		}
+	}
+	if req.GetEndTime() != "" {
+		if param.EndTime, err = time.ParseInLocation(time.DateTime, req.GetEndTime(), time.Local); err != nil {
+			return nil, pb.ErrorInvalidParam("endTime格式错误: %v", err)
+		}
+	}
+	// 按用户筛选时,除了运营和本人,都不能看到这个用户的匿名评价,否则匿名就没有意义了
+	if caller := biz.CallerFromContext(ctx); param.UserId > 0 && caller.Role != biz.RoleOperator &&
+		!(caller.Role == biz.RoleUser && caller.UserId == param.UserId) {
+		param.ExcludeAnonymous = true
+	}
+	ret, err := s.uc.ListReview(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReviewInfo, 0, len(ret.List))
+	for _, v := range ret.List {
+		list = append(list, s.masker.Mask(ctx, esReviewToPb(v)))
+	}
+	return &pb.ListReviewReply{List: list, Total: ret.Total, NextPageToken: ret.NextPageToken}, nil
+}
+
+func (s *ReviewService) SearchReviews(ctx context.Context, req *pb.SearchReviewsRequest) (*pb.SearchReviewsReply, error) {
+	fmt.Printf("[service] SearchReviews, req:%+v\n", req)
+	ret, err := s.uc.SearchReviews(ctx, &biz.SearchReviewParam{
+		Keyword:   req.GetKeyword(),
+		StoreId:   req.GetStoreId(),
+		SpuId:     req.GetSpuId(),
+		MinScore:  req.GetMinScore(),
+		MaxScore:  req.GetMaxScore(),
+		Status:    req.Status,
+		PageToken: req.GetPageToken(),
+		Size:      int(req.GetSize()),
+		Tags:      req.GetTags(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.SearchReviewHit, 0, len(ret.List))
+	for _, v := range ret.List {
+		list = append(list, &pb.SearchReviewHit{
+			Review:             s.masker.Mask(ctx, esReviewToPb(v.Review)),
+			ContentHighlight:   v.Highlight["content"],
+			TagsHighlight:      v.Highlight["tags.text"],
+			OpRemarksHighlight: v.Highlight["op_remarks"],
+		})
+	}
+	return &pb.SearchReviewsReply{List: list, Total: ret.Total, NextPageToken: ret.NextPageToken}, nil
+}
+
+func (s *ReviewService) GetStoreRatingSummary(ctx context.Context, req *pb.GetStoreRatingSummaryRequest) (*pb.GetStoreRatingSummaryReply, error) {
+	fmt.Printf("[service] GetStoreRatingSummary, req:%+v\n", req)
+	param := &biz.StoreRatingParam{StoreId: req.GetStoreId()}
+	var err error
+	if req.GetStartTime() != "" {
+		if param.StartTime, err = time.ParseInLocation(time.DateTime, req.GetStartTime(), time.Local); err != nil {
+			return nil, pb.ErrorInvalidParam("startTime格式错误: %v", err)
+		}
+	}
+	if req.GetEndTime() != "" {
+		if param.EndTime, err = time.ParseInLocation(time.DateTime, req.GetEndTime(), time.Local); err != nil {
+			return nil, pb.ErrorInvalidParam("endTime格式错误: %v", err)
+		}
+	}
+	ret, err := s.uc.GetStoreRatingSummary(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	dist := make([]*pb.ScoreCount, 0, 5)
+	for score := int32(5); score >= 1; score-- {
+		dist = append(dist, &pb.ScoreCount{Score: score, Count: ret.ScoreCount[score]})
+	}
+	return &pb.GetStoreRatingSummaryReply{
+		StoreId:           ret.StoreId,
+		Total:             ret.Total,
+		AvgScore:          round(ret.AvgScore, 2),
+		AvgServiceScore:   round(ret.AvgServiceScore, 2),
+		AvgExpressScore:   round(ret.AvgExpressScore, 2),
+		ScoreDistribution: dist,
+		MediaCount:        ret.MediaCount,
+		ReplyCount:        ret.ReplyCount,
+		ReplyRate:         round(ret.ReplyRate(), 4),
+	}, nil
+}
+
+func (s *ReviewService) ListReviewBySpu(ctx context.Context, req *pb.ListReviewBySpuRequest) (*pb.ListReviewBySpuReply, error) {
+	fmt.Printf("[service] ListReviewBySpu, req:%+v\n", req)
+	ret, err := s.uc.ListReviewBySpu(ctx, &biz.ListReviewBySpuParam{
+		SpuId:     req.GetSpuId(),
+		SkuId:     req.GetSkuId(),
+		Tab:       req.GetTab(),
+		Tags:      req.GetTags(),
+		PageToken: req.GetPageToken(),
+		Size:      int(req.GetSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReviewInfo, 0, len(ret.List))
+	for _, v := range ret.List {
+		list = append(list, s.masker.Mask(ctx, esReviewToPb(v)))
+	}
+	tabs := make([]*pb.ReviewTabCount, 0, len(biz.SpuTabs))
+	for _, tab := range biz.SpuTabs {
+		tabs = append(tabs, &pb.ReviewTabCount{Tab: tab, Count: ret.TabCounts[tab]})
+	}
+	return &pb.ListReviewBySpuReply{List: list, Tabs: tabs, NextPageToken: ret.NextPageToken}, nil
+}
+
+func (s *ReviewService) ListReviewByUser(ctx context.Context, req *pb.ListReviewByUserRequest) (*pb.ListReviewByUserReply, error) {
+	fmt.Printf("[service] ListReviewByUser, req:%+v\n", req)
+	ret, total, err := s.uc.ListReviewByUser(ctx, req.GetUserId(), int(req.GetPage()), int(req.GetSize()))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.UserReviewItem, 0, len(ret))
+	for _, v := range ret {
+		review := toReviewInfo(v.Review)
+		review.Append = toAppendInfo(v.Append)
+		list = append(list, &pb.UserReviewItem{
+			Review: s.masker.Mask(ctx, review),
+			Reply:  toReplyInfo(v.Reply),
+		})
+	}
+	return &pb.ListReviewByUserReply{List: list, Total: total}, nil
+}
+
+func (s *ReviewService) GetUploadToken(ctx context.Context, req *pb.GetUploadTokenRequest) (*pb.GetUploadTokenReply, error) {
+	fmt.Printf("[service] GetUploadToken, req:%+v\n", req)
+	files := make([]*biz.UploadFile, 0, len(req.GetFiles()))
+	for _, f := range req.GetFiles() {
+		files = append(files, &biz.UploadFile{
+			Type:        biz.MediaType(f.GetType()),
+			ContentType: f.GetContentType(),
+			Size:        f.GetSize(),
+		})
+	}
+	tokens, err := s.uc.GetUploadToken(ctx, files)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*pb.UploadToken, 0, len(tokens))
+	for _, t := range tokens {
+		ret = append(ret, &pb.UploadToken{
+			Key:       t.Key,
+			UploadUrl: t.UploadURL,
+			Method:    http.MethodPut,
+			Headers:   t.Headers,
+			Url:       t.URL,
+			ExpireAt:  t.ExpireAt.Format(time.DateTime),
+		})
+	}
+	return &pb.GetUploadTokenReply{Tokens: ret}, nil
+}
+
+func (s *ReviewService) GetTagCloud(ctx context.Context, req *pb.GetTagCloudRequest) (*pb.GetTagCloudReply, error) {
+	fmt.Printf("[service] GetTagCloud, req:%+v\n", req)
+	ret, err := s.uc.GetTagCloud(ctx, &biz.TagCloudParam{
+		StoreId: req.GetStoreId(),
+		SpuId:   req.GetSpuId(),
+		Size:    int(req.GetSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]*pb.TagCount, 0, len(ret))
+	for _, v := range ret {
+		tags = append(tags, &pb.TagCount{Tag: v.Tag, Count: v.Count})
+	}
+	return &pb.GetTagCloudReply{Tags: tags}, nil
+}
+
+func (s *ReviewService) AppendReview(ctx context.Context, req *pb.AppendReviewRequest) (*pb.AppendReviewReply, error) {
+	fmt.Printf("[service] AppendReview, req:%+v\n", req)
+	info, err := s.uc.AppendReview(ctx, &biz.AppendParam{
+		ReviewId: req.GetReviewId(),
+		Content:  req.GetContent(),
+		Media:    toBizMedia(req.GetMedia()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AppendReviewReply{AppendId: info.AppendID}, nil
+}
+
+func (s *ReviewService) AuditAppendReview(ctx context.Context, req *pb.AuditAppendReviewRequest) (*pb.AuditAppendReviewReply, error) {
+	fmt.Printf("[service] AuditAppendReview, req:%+v\n", req)
+	info, err := s.uc.AuditAppendReview(ctx, &biz.AuditParam{
+		ReviewId:  req.GetReviewId(),
+		Status:    req.GetStatus(),
+		OpReason:  req.GetOpReason(),
+		OpRemarks: req.GetOpRemarks(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditAppendReviewReply{AppendId: info.AppendID, Status: info.Status}, nil
+}
+
+// round 保留n位小数
+func round(v float64, n int) float64 {
+	p := math.Pow10(n)
+	return math.Round(v*p) / p
+}
+
+// toBizMedia 把接口中的图片视频转换成biz层的结构
+func toBizMedia(items []*pb.MediaItem) []*biz.MediaItem {
+	if len(items) == 0 {
+		return nil
+	}
+	ret := make([]*biz.MediaItem, 0, len(items))
+	for _, v := range items {
+		ret = append(ret, &biz.MediaItem{
+			URL:      v.GetUrl(),
+			Type:     biz.MediaType(v.GetType()),
+			Width:    v.GetWidth(),
+			Height:   v.GetHeight(),
+			Duration: v.GetDuration(),
+			Cover:    v.GetCover(),
+		})
+	}
+	return ret
+}
+
+// toPbMedia 解析pic_info和video_info,转换成接口返回的结构
+func toPbMedia(picInfo, videoInfo string) []*pb.MediaItem {
+	items := biz.DecodeMedia(picInfo, videoInfo)
+	if len(items) == 0 {
+		return nil
+	}
+	ret := make([]*pb.MediaItem, 0, len(items))
+	for _, v := range items {
+		ret = append(ret, &pb.MediaItem{
+			Url:      v.URL,
+			Type:     string(v.Type),
+			Width:    v.Width,
+			Height:   v.Height,
+			Duration: v.Duration,
+			Cover:    v.Cover,
+		})
+	}
+	return ret
+}
+
+// toReviewInfo 把数据库中的评价转换成接口返回的结构
+func toReviewInfo(review *model.ReviewInfo) *pb.ReviewInfo {
+	if review == nil {
+		return nil
+	}
+	return &pb.ReviewInfo{
+		ReviewId:       review.ReviewID,
+		UserId:         review.UserID,
+		OrderId:        review.OrderID,
+		Score:          review.Score,
+		ServiceScore:   review.ServiceScore,
+		ExpressScore:   review.ExpressScore,
+		Content:        review.Content,
+		Media:          toPbMedia(review.PicInfo, review.VideoInfo),
+		StoreId:        review.StoreID,
+		SkuId:          review.SkuID,
+		SpuId:          review.SpuID,
+		Status:         review.Status,
+		Anonymous:      review.Anonymous == 1,
+		Tags:           biz.DecodeTags(review.Tags),
+		HasMedia:       review.HasMedia == 1,
+		HasReply:       review.HasReply == 1,
+		IsDefault:      review.IsDefault == 1,
+		OpReason:       review.OpReason,
+		OpRemarks:      review.OpRemarks,
+		OpUser:         review.OpUser,
+		GoodsSnapshoot: review.GoodsSnapshoot,
+		Version:        review.Version,
+		CreateAt:       review.CreateAt.Format(time.DateTime),
+		UpdateAt:       review.UpdateAt.Format(time.DateTime),
+	}
+}
+
+// toReplyInfo 把数据库中的商家回复转换成接口返回的结构
+func toReplyInfo(reply *model.ReviewReplyInfo) *pb.ReviewReplyInfo {
+	if reply == nil {
+		return nil
+	}
+	return &pb.ReviewReplyInfo{
+		ReplyId:  reply.ReplyID,
+		ReviewId: reply.ReviewID,
+		StoreId:  reply.StoreID,
+		Content:  reply.Content,
+		Media:    toPbMedia(reply.PicInfo, reply.VideoInfo),
+		CreateAt: reply.CreateAt.Format(time.DateTime),
+	}
+}
+
+// toAppealInfo 把数据库中的商家申诉转换成接口返回的结构
+func toAppealInfo(appeal *model.ReviewAppealInfo) *pb.ReviewAppealInfo {
+	if appeal == nil {
+		return nil
+	}
+	return &pb.ReviewAppealInfo{
+		AppealId:  appeal.AppealID,
+		ReviewId:  appeal.ReviewID,
+		StoreId:   appeal.StoreID,
+		Status:    appeal.Status,
+		Reason:    appeal.Reason,
+		Content:   appeal.Content,
+		Media:     toPbMedia(appeal.PicInfo, appeal.VideoInfo),
+		OpRemarks: appeal.OpRemarks,
+		OpUser:    appeal.OpUser,
+		CreateAt:  appeal.CreateAt.Format(time.DateTime),
+	}
+}
+
+// toAppendInfo 把数据库中的追评转换成接口返回的结构
+func toAppendInfo(info *model.ReviewAppendInfo) *pb.ReviewAppendInfo {
+	if info == nil {
+		return nil
+	}
+	return &pb.ReviewAppendInfo{
+		AppendId: info.AppendID,
+		ReviewId: info.ReviewID,
+		Content:  info.Content,
+		Media:    toPbMedia(info.PicInfo, info.VideoInfo),
+		Status:   info.Status,
+		OpReason: info.OpReason,
+		CreateAt: info.CreateAt.Format(time.DateTime),
+	}
+}
+
+// esReviewToPb 把ES中查出来的评价转换成接口返回的结构
+func esReviewToPb(v *biz.MyReviewInfo) *pb.ReviewInfo {
+	return &pb.ReviewInfo{
+		ReviewId:       v.ReviewID,
+		UserId:         v.UserID,
+		OrderId:        v.OrderID,
+		Score:          v.Score,
+		ServiceScore:   v.ServiceScore,
+		ExpressScore:   v.ExpressScore,
+		Content:        v.Content,
+		Media:          toPbMedia(v.PicInfo, v.VideoInfo),
+		StoreId:        v.StoreID,
+		SkuId:          v.SkuID,
+		SpuId:          v.SpuID,
+		Status:         v.Status,
+		Anonymous:      v.Anonymous == 1,
+		Tags:           v.Tags,
+		HasMedia:       v.HasMedia == 1,
+		HasReply:       v.HasReply == 1,
+		IsDefault:      v.IsDefault == 1,
+		OpReason:       v.OpReason,
+		OpRemarks:      v.OpRemarks,
+		OpUser:         v.OpUser,
+		GoodsSnapshoot: v.GoodsSnapshoot,
+		Version:        v.Version,
+		CreateAt:       time.Time(v.CreateAt).Format(time.DateTime),
+		UpdateAt:       time.Time(v.UpdateAt).Format(time.DateTime),
+		Append:         toAppendInfo(v.Append),
+	}
 }
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+)
+
+// detailRepo 只实现查询评价详情用到的方法
+type detailRepo struct {
+	biz.ReviewRepo
+
+	review *model.ReviewInfo
+}
+
+func (r *detailRepo) GetReview(_ context.Context, reviewId int64) (*model.ReviewInfo, error) {
+	if r.review.ReviewID != reviewId {
+		return nil, pb.ErrorReviewNotFound("评价:%d不存在", reviewId)
+	}
+	ret := *r.review
+	return &ret, nil
+}
+
+func (r *detailRepo) GetReplyByReviewId(context.Context, int64) (*model.ReviewReplyInfo, error) {
+	return &model.ReviewReplyInfo{ReplyID: 2, ReviewID: r.review.ReviewID, StoreID: r.review.StoreID, Content: "感谢支持"}, nil
+}
+
+func (r *detailRepo) GetAppealByReviewId(context.Context, int64) (*model.ReviewAppealInfo, error) {
+	return &model.ReviewAppealInfo{AppealID: 3, ReviewID: r.review.ReviewID, StoreID: r.review.StoreID,
+		Reason: "恶意差评", Content: "同行恶意差评", OpRemarks: "已核实", OpUser: "op"}, nil
+}
+
+func (r *detailRepo) GetAppendByReviewId(context.Context, int64) (*model.ReviewAppendInfo, error) {
+	return nil, nil
+}
+
+func newDetailService(t *testing.T, status biz.ReviewStatus) *ReviewService {
+	t.Helper()
+	c := &conf.Review{AnonymousSecret: "secret"}
+	tags, err := biz.NewTagDict(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &detailRepo{review: &model.ReviewInfo{ReviewID: 1, UserID: 9, OrderID: 100, StoreID: 3, Status: int32(status)}}
+	uc := biz.NewReviewUsecase(c, repo, nil, nil, biz.NewContentFilterWithWords(biz.FilterActionMask), nil, nil, tags, log.DefaultLogger)
+	return NewReviewService(c, uc)
+}
+
+func TestGetReviewByRole(t *testing.T) {
+	cases := []struct {
+		name   string
+		caller *biz.Caller
+		// 期望看到未审核通过的评价、申诉、申诉的运营字段
+		pending  bool
+		appeal   bool
+		opFields bool
+	}{
+		{"operator", &biz.Caller{Role: biz.RoleOperator, OpUser: "op"}, true, true, true},
+		{"author", &biz.Caller{Role: biz.RoleUser, UserId: 9}, true, false, false},
+		{"other user", &biz.Caller{Role: biz.RoleUser, UserId: 8}, false, false, false},
+		{"owning store", &biz.Caller{Role: biz.RoleStore, StoreId: 3}, true, true, false},
+		{"other store", &biz.Caller{Role: biz.RoleStore, StoreId: 4}, false, false, false},
+		{"public", &biz.Caller{Role: biz.RolePublic}, false, false, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := biz.NewCallerContext(context.Background(), c.caller)
+			for _, status := range []biz.ReviewStatus{biz.Approved, biz.PendingReview, biz.ReviewNotApproved, biz.Hidden} {
+				reply, err := newDetailService(t, status).GetReview(ctx, &pb.GetReviewRequest{ReviewId: 1})
+				visible := status == biz.Approved || c.pending
+				if !visible {
+					if !pb.IsReviewNotFound(err) {
+						t.Fatalf("status %d: err = %v, want REVIEW_NOT_FOUND", status, err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("status %d: %v", status, err)
+				}
+				if reply.Review.ReviewId != 1 || reply.Reply == nil {
+					t.Fatalf("status %d: reply = %+v", status, reply)
+				}
+				if (reply.Appeal != nil) != c.appeal {
+					t.Fatalf("status %d: appeal = %+v", status, reply.Appeal)
+				}
+				if reply.Appeal != nil && (reply.Appeal.OpRemarks != "" || reply.Appeal.OpUser != "") != c.opFields {
+					t.Fatalf("status %d: appeal opRemarks = %q, opUser = %q", status, reply.Appeal.OpRemarks, reply.Appeal.OpUser)
+				}
+			}
+		})
+	}
+}
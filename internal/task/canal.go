@@ -0,0 +1,49 @@
+package task
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// canal row change 类型
+const (
+	canalInsert = "INSERT"
+	canalUpdate = "UPDATE"
+	canalDelete = "DELETE"
+)
+
+// reviewTable 需要同步到ES的表
+const reviewTable = "review_info"
+
+// CanalMessage canal投递到kafka的消息(flatMessage json格式)
+// data里面每一行都是 列名->值,值统一是字符串,NULL是null
+type CanalMessage struct {
+	ID       int64                    `json:"id"`
+	Database string                   `json:"database"`
+	Table    string                   `json:"table"`
+	PkNames  []string                 `json:"pkNames"`
+	IsDdl    bool                     `json:"isDdl"`
+	Type     string                   `json:"type"`
+	Es       int64                    `json:"es"`
+	Ts       int64                    `json:"ts"`
+	Sql      string                   `json:"sql"`
+	Data     []map[string]interface{} `json:"data"`
+	Old      []map[string]interface{} `json:"old"`
+}
+
+// rowKey 从一行数据中取出ES文档id(review_id)和版本号(version)
+func rowKey(row map[string]interface{}) (string, int64, error) {
+	id, ok := row["review_id"].(string)
+	if !ok || id == "" {
+		return "", 0, fmt.Errorf("review_id不合法: %v", row["review_id"])
+	}
+	v, ok := row["version"].(string)
+	if !ok {
+		return "", 0, fmt.Errorf("version不合法: %v", row["version"])
+	}
+	version, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("version不合法: %v", row["version"])
+	}
+	return id, version, nil
+}
@@ -0,0 +1,154 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// FakeConsumer 内存版kafka消费者
+// 消息消费完之后FetchMessage会阻塞到ctx取消
+type FakeConsumer struct {
+	mu        sync.Mutex
+	msgs      []kafka.Message
+	next      int
+	committed []kafka.Message
+	notify    chan struct{}
+}
+
+func NewFakeConsumer(values ...[]byte) *FakeConsumer {
+	c := &FakeConsumer{notify: make(chan struct{}, 1)}
+	for _, v := range values {
+		c.Push(v)
+	}
+	return c
+}
+
+// Push 追加一条消息
+func (c *FakeConsumer) Push(value []byte) {
+	c.mu.Lock()
+	c.msgs = append(c.msgs, kafka.Message{Value: value, Offset: int64(len(c.msgs))})
+	c.mu.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (c *FakeConsumer) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	for {
+		c.mu.Lock()
+		if c.next < len(c.msgs) {
+			msg := c.msgs[c.next]
+			c.next++
+			c.mu.Unlock()
+			return msg, nil
+		}
+		c.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return kafka.Message{}, ctx.Err()
+		case <-c.notify:
+		}
+	}
+}
+
+func (c *FakeConsumer) CommitMessages(_ context.Context, msgs ...kafka.Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.committed = append(c.committed, msgs...)
+	return nil
+}
+
+// Committed 返回已经提交的消息
+func (c *FakeConsumer) Committed() []kafka.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]kafka.Message(nil), c.committed...)
+}
+
+func (c *FakeConsumer) Close() error {
+	return nil
+}
+
+// FakeDoc FakeIndexer中保存的文档
+type FakeDoc struct {
+	Version int64
+	Source  map[string]interface{}
+}
+
+// FakeIndexer 内存版ES,和esIndexer一样按external规则忽略不大于当前版本的写入
+// 删除后保留版本号(相当于ES的tombstone),防止过期的写入把文档恢复回来
+// fail大于0时接下来的fail次写入返回错误,模拟ES不可用
+type FakeIndexer struct {
+	mu     sync.Mutex
+	docs   map[string]FakeDoc
+	fail   int
+	writes int
+}
+
+func NewFakeIndexer() *FakeIndexer {
+	return &FakeIndexer{docs: make(map[string]FakeDoc)}
+}
+
+func (i *FakeIndexer) Upsert(_ context.Context, id string, version int64, doc map[string]interface{}) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if err := i.failure(); err != nil {
+		return err
+	}
+	if cur, ok := i.docs[id]; ok && version <= cur.Version {
+		return nil
+	}
+	i.docs[id] = FakeDoc{Version: version, Source: doc}
+	return nil
+}
+
+func (i *FakeIndexer) Delete(_ context.Context, id string, version int64) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if err := i.failure(); err != nil {
+		return err
+	}
+	if cur, ok := i.docs[id]; ok && version <= cur.Version {
+		return nil
+	}
+	i.docs[id] = FakeDoc{Version: version}
+	return nil
+}
+
+func (i *FakeIndexer) failure() error {
+	i.writes++
+	if i.fail > 0 {
+		i.fail--
+		return errors.New("es unavailable")
+	}
+	return nil
+}
+
+// FailNext 接下来的n次写入返回错误
+func (i *FakeIndexer) FailNext(n int) {
+	i.mu.Lock()
+	i.fail = n
+	i.mu.Unlock()
+}
+
+// Writes 返回写入次数,包括失败的
+func (i *FakeIndexer) Writes() int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.writes
+}
+
+// Get 查询文档
+func (i *FakeIndexer) Get(id string) (FakeDoc, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	doc, ok := i.docs[id]
+	if !ok || doc.Source == nil {
+		return FakeDoc{}, false
+	}
+	return doc, true
+}
@@ -0,0 +1,60 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"review-service/internal/esindex"
+	"strconv"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/versiontype"
+)
+
+// Indexer 把评价写到ES
+// version是数据库里的乐观锁版本号,review_info每次写入都会加一
+// 不大于ES里已有文档版本号的写入会被忽略,保证重复消费和乱序消费是幂等的
+type Indexer interface {
+	Upsert(ctx context.Context, id string, version int64, doc map[string]interface{}) error
+	Delete(ctx context.Context, id string, version int64) error
+}
+
+type esIndexer struct {
+	es *elasticsearch.TypedClient
+}
+
+func NewESIndexer(es *elasticsearch.TypedClient) Indexer {
+	return &esIndexer{es: es}
+}
+
+// Upsert 使用external版本控制写入文档,版本号必须比ES里的大
+func (i *esIndexer) Upsert(ctx context.Context, id string, version int64, doc map[string]interface{}) error {
+	_, err := i.es.Index(esindex.ReviewAlias).
+		Id(id).
+		Version(strconv.FormatInt(version, 10)).
+		VersionType(versiontype.External).
+		Document(doc).
+		Do(ctx)
+	if isVersionConflict(err) {
+		return nil
+	}
+	return err
+}
+
+func (i *esIndexer) Delete(ctx context.Context, id string, version int64) error {
+	_, err := i.es.Delete(esindex.ReviewAlias, id).
+		Version(strconv.FormatInt(version, 10)).
+		VersionType(versiontype.External).
+		Do(ctx)
+	if isVersionConflict(err) {
+		return nil
+	}
+	return err
+}
+
+// isVersionConflict ES中已经有更新的版本,说明是过期的消息,直接忽略
+func isVersionConflict(err error) bool {
+	var esErr *types.ElasticsearchError
+	return errors.As(err, &esErr) && esErr.Status == http.StatusConflict
+}
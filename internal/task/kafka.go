@@ -0,0 +1,28 @@
+package task
+
+import (
+	"context"
+	"review-service/internal/conf"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Consumer kafka消费者,*kafka.Reader 实现了这个接口
+type Consumer interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// NewKafkaConsumer 按消费组消费canal投递的binlog消息
+func NewKafkaConsumer(c *conf.Kafka) (Consumer, func(), error) {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: c.Brokers,
+		Topic:   c.Topic,
+		GroupID: c.GroupId,
+	})
+	cleanup := func() {
+		_ = r.Close()
+	}
+	return r, cleanup, nil
+}
@@ -0,0 +1,133 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"review-service/internal/esindex"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/google/wire"
+)
+
+// ProviderSet is task providers.
+var ProviderSet = wire.NewSet(NewKafkaConsumer, NewESIndexer, NewSyncWorker)
+
+// retryInterval 消费或者写ES失败后的重试间隔
+const retryInterval = time.Second
+
+var _ transport.Server = (*SyncWorker)(nil)
+
+// SyncWorker 消费canal投递到kafka的review_info变更消息,同步到ES
+// 写ES成功之后才提交offset,ES不可用时一直重试,保证消息不丢
+type SyncWorker struct {
+	consumer Consumer
+	indexer  Indexer
+	log      *log.Helper
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+func NewSyncWorker(consumer Consumer, indexer Indexer, logger log.Logger) *SyncWorker {
+	return &SyncWorker{
+		consumer: consumer,
+		indexer:  indexer,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Start 开始消费,直到ctx取消或者调用Stop
+func (w *SyncWorker) Start(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	w.mu.Lock()
+	w.cancel = cancel
+	w.mu.Unlock()
+	defer cancel()
+
+	w.log.Info("[task] review sync worker started")
+	for {
+		msg, err := w.consumer.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			w.log.WithContext(ctx).Errorf("[task] FetchMessage fail, err:%v", err)
+			if !sleep(ctx, retryInterval) {
+				return nil
+			}
+			continue
+		}
+		for {
+			err := w.Handle(ctx, msg.Value)
+			if err == nil {
+				break
+			}
+			w.log.WithContext(ctx).Errorf("[task] Handle fail, partition:%d, offset:%d, err:%v", msg.Partition, msg.Offset, err)
+			if !sleep(ctx, retryInterval) {
+				return nil
+			}
+		}
+		if err := w.consumer.CommitMessages(ctx, msg); err != nil {
+			w.log.WithContext(ctx).Errorf("[task] CommitMessages fail, partition:%d, offset:%d, err:%v", msg.Partition, msg.Offset, err)
+		}
+	}
+}
+
+func (w *SyncWorker) Stop(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.log.Info("[task] review sync worker stopped")
+	return nil
+}
+
+// Handle 处理一条canal消息
+// 格式错误的消息重试也没有用,记录日志后跳过;写ES失败返回error,由调用方重试
+func (w *SyncWorker) Handle(ctx context.Context, value []byte) error {
+	var m CanalMessage
+	if err := json.Unmarshal(value, &m); err != nil {
+		w.log.WithContext(ctx).Errorf("[task] invalid canal message, value:%s, err:%v", value, err)
+		return nil
+	}
+	if m.IsDdl || m.Table != reviewTable {
+		return nil
+	}
+	for _, row := range m.Data {
+		id, version, err := rowKey(row)
+		if err != nil {
+			w.log.WithContext(ctx).Errorf("[task] invalid row, type:%s, err:%v", m.Type, err)
+			continue
+		}
+		switch m.Type {
+		case canalInsert, canalUpdate:
+			err = w.indexer.Upsert(ctx, id, version, esindex.NormalizeDoc(row))
+		case canalDelete:
+			// 物理删除时行数据是删除前的数据,version没有变化,加一之后才能覆盖ES里的文档
+			err = w.indexer.Delete(ctx, id, version+1)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sleep 等待d时间,ctx取消时返回false
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
@@ -0,0 +1,153 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// row 构造canal消息里的一行,值和canal一样都是字符串
+func row(reviewId, version int64, content string) map[string]interface{} {
+	return map[string]interface{}{
+		"review_id": strconv.FormatInt(reviewId, 10),
+		"version":   strconv.FormatInt(version, 10),
+		"content":   content,
+		"tags":      "",
+		"delete_at": nil,
+	}
+}
+
+func canalMessage(t *testing.T, typ string, rows ...map[string]interface{}) []byte {
+	t.Helper()
+	b, err := json.Marshal(CanalMessage{Database: "review", Table: reviewTable, Type: typ, Data: rows})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func newTestWorker() (*SyncWorker, *FakeIndexer) {
+	indexer := NewFakeIndexer()
+	return NewSyncWorker(NewFakeConsumer(), indexer, log.DefaultLogger), indexer
+}
+
+func handle(t *testing.T, w *SyncWorker, value []byte) {
+	t.Helper()
+	if err := w.Handle(context.Background(), value); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertDoc(t *testing.T, indexer *FakeIndexer, id string, version int64, content string) {
+	t.Helper()
+	doc, ok := indexer.Get(id)
+	if !ok {
+		t.Fatalf("doc %s not found", id)
+	}
+	if doc.Version != version || doc.Source["content"] != content {
+		t.Fatalf("doc = version:%d content:%v, want version:%d content:%s", doc.Version, doc.Source["content"], version, content)
+	}
+}
+
+func TestHandleOutOfOrder(t *testing.T) {
+	w, indexer := newTestWorker()
+	handle(t, w, canalMessage(t, canalUpdate, row(1, 2, "第二次修改")))
+	handle(t, w, canalMessage(t, canalUpdate, row(1, 1, "第一次修改")))
+	handle(t, w, canalMessage(t, canalInsert, row(1, 0, "原始评价")))
+	assertDoc(t, indexer, "1", 2, "第二次修改")
+}
+
+func TestHandleDuplicate(t *testing.T) {
+	w, indexer := newTestWorker()
+	msg := canalMessage(t, canalInsert, row(1, 0, "原始评价"))
+	handle(t, w, msg)
+	handle(t, w, canalMessage(t, canalUpdate, row(1, 1, "商家已回复")))
+	// 重复投递的旧消息不会覆盖
+	handle(t, w, msg)
+	assertDoc(t, indexer, "1", 1, "商家已回复")
+}
+
+func TestHandleDelete(t *testing.T) {
+	t.Run("soft delete", func(t *testing.T) {
+		w, indexer := newTestWorker()
+		handle(t, w, canalMessage(t, canalInsert, row(1, 0, "原始评价")))
+		deleted := row(1, 1, "原始评价")
+		deleted["delete_at"] = "2026-10-01 12:00:00"
+		handle(t, w, canalMessage(t, canalUpdate, deleted))
+		handle(t, w, canalMessage(t, canalInsert, row(1, 0, "原始评价")))
+		doc, _ := indexer.Get("1")
+		if doc.Source["delete_at"] == nil {
+			t.Fatal("stale insert restored deleted review")
+		}
+	})
+	t.Run("hard delete", func(t *testing.T) {
+		w, indexer := newTestWorker()
+		handle(t, w, canalMessage(t, canalInsert, row(1, 0, "原始评价")))
+		// 物理删除的行数据和最后一次写入的版本号相同
+		handle(t, w, canalMessage(t, canalDelete, row(1, 0, "原始评价")))
+		if _, ok := indexer.Get("1"); ok {
+			t.Fatal("doc not deleted")
+		}
+		handle(t, w, canalMessage(t, canalInsert, row(1, 0, "原始评价")))
+		if _, ok := indexer.Get("1"); ok {
+			t.Fatal("redelivered insert restored deleted doc")
+		}
+	})
+}
+
+func TestHandleSkipsInvalidMessage(t *testing.T) {
+	w, indexer := newTestWorker()
+	other, _ := json.Marshal(CanalMessage{Table: "review_reply_info", Type: canalInsert, Data: []map[string]interface{}{row(1, 0, "")}})
+	ddl, _ := json.Marshal(CanalMessage{Table: reviewTable, IsDdl: true, Type: "ALTER"})
+	noId := row(1, 0, "")
+	delete(noId, "review_id")
+	for _, value := range [][]byte{[]byte("not json"), other, ddl, canalMessage(t, canalInsert, noId)} {
+		handle(t, w, value)
+	}
+	if n := indexer.Writes(); n != 0 {
+		t.Fatalf("writes = %d, want 0", n)
+	}
+}
+
+func TestWorkerRedeliverAfterError(t *testing.T) {
+	consumer := NewFakeConsumer()
+	indexer := NewFakeIndexer()
+	w := NewSyncWorker(consumer, indexer, log.DefaultLogger)
+	indexer.FailNext(1)
+	consumer.Push(canalMessage(t, canalInsert, row(1, 0, "原始评价")))
+
+	done := make(chan error, 1)
+	go func() { done <- w.Start(context.Background()) }()
+	defer func() {
+		_ = w.Stop(context.Background())
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	waitFor(t, func() bool { return indexer.Writes() >= 1 })
+	// 写ES失败时不提交offset
+	if n := len(consumer.Committed()); n != 0 {
+		t.Fatalf("committed %d messages before indexed", n)
+	}
+	waitFor(t, func() bool { return len(consumer.Committed()) == 1 })
+	assertDoc(t, indexer, "1", 0, "原始评价")
+	if n := indexer.Writes(); n != 2 {
+		t.Fatalf("writes = %d, want 2", n)
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -45,7 +45,7 @@ CREATE TABLE `review_info` (
 
                           PRIMARY KEY (`id`),
                           KEY `idx_review_id` (`review_id`) COMMENT '评价id索引',
-                          KEY `idx_order_id` (`order_id`) COMMENT '订单id索引',
+                          UNIQUE KEY `uk_order_id` (`order_id`) COMMENT '一个订单只能评价一次',
                           KEY `idx_user_id` (`user_id`) COMMENT '用户id索引',
                           KEY `idx_spu_id` (`spu_id`) COMMENT '商品spu索引',
                           KEY `idx_store_id` (`store_id`) COMMENT '店铺id索引',
@@ -111,4 +111,53 @@ CREATE TABLE `review_appeal_info` (
                                       KEY `idx_store_id` (`store_id`) COMMENT '店铺id索引',
                                         UNIQUE KEY `uk_review_id` (`review_id`) COMMENT '评价id索引',
                                       KEY `idx_status` (`status`) COMMENT '状态索引'
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价商家申诉表';
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价商家申诉表';
+
+
+CREATE TABLE `review_outbox` (
+                                 `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+                                 `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                 `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+
+                                 `review_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '评价id',
+                                 `event_type` varchar(64) NOT NULL DEFAULT '' COMMENT '事件类型',
+                                 `payload` text NOT NULL COMMENT '事件内容json',
+                                 `status` tinyint(4) NOT NULL DEFAULT '0' COMMENT '状态:0待投递;1已投递;2死信',
+                                 `retry_count` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '投递失败次数',
+                                 `next_retry_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '下次重试时间',
+
+                                 PRIMARY KEY (`id`),
+                                 KEY `idx_status_retry` (`status`, `next_retry_at`) COMMENT '到期待投递事件索引',
+                                 KEY `idx_review_id` (`review_id`) COMMENT '评价id索引'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价事件发件箱表';
+
+CREATE TABLE `review_append_info` (
+                                      `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+                                      `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建方标识',
+                                      `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新方标识',
+                                      `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                      `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                      `version` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '乐观锁标记',
+                                      `delete_at` timestamp COMMENT '逻辑删除标记',
+
+                                      `append_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '追评id',
+                                      `review_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '评价id',
+                                      `user_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '用户id',
+                                      `store_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '店铺id',
+                                      `content` varchar(512) NOT NULL COMMENT '追评内容',
+                                      `pic_info` varchar(1024) NOT NULL DEFAULT '' COMMENT '媒体信息: 图片',
+                                      `video_info` varchar(1024) NOT NULL DEFAULT '' COMMENT '媒体信息: 视频',
+                                      `has_media` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否有图或视频',
+                                      `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10待审核;20审核通过;30审核不通过',
+                                      `op_reason` varchar(512) NOT NULL DEFAULT '' COMMENT '运营审核拒绝原因',
+                                      `op_remarks` varchar(512) NOT NULL DEFAULT '' COMMENT '运营备注',
+                                      `op_user` varchar(64) NOT NULL DEFAULT '' COMMENT '运营者标识',
+
+                                      `ext_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '信息扩展',
+                                      `ctrl_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '控制扩展',
+
+                                      PRIMARY KEY (`id`),
+                                      KEY `idx_append_id` (`append_id`) COMMENT '追评id索引',
+                                      UNIQUE KEY `uk_review_id` (`review_id`) COMMENT '一条评价只能追评一次',
+                                      KEY `idx_user_id` (`user_id`) COMMENT '用户id索引'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价追评表';